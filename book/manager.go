@@ -0,0 +1,377 @@
+package book
+
+import (
+	"errors"
+	"fmt"
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"github.com/NattapornTee22816/binance-connector-golang/websocket"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrSymbolRequired      = errors.New("symbol is required")
+	ErrSymbolSubscribed    = errors.New("symbol already subscribed")
+	ErrSymbolNotSubscribed = errors.New("symbol is not subscribed")
+)
+
+// SnapshotService
+// order book snapshot from rest api, spot.API is implemented this interface
+type SnapshotService interface {
+	OrderBook(param *model.OrderBookParam) (*model.OrderBook, error)
+}
+
+// DiffDepthSubscriber
+// diff depth stream from websocket, websocket.Stream is implemented this interface
+type DiffDepthSubscriber interface {
+	SubscribeDiffDepthStream(streams []string, handler ...websocket.DiffDepthStreamHandler) error
+	Unsubscribe(streams []string) error
+}
+
+// ChangeHandler
+// called after order book of symbol was changed by snapshot or diff depth event
+//
+//	func(book *OrderBook) {
+//	  do something
+//	}
+type ChangeHandler = func(*OrderBook)
+
+type ManagerConfig struct {
+	// depth of snapshot from rest api, default to 1000
+	SnapshotLimit int64
+	// use '<symbol>@depth@100ms' instead of '<symbol>@depth'
+	Use100ms bool
+	// waiting time before fetch snapshot again when error, default to 1 second
+	RetryInterval time.Duration
+}
+
+// Manager
+// keep local order books sync with diff depth stream and snapshot
+//   - buffer the events from the stream
+//   - get a depth snapshot
+//   - drop any event where u is <= lastUpdateId in the snapshot
+//   - the first processed event should have U <= lastUpdateId+1 AND u >= lastUpdateId+1
+//   - each new event's U should be equal to the previous event's u+1, otherwise re-snapshot
+//   - https://binance-docs.github.io/apidocs/spot/en/#how-to-manage-a-local-order-book-correctly
+type Manager struct {
+	ManagerConfig
+	snapshot SnapshotService
+	stream   DiffDepthSubscriber
+	logger   *lib.BinanceLogger
+	mu       sync.RWMutex
+	books    map[string]*bookState
+	handlers []ChangeHandler
+	// subscribeMu (sync.Mutex): serialize Subscribe while it waits for the server, mu is not locked meanwhile
+	subscribeMu sync.Mutex
+}
+
+type bookState struct {
+	mu          sync.Mutex
+	book        *OrderBook
+	stream      string
+	synced      bool
+	syncing     bool
+	expectFirst bool
+	closed      bool
+	buffer      []*websocket.DiffDepthStream
+}
+
+func NewManager(snapshot SnapshotService, stream DiffDepthSubscriber, configs ...ManagerConfig) *Manager {
+	config := ManagerConfig{}
+	if len(configs) > 0 {
+		config = configs[0]
+	}
+	if config.SnapshotLimit <= 0 {
+		config.SnapshotLimit = 1000
+	}
+	if config.RetryInterval <= 0 {
+		config.RetryInterval = time.Second
+	}
+
+	return &Manager{
+		ManagerConfig: config,
+		snapshot:      snapshot,
+		stream:        stream,
+		logger:        lib.NewLogger("binance-order-book", lib.LogLevelDebug),
+		books:         make(map[string]*bookState),
+		handlers:      make([]ChangeHandler, 0),
+	}
+}
+
+// OnChange
+// add handler for every change of all order books
+func (m *Manager) OnChange(handler ...ChangeHandler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.handlers = append(m.handlers, handler...)
+}
+
+// Subscribe
+// subscribe diff depth stream of symbol and start sync order book
+func (m *Manager) Subscribe(symbol string) (*OrderBook, error) {
+	if len(symbol) == 0 {
+		return nil, ErrSymbolRequired
+	}
+	symbol = strings.ToUpper(symbol)
+
+	streamName, err := websocket.NewDiffDepthStreamType(symbol)
+	if m.Use100ms {
+		streamName, err = websocket.NewDiffDepth100msStreamType(symbol)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	m.subscribeMu.Lock()
+	defer m.subscribeMu.Unlock()
+
+	m.mu.Lock()
+	if _, exists := m.books[symbol]; exists {
+		m.mu.Unlock()
+		return nil, ErrSymbolSubscribed
+	}
+
+	state := &bookState{
+		book:   NewOrderBook(symbol),
+		stream: streamName,
+		buffer: make([]*websocket.DiffDepthStream, 0),
+	}
+	m.books[symbol] = state
+
+	// events are buffered from here until snapshot is applied
+	m.mu.Unlock()
+
+	// onDiffDepth locks mu while the stream waits for the response of SUBSCRIBE
+	if err := m.stream.SubscribeDiffDepthStream([]string{streamName}, m.onDiffDepth); err != nil {
+		m.mu.Lock()
+		if m.books[symbol] == state {
+			delete(m.books, symbol)
+		}
+		m.mu.Unlock()
+		return nil, err
+	}
+
+	state.mu.Lock()
+	m.startSync(state)
+	state.mu.Unlock()
+
+	return state.book, nil
+}
+
+// Unsubscribe
+// stop sync order book of symbol and unsubscribe its diff depth stream
+func (m *Manager) Unsubscribe(symbol string) error {
+	symbol = strings.ToUpper(symbol)
+
+	m.mu.Lock()
+	state, exists := m.books[symbol]
+	if exists {
+		delete(m.books, symbol)
+	}
+	m.mu.Unlock()
+
+	if !exists {
+		return ErrSymbolNotSubscribed
+	}
+
+	state.mu.Lock()
+	state.closed = true
+	state.buffer = nil
+	state.mu.Unlock()
+
+	return m.stream.Unsubscribe([]string{state.stream})
+}
+
+// Close
+// unsubscribe all order books
+func (m *Manager) Close() {
+	m.mu.RLock()
+	symbols := make([]string, 0, len(m.books))
+	for symbol := range m.books {
+		symbols = append(symbols, symbol)
+	}
+	m.mu.RUnlock()
+
+	for _, symbol := range symbols {
+		if err := m.Unsubscribe(symbol); err != nil {
+			m.logger.Error(fmt.Sprintf("unsubscribe order book %s error: %s", symbol, err.Error()))
+		}
+	}
+}
+
+// Book
+// order book of symbol, false when symbol is not subscribed
+func (m *Manager) Book(symbol string) (*OrderBook, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	state, exists := m.books[strings.ToUpper(symbol)]
+	if !exists {
+		return nil, false
+	}
+	return state.book, true
+}
+
+// IsSynced
+// true when order book of symbol was applied snapshot and following events without gap
+func (m *Manager) IsSynced(symbol string) bool {
+	m.mu.RLock()
+	state, exists := m.books[strings.ToUpper(symbol)]
+	m.mu.RUnlock()
+	if !exists {
+		return false
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	return state.synced
+}
+
+func (m *Manager) onDiffDepth(_ string, data *websocket.DiffDepthStream, _ error) {
+	if data == nil {
+		return
+	}
+
+	m.mu.RLock()
+	state, exists := m.books[strings.ToUpper(data.Symbol)]
+	m.mu.RUnlock()
+	if !exists {
+		return
+	}
+
+	state.mu.Lock()
+	if state.closed {
+		state.mu.Unlock()
+		return
+	}
+	if !state.synced {
+		state.buffer = append(state.buffer, data)
+		m.startSync(state)
+		state.mu.Unlock()
+		return
+	}
+
+	changed, ok := m.applyEvent(state, data)
+	if !ok {
+		m.logger.Info(fmt.Sprintf("order book %s has gap of update id, re-snapshot", state.book.Symbol()))
+		state.synced = false
+		state.buffer = append(state.buffer[:0], data)
+		m.startSync(state)
+	}
+	state.mu.Unlock()
+
+	if changed {
+		m.notify(state.book)
+	}
+}
+
+// startSync
+// fetch snapshot in background when not syncing, state.mu must be held
+func (m *Manager) startSync(state *bookState) {
+	if state.syncing || state.closed {
+		return
+	}
+
+	state.syncing = true
+	go m.sync(state)
+}
+
+func (m *Manager) sync(state *bookState) {
+	for {
+		snapshot, err := m.snapshot.OrderBook(&model.OrderBookParam{
+			Symbol: state.book.Symbol(),
+			Limit:  m.SnapshotLimit,
+		})
+
+		state.mu.Lock()
+		if state.closed {
+			state.syncing = false
+			state.mu.Unlock()
+			return
+		}
+		if err == nil {
+			err = m.applySnapshot(state, snapshot)
+		}
+		if err == nil {
+			state.syncing = false
+			state.mu.Unlock()
+			m.notify(state.book)
+			return
+		}
+		state.mu.Unlock()
+
+		m.logger.Error(fmt.Sprintf("sync order book %s error: %s", state.book.Symbol(), err.Error()))
+		time.Sleep(m.RetryInterval)
+	}
+}
+
+// applySnapshot
+// reset order book with snapshot then apply buffered events, state.mu must be held
+func (m *Manager) applySnapshot(state *bookState, snapshot *model.OrderBook) error {
+	state.book.reset(snapshot.LastUpdateId, orderBookLevels(snapshot.Bids), orderBookLevels(snapshot.Asks))
+	state.expectFirst = true
+
+	buffer := state.buffer
+	state.buffer = make([]*websocket.DiffDepthStream, 0)
+	for i, event := range buffer {
+		if _, ok := m.applyEvent(state, event); !ok {
+			// snapshot is older than buffered events, keep events and fetch again
+			state.buffer = append(state.buffer, buffer[i:]...)
+			return fmt.Errorf("snapshot %d is older than event %d", snapshot.LastUpdateId, event.FirstUpdateIdInEvent)
+		}
+	}
+
+	state.synced = true
+	return nil
+}
+
+// applyEvent
+// apply diff depth event to order book, state.mu must be held.
+// return false when update id of the event is not continuous
+func (m *Manager) applyEvent(state *bookState, event *websocket.DiffDepthStream) (bool, bool) {
+	lastUpdateId := state.book.LastUpdateId()
+	if event.FinalUpdateIdInEvent <= lastUpdateId {
+		return false, true
+	}
+	if state.expectFirst {
+		if event.FirstUpdateIdInEvent > lastUpdateId+1 {
+			return false, false
+		}
+	} else if event.FirstUpdateIdInEvent != lastUpdateId+1 {
+		return false, false
+	}
+
+	state.book.update(event.FinalUpdateIdInEvent, diffDepthLevels(event.Bids), diffDepthLevels(event.Asks))
+	state.expectFirst = false
+	return true, true
+}
+
+func (m *Manager) notify(book *OrderBook) {
+	m.mu.RLock()
+	handlers := m.handlers
+	m.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(book)
+	}
+}
+
+func orderBookLevels(prices []*model.OrderBookPrice) []Level {
+	levels := make([]Level, 0, len(prices))
+	for _, price := range prices {
+		levels = append(levels, Level{Price: price.Price, Quantity: price.Qty})
+	}
+	return levels
+}
+
+func diffDepthLevels(prices []*websocket.DiffDepthStreamPriceLevel) []Level {
+	levels := make([]Level, 0, len(prices))
+	for _, price := range prices {
+		levels = append(levels, Level{Price: price.Price, Quantity: price.Quantity})
+	}
+	return levels
+}
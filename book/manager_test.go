@@ -0,0 +1,152 @@
+package book
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"github.com/NattapornTee22816/binance-connector-golang/websocket"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeSnapshot struct {
+	mu        sync.Mutex
+	snapshots []*model.OrderBook
+	calls     int
+	ready     chan struct{}
+}
+
+func (f *fakeSnapshot) OrderBook(_ *model.OrderBookParam) (*model.OrderBook, error) {
+	<-f.ready
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	snapshot := f.snapshots[f.calls]
+	if f.calls < len(f.snapshots)-1 {
+		f.calls++
+	}
+	return snapshot, nil
+}
+
+type fakeStream struct {
+	handler websocket.DiffDepthStreamHandler
+	// onSubscribe (func() error): called before the response of SUBSCRIBE
+	onSubscribe func() error
+}
+
+func (f *fakeStream) SubscribeDiffDepthStream(_ []string, handler ...websocket.DiffDepthStreamHandler) error {
+	if len(handler) > 0 {
+		f.handler = handler[0]
+	}
+	if f.onSubscribe != nil {
+		return f.onSubscribe()
+	}
+	return nil
+}
+
+func (f *fakeStream) Unsubscribe(_ []string) error {
+	return nil
+}
+
+func diffDepth(first int64, final int64, bidPrice string, bidQty string) *websocket.DiffDepthStream {
+	return &websocket.DiffDepthStream{
+		Symbol:               "BTCUSDT",
+		FirstUpdateIdInEvent: first,
+		FinalUpdateIdInEvent: final,
+		Bids:                 []*websocket.DiffDepthStreamPriceLevel{{Price: model.MustDecimal(bidPrice), Quantity: model.MustDecimal(bidQty)}},
+		Asks:                 []*websocket.DiffDepthStreamPriceLevel{},
+	}
+}
+
+func waitSynced(t *testing.T, m *Manager, lastUpdateId int64) {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if b, ok := m.Book("BTCUSDT"); ok && m.IsSynced("BTCUSDT") && b.LastUpdateId() == lastUpdateId {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("order book is not synced at %d", lastUpdateId)
+}
+
+func TestManager_Sync(t *testing.T) {
+	snapshot := &fakeSnapshot{
+		ready: make(chan struct{}),
+		snapshots: []*model.OrderBook{
+			{
+				LastUpdateId: 100,
+				Bids:         []*model.OrderBookPrice{{Price: model.MustDecimal("10.0"), Qty: model.MustDecimal("1.0")}},
+				Asks:         []*model.OrderBookPrice{{Price: model.MustDecimal("11.0"), Qty: model.MustDecimal("1.0")}},
+			},
+			{
+				LastUpdateId: 200,
+				Bids:         []*model.OrderBookPrice{{Price: model.MustDecimal("12.0"), Qty: model.MustDecimal("1.0")}},
+				Asks:         []*model.OrderBookPrice{{Price: model.MustDecimal("13.0"), Qty: model.MustDecimal("1.0")}},
+			},
+		},
+	}
+	stream := &fakeStream{}
+	m := NewManager(snapshot, stream, ManagerConfig{RetryInterval: time.Millisecond})
+
+	if _, err := m.Subscribe("btcusdt"); err != nil {
+		t.Fatal(err)
+	}
+
+	// buffered before snapshot, first is dropped because u <= lastUpdateId
+	stream.handler("btcusdt@depth", diffDepth(90, 100, "9.0", "1.0"), nil)
+	stream.handler("btcusdt@depth", diffDepth(95, 105, "10.0", "2.0"), nil)
+	close(snapshot.ready)
+	waitSynced(t, m, 105)
+
+	b, _ := m.Book("BTCUSDT")
+	if bid, _ := b.BestBid(); !bid.Price.Equal(model.NewDecimalFromInt(10)) || !bid.Quantity.Equal(model.NewDecimalFromInt(2)) {
+		t.Errorf("best bid = %v, want 10@2", bid)
+	}
+
+	stream.handler("btcusdt@depth", diffDepth(106, 110, "10.5", "1.0"), nil)
+	if b.LastUpdateId() != 110 {
+		t.Errorf("lastUpdateId = %d, want 110", b.LastUpdateId())
+	}
+
+	// gap from 110 to 120, re-snapshot
+	stream.handler("btcusdt@depth", diffDepth(120, 201, "12.0", "3.0"), nil)
+	waitSynced(t, m, 201)
+	if bid, _ := b.BestBid(); !bid.Price.Equal(model.NewDecimalFromInt(12)) || !bid.Quantity.Equal(model.NewDecimalFromInt(3)) {
+		t.Errorf("best bid = %v, want 12@3", bid)
+	}
+}
+
+func TestManager_SubscribeWhileHandling(t *testing.T) {
+	snapshot := &fakeSnapshot{
+		ready:     make(chan struct{}),
+		snapshots: []*model.OrderBook{{LastUpdateId: 100}},
+	}
+	close(snapshot.ready)
+	stream := &fakeStream{}
+	m := NewManager(snapshot, stream, ManagerConfig{RetryInterval: time.Millisecond})
+
+	if _, err := m.Subscribe("btcusdt"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the response of SUBSCRIBE is read after the worker handled the queued event
+	stream.onSubscribe = func() error {
+		handled := make(chan struct{})
+		go func() {
+			stream.handler("btcusdt@depth", diffDepth(101, 102, "10.0", "1.0"), nil)
+			close(handled)
+		}()
+		select {
+		case <-handled:
+			return nil
+		case <-time.After(time.Second):
+			return websocket.ErrCommandTimeout
+		}
+	}
+	if _, err := m.Subscribe("ethusdt"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m.Book("ETHUSDT"); !ok {
+		t.Error("ETHUSDT is not subscribed")
+	}
+}
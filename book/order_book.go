@@ -0,0 +1,212 @@
+package book
+
+import (
+	"errors"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"sort"
+	"sync"
+)
+
+var (
+	ErrInsufficientDepth = errors.New("order book depth is not enough for size")
+	ErrInvalidSize       = errors.New("size must be greater than zero")
+)
+
+type Side = string
+
+var (
+	SideBid = Side("BID")
+	SideAsk = Side("ASK")
+)
+
+// vwapScale
+// digits after decimal point of VWAP
+const vwapScale = 16
+
+type Level struct {
+	Price    model.Decimal `json:"price"`
+	Quantity model.Decimal `json:"quantity"`
+}
+
+// OrderBook
+// local order book of a symbol, bids are sorted by price descending and asks are sorted by price ascending.
+// it is safe for concurrent use
+type OrderBook struct {
+	mu           sync.RWMutex
+	symbol       string
+	lastUpdateId int64
+	bids         []Level
+	asks         []Level
+}
+
+func NewOrderBook(symbol string) *OrderBook {
+	return &OrderBook{
+		symbol: symbol,
+		bids:   make([]Level, 0),
+		asks:   make([]Level, 0),
+	}
+}
+
+func (b *OrderBook) Symbol() string {
+	return b.symbol
+}
+
+// LastUpdateId
+// update id of the last snapshot or event applied to the book
+func (b *OrderBook) LastUpdateId() int64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.lastUpdateId
+}
+
+// BestBid
+// highest bid, false when there is no bid
+func (b *OrderBook) BestBid() (Level, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if len(b.bids) == 0 {
+		return Level{}, false
+	}
+	return b.bids[0], true
+}
+
+// BestAsk
+// lowest ask, false when there is no ask
+func (b *OrderBook) BestAsk() (Level, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if len(b.asks) == 0 {
+		return Level{}, false
+	}
+	return b.asks[0], true
+}
+
+// Bids
+// top n bids, n <= 0 is all bids
+func (b *OrderBook) Bids(n int) []Level {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return copyLevels(b.bids, n)
+}
+
+// Asks
+// top n asks, n <= 0 is all asks
+func (b *OrderBook) Asks(n int) []Level {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return copyLevels(b.asks, n)
+}
+
+// Depth
+// top n bids and asks from the same state of the book
+func (b *OrderBook) Depth(n int) (bids []Level, asks []Level) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return copyLevels(b.bids, n), copyLevels(b.asks, n)
+}
+
+// VWAP
+// volume weighted average price for fill size on the side.
+//   - SideBid walks the bids (sell size)
+//   - SideAsk walks the asks (buy size)
+func (b *OrderBook) VWAP(side Side, size model.Decimal) (model.Decimal, error) {
+	if !size.IsPositive() {
+		return model.Decimal{}, ErrInvalidSize
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	levels := b.asks
+	if side == SideBid {
+		levels = b.bids
+	}
+
+	remaining, notional := size, model.Decimal{}
+	for _, level := range levels {
+		qty := level.Quantity
+		if qty.GreaterThan(remaining) {
+			qty = remaining
+		}
+		notional = notional.Add(qty.Mul(level.Price))
+		remaining = remaining.Sub(qty)
+		if !remaining.IsPositive() {
+			return notional.Div(size, vwapScale).Trim(), nil
+		}
+	}
+
+	return model.Decimal{}, ErrInsufficientDepth
+}
+
+// reset
+// replace all levels with snapshot
+func (b *OrderBook) reset(lastUpdateId int64, bids []Level, asks []Level) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastUpdateId = lastUpdateId
+	b.bids = make([]Level, 0, len(bids))
+	b.asks = make([]Level, 0, len(asks))
+	for _, level := range bids {
+		b.bids = updateLevel(b.bids, level, true)
+	}
+	for _, level := range asks {
+		b.asks = updateLevel(b.asks, level, false)
+	}
+}
+
+// update
+// apply changed levels, quantity 0 is remove the price level
+func (b *OrderBook) update(finalUpdateId int64, bids []Level, asks []Level) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, level := range bids {
+		b.bids = updateLevel(b.bids, level, true)
+	}
+	for _, level := range asks {
+		b.asks = updateLevel(b.asks, level, false)
+	}
+	b.lastUpdateId = finalUpdateId
+}
+
+func updateLevel(levels []Level, level Level, descending bool) []Level {
+	i := sort.Search(len(levels), func(i int) bool {
+		if descending {
+			return levels[i].Price.LessThanOrEqual(level.Price)
+		}
+		return levels[i].Price.GreaterThanOrEqual(level.Price)
+	})
+
+	exists := i < len(levels) && levels[i].Price.Equal(level.Price)
+	switch {
+	case level.Quantity.IsZero() && exists:
+		return append(levels[:i], levels[i+1:]...)
+	case level.Quantity.IsZero():
+		return levels
+	case exists:
+		levels[i].Quantity = level.Quantity
+		return levels
+	}
+
+	levels = append(levels, Level{})
+	copy(levels[i+1:], levels[i:])
+	levels[i] = level
+	return levels
+}
+
+func copyLevels(levels []Level, n int) []Level {
+	if n <= 0 || n > len(levels) {
+		n = len(levels)
+	}
+
+	result := make([]Level, n)
+	copy(result, levels[:n])
+	return result
+}
@@ -0,0 +1,61 @@
+package book
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"testing"
+)
+
+func level(price string, quantity string) Level {
+	return Level{Price: model.MustDecimal(price), Quantity: model.MustDecimal(quantity)}
+}
+
+func TestOrderBook_Update(t *testing.T) {
+	book := NewOrderBook("BTCUSDT")
+	book.reset(10,
+		[]Level{level("99", "1"), level("100", "2"), level("98", "3")},
+		[]Level{level("102", "1"), level("101", "2")},
+	)
+
+	book.update(11,
+		[]Level{level("100.00", "0.00"), level("99.5", "4")},
+		[]Level{level("101", "5"), level("103", "0")},
+	)
+
+	if book.LastUpdateId() != 11 {
+		t.Errorf("lastUpdateId = %d, want 11", book.LastUpdateId())
+	}
+	bids := book.Bids(0)
+	want := []string{"99.5", "99", "98"}
+	if len(bids) != len(want) {
+		t.Fatalf("bids = %v, want prices %v", bids, want)
+	}
+	for i, price := range want {
+		if bids[i].Price.String() != price {
+			t.Errorf("bids[%d] = %v, want %v", i, bids[i].Price, price)
+		}
+	}
+	if ask, ok := book.BestAsk(); !ok || ask.Price.String() != "101" || ask.Quantity.String() != "5" {
+		t.Errorf("best ask = %v, want 101@5", ask)
+	}
+	if asks := book.Asks(1); len(asks) != 1 {
+		t.Errorf("asks(1) length = %d, want 1", len(asks))
+	}
+}
+
+func TestOrderBook_VWAP(t *testing.T) {
+	book := NewOrderBook("BTCUSDT")
+	book.reset(1,
+		[]Level{level("100", "1"), level("99", "1")},
+		[]Level{level("101", "1"), level("103", "1")},
+	)
+
+	if v, err := book.VWAP(SideAsk, model.MustDecimal("2")); err != nil || v.String() != "102" {
+		t.Errorf("vwap ask = %v, %v, want 102", v, err)
+	}
+	if v, err := book.VWAP(SideBid, model.MustDecimal("1.6")); err != nil || v.String() != "99.625" {
+		t.Errorf("vwap bid = %v, %v, want 99.625", v, err)
+	}
+	if _, err := book.VWAP(SideBid, model.MustDecimal("3")); err != ErrInsufficientDepth {
+		t.Errorf("vwap err = %v, want %v", err, ErrInsufficientDepth)
+	}
+}
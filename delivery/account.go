@@ -0,0 +1,183 @@
+package delivery
+
+import (
+	"context"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"net/http"
+)
+
+// NewOrder
+// Send in a new order (TRADE)
+// POST /dapi/v1/order
+// https://binance-docs.github.io/apidocs/delivery/en/#new-order-trade
+func (r *API) NewOrder(param *OrderParam) (*Order, error) {
+	return r.NewOrderContext(r.api.Context(), param)
+}
+
+// NewOrderContext
+// NewOrder with context of the request
+func (r *API) NewOrderContext(ctx context.Context, param *OrderParam) (*Order, error) {
+	bytes, err := r.api.Request(ctx, http.MethodPost, "/dapi/v1/order", param, model.EndpointSecurityTypeTrade)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseOrder(bytes)
+}
+
+// CancelOrder
+// Cancel an active order (TRADE)
+// DELETE /dapi/v1/order
+// https://binance-docs.github.io/apidocs/delivery/en/#cancel-order-trade
+func (r *API) CancelOrder(param *CancelOrderParam) (*Order, error) {
+	return r.CancelOrderContext(r.api.Context(), param)
+}
+
+// CancelOrderContext
+// CancelOrder with context of the request
+func (r *API) CancelOrderContext(ctx context.Context, param *CancelOrderParam) (*Order, error) {
+	bytes, err := r.api.Request(ctx, http.MethodDelete, "/dapi/v1/order", param, model.EndpointSecurityTypeTrade)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseOrder(bytes)
+}
+
+// GetOrder
+// Check an order's status (USER_DATA)
+// GET /dapi/v1/order
+// https://binance-docs.github.io/apidocs/delivery/en/#query-order-user_data
+func (r *API) GetOrder(param *GetOrderParam) (*Order, error) {
+	return r.GetOrderContext(r.api.Context(), param)
+}
+
+// GetOrderContext
+// GetOrder with context of the request
+func (r *API) GetOrderContext(ctx context.Context, param *GetOrderParam) (*Order, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/dapi/v1/order", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseOrder(bytes)
+}
+
+// GetOpenOrders
+// Get all open orders on a symbol or pair (USER_DATA)
+// GET /dapi/v1/openOrders
+// https://binance-docs.github.io/apidocs/delivery/en/#current-all-open-orders-user_data
+func (r *API) GetOpenOrders(param *GetOpenOrdersParam) ([]*Order, error) {
+	return r.GetOpenOrdersContext(r.api.Context(), param)
+}
+
+// GetOpenOrdersContext
+// GetOpenOrders with context of the request
+func (r *API) GetOpenOrdersContext(ctx context.Context, param *GetOpenOrdersParam) ([]*Order, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/dapi/v1/openOrders", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseOrders(bytes)
+}
+
+// Positions
+// Position Information (USER_DATA)
+// GET /dapi/v1/positionRisk
+// https://binance-docs.github.io/apidocs/delivery/en/#position-information-user_data
+func (r *API) Positions(param *PositionParam) ([]*Position, error) {
+	return r.PositionsContext(r.api.Context(), param)
+}
+
+// PositionsContext
+// Positions with context of the request
+func (r *API) PositionsContext(ctx context.Context, param *PositionParam) ([]*Position, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/dapi/v1/positionRisk", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParsePositions(bytes)
+}
+
+// Account
+// Get current account information (USER_DATA)
+// GET /dapi/v1/account
+// https://binance-docs.github.io/apidocs/delivery/en/#account-information-user_data
+func (r *API) Account(param *AccountParam) (*Account, error) {
+	return r.AccountContext(r.api.Context(), param)
+}
+
+// AccountContext
+// Account with context of the request
+func (r *API) AccountContext(ctx context.Context, param *AccountParam) (*Account, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/dapi/v1/account", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseAccount(bytes)
+}
+
+// ChangeLeverage
+// Change user's initial leverage in the specific symbol market (TRADE)
+// POST /dapi/v1/leverage
+// https://binance-docs.github.io/apidocs/delivery/en/#change-initial-leverage-trade
+func (r *API) ChangeLeverage(param *LeverageParam) (*Leverage, error) {
+	return r.ChangeLeverageContext(r.api.Context(), param)
+}
+
+// ChangeLeverageContext
+// ChangeLeverage with context of the request
+func (r *API) ChangeLeverageContext(ctx context.Context, param *LeverageParam) (*Leverage, error) {
+	bytes, err := r.api.Request(ctx, http.MethodPost, "/dapi/v1/leverage", param, model.EndpointSecurityTypeTrade)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseLeverage(bytes)
+}
+
+// LeverageBrackets
+// Get the symbol's notional bracket list (USER_DATA)
+// GET /dapi/v2/leverageBracket
+// https://binance-docs.github.io/apidocs/delivery/en/#notional-bracket-for-symbol-user_data
+func (r *API) LeverageBrackets(param *LeverageBracketParam) ([]*LeverageBracket, error) {
+	return r.LeverageBracketsContext(r.api.Context(), param)
+}
+
+// LeverageBracketsContext
+// LeverageBrackets with context of the request
+func (r *API) LeverageBracketsContext(ctx context.Context, param *LeverageBracketParam) ([]*LeverageBracket, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/dapi/v2/leverageBracket", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseLeverageBrackets(bytes)
+}
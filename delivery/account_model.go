@@ -0,0 +1,874 @@
+package delivery
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/futures"
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"github.com/buger/jsonparser"
+	"time"
+)
+
+// OrderParam
+// Quantity is number of contracts
+type OrderParam = futures.OrderParam
+
+type CancelOrderParam = futures.CancelOrderParam
+
+type GetOrderParam = futures.GetOrderParam
+
+// GetOpenOrdersParam
+// all symbols when Symbol and Pair are empty
+type GetOpenOrdersParam struct {
+	Symbol     string `json:"symbol" param:"symbol"`
+	Pair       string `json:"pair" param:"pair"`
+	RecvWindow int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type Order struct {
+	Symbol        string        `json:"symbol"`
+	Pair          string        `json:"pair"`
+	OrderId       int64         `json:"orderId"`
+	ClientOrderId string        `json:"clientOrderId"`
+	Price         model.Decimal `json:"price"`
+	AvgPrice      model.Decimal `json:"avgPrice"`
+	OrigQty       model.Decimal `json:"origQty"`
+	ExecutedQty   model.Decimal `json:"executedQty"`
+	CumQty        model.Decimal `json:"cumQty"`
+	CumBase       model.Decimal `json:"cumBase"`
+	Status        string        `json:"status"`
+	TimeInForce   string        `json:"timeInForce"`
+	OrderType     string        `json:"type"`
+	OrigType      string        `json:"origType"`
+	Side          string        `json:"side"`
+	PositionSide  string        `json:"positionSide"`
+	StopPrice     model.Decimal `json:"stopPrice"`
+	ActivatePrice model.Decimal `json:"activatePrice"`
+	PriceRate     model.Decimal `json:"priceRate"`
+	ReduceOnly    bool          `json:"reduceOnly"`
+	ClosePosition bool          `json:"closePosition"`
+	WorkingType   string        `json:"workingType"`
+	PriceProtect  bool          `json:"priceProtect"`
+	Time          time.Time     `json:"time"`
+	UpdateTime    time.Time     `json:"updateTime"`
+}
+
+func (r *Parser) ParseOrder(b []byte) (*Order, error) {
+	result := new(Order)
+
+	if v, err := jsonparser.GetString(b, "symbol"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "pair"); err == nil {
+		result.Pair = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "orderId"); err == nil {
+		result.OrderId = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "clientOrderId"); err == nil {
+		result.ClientOrderId = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "price"); err == nil {
+		result.Price = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "avgPrice"); err == nil {
+		result.AvgPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "origQty"); err == nil {
+		result.OrigQty = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "executedQty"); err == nil {
+		result.ExecutedQty = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "cumQty"); err == nil {
+		result.CumQty = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "cumBase"); err == nil {
+		result.CumBase = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "status"); err == nil {
+		result.Status = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "timeInForce"); err == nil {
+		result.TimeInForce = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "type"); err == nil {
+		result.OrderType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "origType"); err == nil {
+		result.OrigType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "side"); err == nil {
+		result.Side = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "positionSide"); err == nil {
+		result.PositionSide = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "stopPrice"); err == nil {
+		result.StopPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "activatePrice"); err == nil {
+		result.ActivatePrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "priceRate"); err == nil {
+		result.PriceRate = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "reduceOnly"); err == nil {
+		result.ReduceOnly = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "closePosition"); err == nil {
+		result.ClosePosition = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "workingType"); err == nil {
+		result.WorkingType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "priceProtect"); err == nil {
+		result.PriceProtect = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "time"); err == nil {
+		result.Time = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "updateTime"); err == nil {
+		result.UpdateTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) ParseOrders(b []byte) ([]*Order, error) {
+	results := make([]*Order, 0)
+
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.ParseOrder(value); err == nil {
+			results = append(results, item)
+		} else {
+			if _err = r.ParseError(err); _err != nil {
+				return
+			}
+		}
+	})
+	if err = r.ParseError(err); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// PositionParam
+// all positions when MarginAsset and Pair are empty
+type PositionParam struct {
+	MarginAsset string `json:"marginAsset" param:"marginAsset"`
+	Pair        string `json:"pair" param:"pair"`
+	RecvWindow  int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type Position struct {
+	Symbol           string        `json:"symbol"`
+	PositionSide     string        `json:"positionSide"`
+	PositionAmt      model.Decimal `json:"positionAmt"`
+	EntryPrice       model.Decimal `json:"entryPrice"`
+	BreakEvenPrice   model.Decimal `json:"breakEvenPrice"`
+	MarkPrice        model.Decimal `json:"markPrice"`
+	UnRealizedProfit model.Decimal `json:"unRealizedProfit"`
+	LiquidationPrice model.Decimal `json:"liquidationPrice"`
+	Leverage         model.Decimal `json:"leverage"`
+	MaxQty           model.Decimal `json:"maxQty"`
+	MarginType       string        `json:"marginType"`
+	IsolatedMargin   model.Decimal `json:"isolatedMargin"`
+	IsAutoAddMargin  string        `json:"isAutoAddMargin"`
+	NotionalValue    model.Decimal `json:"notionalValue"`
+	UpdateTime       time.Time     `json:"updateTime"`
+}
+
+func (r *Parser) ParsePositions(b []byte) ([]*Position, error) {
+	results := make([]*Position, 0)
+
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parsePosition(value); err == nil {
+			results = append(results, item)
+		} else {
+			if _err = r.ParseError(err); _err != nil {
+				return
+			}
+		}
+	})
+	if err = r.ParseError(err); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *Parser) parsePosition(b []byte) (*Position, error) {
+	result := new(Position)
+
+	if v, err := jsonparser.GetString(b, "symbol"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "positionSide"); err == nil {
+		result.PositionSide = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "positionAmt"); err == nil {
+		result.PositionAmt = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "entryPrice"); err == nil {
+		result.EntryPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "breakEvenPrice"); err == nil {
+		result.BreakEvenPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "markPrice"); err == nil {
+		result.MarkPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "unRealizedProfit"); err == nil {
+		result.UnRealizedProfit = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "liquidationPrice"); err == nil {
+		result.LiquidationPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "leverage"); err == nil {
+		result.Leverage = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "maxQty"); err == nil {
+		result.MaxQty = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "marginType"); err == nil {
+		result.MarginType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "isolatedMargin"); err == nil {
+		result.IsolatedMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "isAutoAddMargin"); err == nil {
+		result.IsAutoAddMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "notionalValue"); err == nil {
+		result.NotionalValue = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "updateTime"); err == nil {
+		result.UpdateTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+type LeverageParam = futures.LeverageParam
+
+type Leverage struct {
+	Symbol   string        `json:"symbol"`
+	Leverage int64         `json:"leverage"`
+	MaxQty   model.Decimal `json:"maxQty"`
+}
+
+func (r *Parser) ParseLeverage(b []byte) (*Leverage, error) {
+	result := new(Leverage)
+
+	if v, err := jsonparser.GetString(b, "symbol"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "leverage"); err == nil {
+		result.Leverage = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "maxQty"); err == nil {
+		result.MaxQty = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// LeverageBracketParam
+// all symbols when Symbol is empty
+type LeverageBracketParam struct {
+	Symbol     string `json:"symbol" param:"symbol"`
+	RecvWindow int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type LeverageBracket struct {
+	Symbol   string     `json:"symbol"`
+	Brackets []*Bracket `json:"brackets"`
+}
+
+type Bracket struct {
+	Bracket          int64         `json:"bracket"`
+	InitialLeverage  int64         `json:"initialLeverage"`
+	QtyCap           model.Decimal `json:"qtyCap"`
+	QtyFloor         model.Decimal `json:"qtyFloor"`
+	MaintMarginRatio model.Decimal `json:"maintMarginRatio"`
+	Cum              model.Decimal `json:"cum"`
+}
+
+func (r *Parser) ParseLeverageBrackets(b []byte) ([]*LeverageBracket, error) {
+	results := make([]*LeverageBracket, 0)
+
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseLeverageBracket(value); err == nil {
+			results = append(results, item)
+		} else {
+			if _err = r.ParseError(err); _err != nil {
+				return
+			}
+		}
+	})
+	if err = r.ParseError(err); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *Parser) parseLeverageBracket(b []byte) (*LeverageBracket, error) {
+	result := new(LeverageBracket)
+
+	if v, err := jsonparser.GetString(b, "symbol"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	result.Brackets = make([]*Bracket, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseBracket(value); err == nil {
+			result.Brackets = append(result.Brackets, item)
+		} else {
+			if _err = r.ParseError(err); _err != nil {
+				return
+			}
+		}
+	}, "brackets"); err != nil {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseBracket(b []byte) (*Bracket, error) {
+	result := new(Bracket)
+
+	if v, err := jsonparser.GetInt(b, "bracket"); err == nil {
+		result.Bracket = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "initialLeverage"); err == nil {
+		result.InitialLeverage = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "qtyCap"); err == nil {
+		result.QtyCap = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "qtyFloor"); err == nil {
+		result.QtyFloor = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "maintMarginRatio"); err == nil {
+		result.MaintMarginRatio = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "cum"); err == nil {
+		result.Cum = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+type AccountParam = futures.AccountParam
+
+type Account struct {
+	FeeTier     int64              `json:"feeTier"`
+	CanTrade    bool               `json:"canTrade"`
+	CanDeposit  bool               `json:"canDeposit"`
+	CanWithdraw bool               `json:"canWithdraw"`
+	UpdateTime  time.Time          `json:"updateTime"`
+	Assets      []*AccountAsset    `json:"assets"`
+	Positions   []*AccountPosition `json:"positions"`
+}
+
+type AccountAsset struct {
+	Asset                  string        `json:"asset"`
+	WalletBalance          model.Decimal `json:"walletBalance"`
+	UnrealizedProfit       model.Decimal `json:"unrealizedProfit"`
+	MarginBalance          model.Decimal `json:"marginBalance"`
+	MaintMargin            model.Decimal `json:"maintMargin"`
+	InitialMargin          model.Decimal `json:"initialMargin"`
+	PositionInitialMargin  model.Decimal `json:"positionInitialMargin"`
+	OpenOrderInitialMargin model.Decimal `json:"openOrderInitialMargin"`
+	MaxWithdrawAmount      model.Decimal `json:"maxWithdrawAmount"`
+	CrossWalletBalance     model.Decimal `json:"crossWalletBalance"`
+	CrossUnPnl             model.Decimal `json:"crossUnPnl"`
+	AvailableBalance       model.Decimal `json:"availableBalance"`
+}
+
+type AccountPosition struct {
+	Symbol                 string        `json:"symbol"`
+	PositionSide           string        `json:"positionSide"`
+	PositionAmt            model.Decimal `json:"positionAmt"`
+	EntryPrice             model.Decimal `json:"entryPrice"`
+	BreakEvenPrice         model.Decimal `json:"breakEvenPrice"`
+	UnrealizedProfit       model.Decimal `json:"unrealizedProfit"`
+	Leverage               model.Decimal `json:"leverage"`
+	Isolated               bool          `json:"isolated"`
+	InitialMargin          model.Decimal `json:"initialMargin"`
+	MaintMargin            model.Decimal `json:"maintMargin"`
+	PositionInitialMargin  model.Decimal `json:"positionInitialMargin"`
+	OpenOrderInitialMargin model.Decimal `json:"openOrderInitialMargin"`
+	MaxQty                 model.Decimal `json:"maxQty"`
+	UpdateTime             time.Time     `json:"updateTime"`
+}
+
+func (r *Parser) ParseAccount(b []byte) (*Account, error) {
+	result := new(Account)
+
+	if v, err := jsonparser.GetInt(b, "feeTier"); err == nil {
+		result.FeeTier = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "canTrade"); err == nil {
+		result.CanTrade = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "canDeposit"); err == nil {
+		result.CanDeposit = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "canWithdraw"); err == nil {
+		result.CanWithdraw = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "updateTime"); err == nil {
+		result.UpdateTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	result.Assets = make([]*AccountAsset, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseAccountAsset(value); err == nil {
+			result.Assets = append(result.Assets, item)
+		} else {
+			if _err = r.ParseError(err); _err != nil {
+				return
+			}
+		}
+	}, "assets"); err != nil {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	result.Positions = make([]*AccountPosition, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseAccountPosition(value); err == nil {
+			result.Positions = append(result.Positions, item)
+		} else {
+			if _err = r.ParseError(err); _err != nil {
+				return
+			}
+		}
+	}, "positions"); err != nil {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseAccountAsset(b []byte) (*AccountAsset, error) {
+	result := new(AccountAsset)
+
+	if v, err := jsonparser.GetString(b, "asset"); err == nil {
+		result.Asset = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "walletBalance"); err == nil {
+		result.WalletBalance = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "unrealizedProfit"); err == nil {
+		result.UnrealizedProfit = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "marginBalance"); err == nil {
+		result.MarginBalance = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "maintMargin"); err == nil {
+		result.MaintMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "initialMargin"); err == nil {
+		result.InitialMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "positionInitialMargin"); err == nil {
+		result.PositionInitialMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "openOrderInitialMargin"); err == nil {
+		result.OpenOrderInitialMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "maxWithdrawAmount"); err == nil {
+		result.MaxWithdrawAmount = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "crossWalletBalance"); err == nil {
+		result.CrossWalletBalance = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "crossUnPnl"); err == nil {
+		result.CrossUnPnl = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "availableBalance"); err == nil {
+		result.AvailableBalance = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseAccountPosition(b []byte) (*AccountPosition, error) {
+	result := new(AccountPosition)
+
+	if v, err := jsonparser.GetString(b, "symbol"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "positionSide"); err == nil {
+		result.PositionSide = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "positionAmt"); err == nil {
+		result.PositionAmt = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "entryPrice"); err == nil {
+		result.EntryPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "breakEvenPrice"); err == nil {
+		result.BreakEvenPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "unrealizedProfit"); err == nil {
+		result.UnrealizedProfit = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "leverage"); err == nil {
+		result.Leverage = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "isolated"); err == nil {
+		result.Isolated = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "initialMargin"); err == nil {
+		result.InitialMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "maintMargin"); err == nil {
+		result.MaintMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "positionInitialMargin"); err == nil {
+		result.PositionInitialMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "openOrderInitialMargin"); err == nil {
+		result.OpenOrderInitialMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "maxQty"); err == nil {
+		result.MaxQty = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "updateTime"); err == nil {
+		result.UpdateTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,76 @@
+package delivery
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"github.com/NattapornTee22816/binance-connector-golang/spot"
+	"net/http"
+)
+
+// DefaultRateLimits
+// limits of dapi.binance.com, replaced by ExchangeInformation.RateLimits once it is loaded
+var DefaultRateLimits = []*model.RateLimit{
+	{RateLimitType: model.RateLimiterWeight, Interval: model.RateLimitIntervalMinute, IntervalNum: 1, Limit: 2400},
+	{RateLimitType: model.RateLimiterOrders, Interval: model.RateLimitIntervalMinute, IntervalNum: 1, Limit: 1200},
+}
+
+// https://binance-docs.github.io/apidocs/delivery/en/#limits
+var endpointWeights = []*spot.EndpointWeight{
+	{HttpMethod: http.MethodGet, UrlPath: "/dapi/v1/exchangeInfo", Weight: 1},
+	{HttpMethod: http.MethodGet, UrlPath: "/dapi/v1/klines", Weight: 5},
+	{HttpMethod: http.MethodGet, UrlPath: "/dapi/v1/indexPriceKlines", Weight: 5},
+	{HttpMethod: http.MethodGet, UrlPath: "/dapi/v1/markPriceKlines", Weight: 5},
+	{HttpMethod: http.MethodPost, UrlPath: "/dapi/v1/order", Weight: 1, Orders: 1},
+	{HttpMethod: http.MethodGet, UrlPath: "/dapi/v1/positionRisk", Weight: 1},
+	{HttpMethod: http.MethodGet, UrlPath: "/dapi/v1/account", Weight: 5},
+	{HttpMethod: http.MethodGet, UrlPath: "/dapi/v2/leverageBracket", Weight: 1},
+}
+
+var marketConfig = &spot.MarketConfig{
+	ServerTimePath:  "/dapi/v1/time",
+	ListenKeyPath:   "/dapi/v1/listenKey",
+	RateLimits:      DefaultRateLimits,
+	EndpointWeights: endpointWeights,
+}
+
+// NewRateLimiter
+// rate limiter with limits and endpoint weights of COIN-M futures
+func NewRateLimiter(configs ...spot.RateLimiterConfig) *spot.RateLimiter {
+	return marketConfig.NewRateLimiter(configs...)
+}
+
+// API
+//   - client of COIN-M futures, signing, clock, rate limit, retry and error handling are shared with spot.API
+//   - listenKey of user data stream: CreateListenKey, KeepAliveListenKey and CloseListenKey of spot.MarketAPI,
+//     https://binance-docs.github.io/apidocs/delivery/en/#user-data-streams
+type API struct {
+	*spot.MarketAPI
+	api    *spot.API
+	logger *lib.BinanceLogger
+	parser *Parser
+}
+
+// NewAPI
+//   - https://binance-docs.github.io/apidocs/delivery/en/#general-info
+//   - options of spot.API, base url is https://dapi.binance.com by default
+func NewAPI(key string, secret string, opts ...spot.APIOption) (*API, error) {
+	return newAPI(key, secret, append([]spot.APIOption{spot.WithBaseUrl("https://dapi.binance.com")}, opts...))
+}
+
+func NewTestnetAPI(key, secret string, opts ...spot.APIOption) (*API, error) {
+	return newAPI(key, secret, append([]spot.APIOption{spot.WithBaseUrl("https://testnet.binancefuture.com")}, opts...))
+}
+
+func newAPI(key string, secret string, opts []spot.APIOption) (*API, error) {
+	market, err := spot.NewMarketAPI(key, secret, marketConfig, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &API{
+		MarketAPI: market,
+		api:       market.API(),
+		logger:    market.API().Logger(),
+		parser:    NewParser(),
+	}, nil
+}
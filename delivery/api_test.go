@@ -0,0 +1,97 @@
+package delivery
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/internal/apitest"
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"github.com/NattapornTee22816/binance-connector-golang/spot"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestAPI(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) (*API, *apitest.Server) {
+	fake := apitest.NewServer(t, "/dapi/v1/time", func(w http.ResponseWriter, r *http.Request, _ int) {
+		handler(w, r)
+	})
+	api, err := NewAPI("key", "secret", spot.WithBaseUrl(fake.URL), spot.WithLogLevel(lib.LogLevelInfo))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(api.Close)
+	api.SetRetryPolicy(&spot.BackoffRetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond})
+	return api, fake
+}
+
+func TestAPI_NewOrder(t *testing.T) {
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"orderId":1,"symbol":"BTCUSD_PERP","pair":"BTCUSD","status":"NEW","origQty":"2"}`))
+	})
+
+	order, err := api.NewOrder(&OrderParam{
+		Symbol:       "BTCUSD_PERP",
+		Side:         model.OrderSideBuy,
+		PositionSide: PositionSideLong,
+		OrderType:    OrderTypeMarket,
+		Quantity:     model.MustDecimal("2"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.Pair != "BTCUSD" {
+		t.Errorf("order = %+v", order)
+	}
+
+	request := fake.Last()
+	if request.Method != http.MethodPost || request.URL.Path != "/dapi/v1/order" {
+		t.Errorf("request = %s %s", request.Method, request.URL.Path)
+	}
+	if query := request.URL.Query(); query.Get("positionSide") != "LONG" || len(query.Get("signature")) == 0 {
+		t.Errorf("query = %v", query)
+	}
+}
+
+func TestAPI_IndexPriceKlines(t *testing.T) {
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[[1591256400000,"9653.69440000","9653.69640000","9651.38600000","9651.55200000","0",1591256459999,"0",60,"0","0","0"]]`))
+	})
+
+	klines, err := api.IndexPriceKlines(&PairKlineParam{Pair: "BTCUSD", Interval: model.Interval1Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(klines) != 1 || klines[0].Close.String() != "9651.55200000" || klines[0].NumberOfTrades != 60 {
+		t.Errorf("klines = %+v", klines)
+	}
+
+	request := fake.Last()
+	if request.URL.Path != "/dapi/v1/indexPriceKlines" || request.URL.Query().Get("pair") != "BTCUSD" {
+		t.Errorf("request = %s", request.URL)
+	}
+}
+
+func TestAPI_ListenKey(t *testing.T) {
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"listenKey":"pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1"}`))
+	})
+
+	listenKey, err := api.CreateListenKey()
+	if err != nil || len(listenKey) == 0 {
+		t.Fatalf("listenKey = %s, err = %v", listenKey, err)
+	}
+	if err = api.KeepAliveListenKey(listenKey); err != nil {
+		t.Fatal(err)
+	}
+	if err = api.CloseListenKey(listenKey); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "POST /dapi/v1/listenKey,PUT /dapi/v1/listenKey,DELETE /dapi/v1/listenKey"
+	if calls := strings.Join(fake.Calls(), ","); calls != want {
+		t.Errorf("calls = %s", calls)
+	}
+	if v := fake.Last().Header.Get("X-MBX-APIKEY"); v != "key" {
+		t.Errorf("api key = %s", v)
+	}
+}
@@ -0,0 +1,53 @@
+package delivery
+
+import "github.com/NattapornTee22816/binance-connector-golang/futures"
+
+// enums shared with USDⓈ-M futures
+
+type PositionSide = futures.PositionSide
+
+var (
+	PositionSideBoth  = futures.PositionSideBoth
+	PositionSideLong  = futures.PositionSideLong
+	PositionSideShort = futures.PositionSideShort
+)
+
+type OrderType = futures.OrderType
+
+var (
+	OrderTypeLimit              = futures.OrderTypeLimit
+	OrderTypeMarket             = futures.OrderTypeMarket
+	OrderTypeStop               = futures.OrderTypeStop
+	OrderTypeStopMarket         = futures.OrderTypeStopMarket
+	OrderTypeTakeProfit         = futures.OrderTypeTakeProfit
+	OrderTypeTakeProfitMarket   = futures.OrderTypeTakeProfitMarket
+	OrderTypeTrailingStopMarket = futures.OrderTypeTrailingStopMarket
+)
+
+type WorkingType = futures.WorkingType
+
+var (
+	WorkingTypeMarkPrice     = futures.WorkingTypeMarkPrice
+	WorkingTypeContractPrice = futures.WorkingTypeContractPrice
+)
+
+type ContractType = futures.ContractType
+
+var (
+	ContractTypePerpetual      = futures.ContractTypePerpetual
+	ContractTypeCurrentQuarter = futures.ContractTypeCurrentQuarter
+	ContractTypeNextQuarter    = futures.ContractTypeNextQuarter
+)
+
+type ContractStatus = string
+
+var (
+	ContractStatusPendingTrading = ContractStatus("PENDING_TRADING")
+	ContractStatusTrading        = ContractStatus("TRADING")
+	ContractStatusPreDelivering  = ContractStatus("PRE_DELIVERING")
+	ContractStatusDelivering     = ContractStatus("DELIVERING")
+	ContractStatusDelivered      = ContractStatus("DELIVERED")
+	ContractStatusPreSettle      = ContractStatus("PRE_SETTLE")
+	ContractStatusSettling       = ContractStatus("SETTLING")
+	ContractStatusClose          = ContractStatus("CLOSE")
+)
@@ -0,0 +1,166 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"net/http"
+)
+
+// Ping
+// Test connectivity to the Rest API.
+// GET /dapi/v1/ping
+// https://binance-docs.github.io/apidocs/delivery/en/#test-connectivity
+func (r *API) Ping() error {
+	return r.PingContext(r.api.Context())
+}
+
+// PingContext
+// Ping with context of the request
+func (r *API) PingContext(ctx context.Context) error {
+	_, err := r.api.Request(ctx, http.MethodGet, "/dapi/v1/ping", nil, model.EndpointSecurityTypeNone)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+	}
+	return err
+}
+
+// CheckServerTime
+// Test connectivity to the Rest API and get the current server time.
+// GET /dapi/v1/time
+// https://binance-docs.github.io/apidocs/delivery/en/#check-server-time
+func (r *API) CheckServerTime() (int64, error) {
+	return r.CheckServerTimeContext(r.api.Context())
+}
+
+// CheckServerTimeContext
+// CheckServerTime with context of the request
+func (r *API) CheckServerTimeContext(ctx context.Context) (int64, error) {
+	return r.api.CheckServerTimeContext(ctx)
+}
+
+// ExchangeInformation
+// Current exchange trading rules and symbol information
+// GET /dapi/v1/exchangeInfo
+// https://binance-docs.github.io/apidocs/delivery/en/#exchange-information
+func (r *API) ExchangeInformation() (*ExchangeInformation, error) {
+	return r.ExchangeInformationContext(r.api.Context())
+}
+
+// ExchangeInformationContext
+// ExchangeInformation with context of the request
+func (r *API) ExchangeInformationContext(ctx context.Context) (*ExchangeInformation, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/dapi/v1/exchangeInfo", nil, model.EndpointSecurityTypeNone)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	body := new(ExchangeInformation)
+	if err := json.Unmarshal(bytes, &body); err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+	if limiter := r.RateLimiter(); limiter != nil && len(body.RateLimits) > 0 {
+		limiter.SetRateLimits(body.RateLimits)
+	}
+
+	return body, nil
+}
+
+// Klines
+// Kline/candlestick bars for a symbol.
+// Klines are uniquely identified by their open time.
+// GET /dapi/v1/klines
+// https://binance-docs.github.io/apidocs/delivery/en/#kline-candlestick-data
+func (r *API) Klines(param *KlineParam) ([]*model.Kline, error) {
+	return r.KlinesContext(r.api.Context(), param)
+}
+
+// KlinesContext
+// Klines with context of the request
+func (r *API) KlinesContext(ctx context.Context, param *KlineParam) ([]*model.Kline, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/dapi/v1/klines", param, model.EndpointSecurityTypeNone)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseKline(bytes)
+}
+
+// ContinuousKlines
+// Kline/candlestick bars for a specific contract type.
+// GET /dapi/v1/continuousKlines
+// https://binance-docs.github.io/apidocs/delivery/en/#continuous-contract-kline-candlestick-data
+func (r *API) ContinuousKlines(param *PairKlineParam) ([]*model.Kline, error) {
+	return r.ContinuousKlinesContext(r.api.Context(), param)
+}
+
+// ContinuousKlinesContext
+// ContinuousKlines with context of the request
+func (r *API) ContinuousKlinesContext(ctx context.Context, param *PairKlineParam) ([]*model.Kline, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/dapi/v1/continuousKlines", param, model.EndpointSecurityTypeNone)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseKline(bytes)
+}
+
+// IndexPriceKlines
+// Kline/candlestick bars for the index price of a pair.
+// volumes of the klines are 0
+// GET /dapi/v1/indexPriceKlines
+// https://binance-docs.github.io/apidocs/delivery/en/#index-price-kline-candlestick-data
+func (r *API) IndexPriceKlines(param *PairKlineParam) ([]*model.Kline, error) {
+	return r.IndexPriceKlinesContext(r.api.Context(), param)
+}
+
+// IndexPriceKlinesContext
+// IndexPriceKlines with context of the request
+func (r *API) IndexPriceKlinesContext(ctx context.Context, param *PairKlineParam) ([]*model.Kline, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/dapi/v1/indexPriceKlines", param, model.EndpointSecurityTypeNone)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseKline(bytes)
+}
+
+// MarkPriceKlines
+// Kline/candlestick bars for the mark price of a symbol.
+// volumes of the klines are 0
+// GET /dapi/v1/markPriceKlines
+// https://binance-docs.github.io/apidocs/delivery/en/#mark-price-kline-candlestick-data
+func (r *API) MarkPriceKlines(param *KlineParam) ([]*model.Kline, error) {
+	return r.MarkPriceKlinesContext(r.api.Context(), param)
+}
+
+// MarkPriceKlinesContext
+// MarkPriceKlines with context of the request
+func (r *API) MarkPriceKlinesContext(ctx context.Context, param *KlineParam) ([]*model.Kline, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/dapi/v1/markPriceKlines", param, model.EndpointSecurityTypeNone)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseKline(bytes)
+}
@@ -0,0 +1,79 @@
+package delivery
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/futures"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"time"
+)
+
+type ExchangeInformation struct {
+	Timezone        string             `json:"timezone"`
+	ServerTime      int64              `json:"serverTime"`
+	RateLimits      []*model.RateLimit `json:"rateLimits"`
+	ExchangeFilters []struct{}         `json:"exchangeFilters"`
+	Symbols         []*SymbolInfo      `json:"symbols"`
+}
+
+// SymbolInfo
+// find symbol information by name, return false when the symbol is not listed
+func (e *ExchangeInformation) SymbolInfo(symbol string) (*SymbolInfo, bool) {
+	for _, info := range e.Symbols {
+		if info.Symbol == symbol {
+			return info, true
+		}
+	}
+	return nil, false
+}
+
+// SymbolInfo
+//   - ContractSize is the value of one contract in quote asset, e.g. 100 USD of BTCUSD_PERP
+//   - DeliveryDate of perpetual contract is far in the future
+type SymbolInfo struct {
+	Symbol                string                `json:"symbol"`
+	Pair                  string                `json:"pair"`
+	ContractType          ContractType          `json:"contractType"`
+	DeliveryDate          int64                 `json:"deliveryDate"`
+	OnboardDate           int64                 `json:"onboardDate"`
+	ContractStatus        ContractStatus        `json:"contractStatus"`
+	ContractSize          int64                 `json:"contractSize"`
+	MarginAsset           string                `json:"marginAsset"`
+	MaintMarginPercent    model.Decimal         `json:"maintMarginPercent"`
+	RequiredMarginPercent model.Decimal         `json:"requiredMarginPercent"`
+	BaseAsset             string                `json:"baseAsset"`
+	QuoteAsset            string                `json:"quoteAsset"`
+	PricePrecision        int64                 `json:"pricePrecision"`
+	QuantityPrecision     int64                 `json:"quantityPrecision"`
+	BaseAssetPrecision    int64                 `json:"baseAssetPrecision"`
+	QuotePrecision        int64                 `json:"quotePrecision"`
+	EqualQtyPrecision     int64                 `json:"equalQtyPrecision"`
+	UnderlyingType        string                `json:"underlyingType"`
+	UnderlyingSubType     []string              `json:"underlyingSubType"`
+	TriggerProtect        model.Decimal         `json:"triggerProtect"`
+	LiquidationFee        model.Decimal         `json:"liquidationFee"`
+	MarketTakeBound       model.Decimal         `json:"marketTakeBound"`
+	Filters               []*model.SymbolFilter `json:"filters"`
+	OrderTypes            []OrderType           `json:"orderTypes"`
+	TimeInForce           []model.TimeInForce   `json:"timeInForce"`
+}
+
+// DeliveryTime
+// delivery date of the contract
+func (s *SymbolInfo) DeliveryTime() time.Time {
+	return time.UnixMilli(s.DeliveryDate)
+}
+
+// KlineParam
+// Symbol is a contract, e.g. BTCUSD_PERP or BTCUSD_220624
+type KlineParam = futures.KlineParam
+
+// PairKlineParam
+//   - Pair is the underlying, e.g. BTCUSD
+//   - ContractType is required by continuous contract klines
+type PairKlineParam struct {
+	Pair         string         `json:"pair" param:"pair" validate:"required"`
+	ContractType ContractType   `json:"contractType" param:"contractType"`
+	Interval     model.Interval `json:"interval" param:"interval" validate:"required"`
+	StartTime    time.Time      `json:"startTime" param:"startTime"`
+	EndTime      time.Time      `json:"endTime" param:"endTime"`
+	Limit        int64          `json:"limit" param:"limit" validate:"min=0,max=1500"`
+}
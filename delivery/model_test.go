@@ -0,0 +1,82 @@
+package delivery
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// ExchangeInformation
+func TestExchangeInformation_Unmarshal(t *testing.T) {
+	bytes := []byte(`{"exchangeFilters":[],"rateLimits":[{"interval":"MINUTE","intervalNum":1,"limit":6000,"rateLimitType":"REQUEST_WEIGHT"}],"serverTime":1565613908500,"symbols":[{"filters":[{"filterType":"PRICE_FILTER","maxPrice":"100000","minPrice":"0.1","tickSize":"0.1"},{"filterType":"LOT_SIZE","maxQty":"100000","minQty":"1","stepSize":"1"}],"orderTypes":["LIMIT","MARKET"],"timeInForce":["GTC","IOC","FOK","GTX"],"liquidationFee":"0.010000","marketTakeBound":"0.30","symbol":"BTCUSD_200925","pair":"BTCUSD","contractType":"CURRENT_QUARTER","deliveryDate":1601020800000,"onboardDate":1590739200000,"contractStatus":"TRADING","contractSize":100,"quoteAsset":"USD","baseAsset":"BTC","marginAsset":"BTC","pricePrecision":1,"quantityPrecision":0,"baseAssetPrecision":8,"quotePrecision":8,"equalQtyPrecision":4,"triggerProtect":"0.0500","maintMarginPercent":"2.5000","requiredMarginPercent":"5.0000","underlyingType":"COIN","underlyingSubType":[]}],"timezone":"UTC"}`)
+
+	result := new(ExchangeInformation)
+	if err := json.Unmarshal(bytes, result); err != nil {
+		t.Fatal(err)
+	}
+	info, ok := result.SymbolInfo("BTCUSD_200925")
+	if !ok || info.ContractType != ContractTypeCurrentQuarter || info.ContractStatus != ContractStatusTrading || info.ContractSize != 100 {
+		t.Errorf("symbol = %+v", info)
+	}
+	if info.DeliveryTime().UnixMilli() != 1601020800000 {
+		t.Errorf("delivery time = %v", info.DeliveryTime())
+	}
+}
+
+// NewOrder, CancelOrder, GetOrder
+func TestParser_ParseOrder(t *testing.T) {
+	bytes := []byte(`{"clientOrderId":"testOrder","cumQty":"0","cumBase":"0","executedQty":"0","orderId":22542179,"avgPrice":"0.0","origQty":"10","price":"0","reduceOnly":false,"side":"BUY","positionSide":"SHORT","status":"NEW","stopPrice":"9300","closePosition":false,"symbol":"BTCUSD_200925","pair":"BTCUSD","timeInForce":"GTC","type":"TRAILING_STOP_MARKET","origType":"TRAILING_STOP_MARKET","activatePrice":"9020","priceRate":"0.3","updateTime":1566818724722,"workingType":"CONTRACT_PRICE","priceProtect":false}`)
+
+	parser := NewParser()
+	result, err := parser.ParseOrder(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.OrderId != 22542179 || result.Pair != "BTCUSD" || result.CumBase.String() != "0" || result.OrigQty.String() != "10" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+// Positions
+func TestParser_ParsePositions(t *testing.T) {
+	bytes := []byte(`[{"symbol":"BTCUSD_201225","positionAmt":"0","entryPrice":"0.0","breakEvenPrice":"0.0","markPrice":"0.00000000","unRealizedProfit":"0.00000000","liquidationPrice":"0","leverage":"125","maxQty":"50","marginType":"cross","isolatedMargin":"0.00000000","isAutoAddMargin":"false","positionSide":"BOTH","notionalValue":"0","isolatedWallet":"0","updateTime":0}]`)
+
+	parser := NewParser()
+	result, err := parser.ParsePositions(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 1 || result[0].Leverage.String() != "125" || result[0].MaxQty.String() != "50" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+// LeverageBrackets
+func TestParser_ParseLeverageBrackets(t *testing.T) {
+	bytes := []byte(`[{"symbol":"BTCUSD_PERP","notionalCoef":1.50,"brackets":[{"bracket":1,"initialLeverage":125,"qtyCap":50,"qtyFloor":0,"maintMarginRatio":0.004,"cum":0.0},{"bracket":2,"initialLeverage":100,"qtyCap":100,"qtyFloor":50,"maintMarginRatio":0.005,"cum":0.05}]}]`)
+
+	parser := NewParser()
+	result, err := parser.ParseLeverageBrackets(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 1 || result[0].Symbol != "BTCUSD_PERP" || len(result[0].Brackets) != 2 {
+		t.Fatalf("result = %+v", result)
+	}
+	if b := result[0].Brackets[1]; b.InitialLeverage != 100 || b.MaintMarginRatio.String() != "0.005" || b.QtyFloor.String() != "50" {
+		t.Errorf("bracket = %+v", b)
+	}
+}
+
+// Account
+func TestParser_ParseAccount(t *testing.T) {
+	bytes := []byte(`{"assets":[{"asset":"BTC","walletBalance":"0.00241969","unrealizedProfit":"0.00000000","marginBalance":"0.00241969","maintMargin":"0.00000000","initialMargin":"0.00000000","positionInitialMargin":"0.00000000","openOrderInitialMargin":"0.00000000","maxWithdrawAmount":"0.00241969","crossWalletBalance":"0.00241969","crossUnPnl":"0.00000000","availableBalance":"0.00241969"}],"positions":[{"symbol":"BTCUSD_201225","positionAmt":"0","initialMargin":"0","maintMargin":"0","unrealizedProfit":"0.00000000","positionInitialMargin":"0","openOrderInitialMargin":"0","leverage":"125","isolated":false,"positionSide":"BOTH","entryPrice":"0.0","breakEvenPrice":"0.0","maxQty":"50","updateTime":0}],"canDeposit":true,"canTrade":true,"canWithdraw":true,"feeTier":2,"updateTime":0}`)
+
+	parser := NewParser()
+	result, err := parser.ParseAccount(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.FeeTier != 2 || len(result.Assets) != 1 || result.Assets[0].WalletBalance.String() != "0.00241969" || len(result.Positions) != 1 {
+		t.Errorf("result = %+v", result)
+	}
+}
@@ -0,0 +1,17 @@
+package delivery
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+)
+
+// Parser
+// parser of COIN-M futures responses, missing key is a warning as model.Parser
+type Parser struct {
+	*model.Parser
+}
+
+func NewParser(options ...*model.ParserOption) *Parser {
+	return &Parser{
+		Parser: model.NewParser(options...),
+	}
+}
@@ -0,0 +1,30 @@
+package delivery
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/futures"
+	"github.com/NattapornTee22816/binance-connector-golang/websocket"
+)
+
+// NewMarketStream
+//   - market streams of COIN-M futures, the events are in the same format as USDⓈ-M futures
+//   - stream name of a contract is in lower case, e.g. btcusd_perp@markPrice
+//   - https://binance-docs.github.io/apidocs/delivery/en/#websocket-market-streams
+func NewMarketStream(opts ...websocket.StreamOption) (*futures.MarketStream, error) {
+	return futures.NewMarketStream(append([]websocket.StreamOption{websocket.WithBaseUrl("wss://dstream.binance.com/stream")}, opts...)...)
+}
+
+func NewTestnetMarketStream(opts ...websocket.StreamOption) (*futures.MarketStream, error) {
+	return futures.NewMarketStream(append([]websocket.StreamOption{websocket.WithBaseUrl("wss://dstream.binancefuture.com/stream")}, opts...)...)
+}
+
+// NewUserDataStream
+//   - obtain listenKey from service, e.g. delivery.API, and connect to user data stream
+//   - ORDER_TRADE_UPDATE, ACCOUNT_UPDATE and MARGIN_CALL are in the same format as USDⓈ-M futures
+//   - https://binance-docs.github.io/apidocs/delivery/en/#user-data-streams
+func NewUserDataStream(service websocket.ListenKeyService, opts ...websocket.StreamOption) (*futures.UserDataStream, error) {
+	return futures.NewUserDataStream(service, append([]websocket.StreamOption{websocket.WithBaseUrl("wss://dstream.binance.com/ws")}, opts...)...)
+}
+
+func NewTestnetUserDataStream(service websocket.ListenKeyService, opts ...websocket.StreamOption) (*futures.UserDataStream, error) {
+	return futures.NewUserDataStream(service, append([]websocket.StreamOption{websocket.WithBaseUrl("wss://dstream.binancefuture.com/ws")}, opts...)...)
+}
@@ -0,0 +1,271 @@
+package futures
+
+import (
+	"context"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"net/http"
+	"strconv"
+)
+
+// NewOrder
+// Send in a new order (TRADE)
+// POST /fapi/v1/order
+// https://binance-docs.github.io/apidocs/futures/en/#new-order-trade
+func (r *API) NewOrder(param *OrderParam) (*Order, error) {
+	return r.NewOrderContext(r.api.Context(), param)
+}
+
+// NewOrderContext
+// NewOrder with context of the request
+func (r *API) NewOrderContext(ctx context.Context, param *OrderParam) (*Order, error) {
+	bytes, err := r.api.Request(ctx, http.MethodPost, "/fapi/v1/order", param, model.EndpointSecurityTypeTrade)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseOrder(bytes)
+}
+
+// CancelOrder
+// Cancel an active order (TRADE)
+// DELETE /fapi/v1/order
+// https://binance-docs.github.io/apidocs/futures/en/#cancel-order-trade
+func (r *API) CancelOrder(param *CancelOrderParam) (*Order, error) {
+	return r.CancelOrderContext(r.api.Context(), param)
+}
+
+// CancelOrderContext
+// CancelOrder with context of the request
+func (r *API) CancelOrderContext(ctx context.Context, param *CancelOrderParam) (*Order, error) {
+	bytes, err := r.api.Request(ctx, http.MethodDelete, "/fapi/v1/order", param, model.EndpointSecurityTypeTrade)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseOrder(bytes)
+}
+
+// GetOrder
+// Check an order's status (USER_DATA)
+// GET /fapi/v1/order
+// https://binance-docs.github.io/apidocs/futures/en/#query-order-user_data
+func (r *API) GetOrder(param *GetOrderParam) (*Order, error) {
+	return r.GetOrderContext(r.api.Context(), param)
+}
+
+// GetOrderContext
+// GetOrder with context of the request
+func (r *API) GetOrderContext(ctx context.Context, param *GetOrderParam) (*Order, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/fapi/v1/order", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseOrder(bytes)
+}
+
+// GetOpenOrders
+// Get all open orders on a symbol, all symbols when Symbol is empty (USER_DATA)
+// GET /fapi/v1/openOrders
+// https://binance-docs.github.io/apidocs/futures/en/#current-all-open-orders-user_data
+func (r *API) GetOpenOrders(param *GetOpenOrdersParam) ([]*Order, error) {
+	return r.GetOpenOrdersContext(r.api.Context(), param)
+}
+
+// GetOpenOrdersContext
+// GetOpenOrders with context of the request
+func (r *API) GetOpenOrdersContext(ctx context.Context, param *GetOpenOrdersParam) ([]*Order, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/fapi/v1/openOrders", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseOrders(bytes)
+}
+
+// Positions
+// Position Information V2 (USER_DATA)
+// GET /fapi/v2/positionRisk
+// https://binance-docs.github.io/apidocs/futures/en/#position-information-v2-user_data
+func (r *API) Positions(param *PositionParam) ([]*Position, error) {
+	return r.PositionsContext(r.api.Context(), param)
+}
+
+// PositionsContext
+// Positions with context of the request
+func (r *API) PositionsContext(ctx context.Context, param *PositionParam) ([]*Position, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/fapi/v2/positionRisk", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParsePositions(bytes)
+}
+
+// ChangeLeverage
+// Change user's initial leverage of specific symbol market (TRADE)
+// POST /fapi/v1/leverage
+// https://binance-docs.github.io/apidocs/futures/en/#change-initial-leverage-trade
+func (r *API) ChangeLeverage(param *LeverageParam) (*Leverage, error) {
+	return r.ChangeLeverageContext(r.api.Context(), param)
+}
+
+// ChangeLeverageContext
+// ChangeLeverage with context of the request
+func (r *API) ChangeLeverageContext(ctx context.Context, param *LeverageParam) (*Leverage, error) {
+	bytes, err := r.api.Request(ctx, http.MethodPost, "/fapi/v1/leverage", param, model.EndpointSecurityTypeTrade)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseLeverage(bytes)
+}
+
+// ChangeMarginType
+// Change Margin Type of a symbol, ISOLATED or CROSSED (TRADE)
+// POST /fapi/v1/marginType
+// https://binance-docs.github.io/apidocs/futures/en/#change-margin-type-trade
+func (r *API) ChangeMarginType(param *MarginTypeParam) error {
+	return r.ChangeMarginTypeContext(r.api.Context(), param)
+}
+
+// ChangeMarginTypeContext
+// ChangeMarginType with context of the request
+func (r *API) ChangeMarginTypeContext(ctx context.Context, param *MarginTypeParam) error {
+	_, err := r.api.Request(ctx, http.MethodPost, "/fapi/v1/marginType", param, model.EndpointSecurityTypeTrade)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+	}
+	return err
+}
+
+// ChangePositionMode
+// Change user's position mode (Hedge Mode or One-way Mode) on EVERY symbol (TRADE)
+// POST /fapi/v1/positionSide/dual
+// https://binance-docs.github.io/apidocs/futures/en/#change-position-mode-trade
+func (r *API) ChangePositionMode(dualSidePosition bool) error {
+	return r.ChangePositionModeContext(r.api.Context(), dualSidePosition)
+}
+
+// ChangePositionModeContext
+// ChangePositionMode with context of the request
+func (r *API) ChangePositionModeContext(ctx context.Context, dualSidePosition bool) error {
+	param := &PositionModeParam{
+		DualSidePosition: strconv.FormatBool(dualSidePosition),
+	}
+	_, err := r.api.Request(ctx, http.MethodPost, "/fapi/v1/positionSide/dual", param, model.EndpointSecurityTypeTrade)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+	}
+	return err
+}
+
+// GetPositionMode
+// Get user's position mode, true for Hedge Mode (USER_DATA)
+// GET /fapi/v1/positionSide/dual
+// https://binance-docs.github.io/apidocs/futures/en/#get-current-position-mode-user_data
+func (r *API) GetPositionMode() (bool, error) {
+	return r.GetPositionModeContext(r.api.Context())
+}
+
+// GetPositionModeContext
+// GetPositionMode with context of the request
+func (r *API) GetPositionModeContext(ctx context.Context) (bool, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/fapi/v1/positionSide/dual", nil, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return false, err
+	}
+
+	return r.parser.ParsePositionMode(bytes)
+}
+
+// Income
+// Get Income History (USER_DATA)
+// GET /fapi/v1/income
+// https://binance-docs.github.io/apidocs/futures/en/#get-income-history-user_data
+func (r *API) Income(param *IncomeParam) ([]*Income, error) {
+	return r.IncomeContext(r.api.Context(), param)
+}
+
+// IncomeContext
+// Income with context of the request
+func (r *API) IncomeContext(ctx context.Context, param *IncomeParam) ([]*Income, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/fapi/v1/income", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseIncome(bytes)
+}
+
+// Account
+// Get current account information (USER_DATA)
+// GET /fapi/v2/account
+// https://binance-docs.github.io/apidocs/futures/en/#account-information-v2-user_data
+func (r *API) Account(param *AccountParam) (*Account, error) {
+	return r.AccountContext(r.api.Context(), param)
+}
+
+// AccountContext
+// Account with context of the request
+func (r *API) AccountContext(ctx context.Context, param *AccountParam) (*Account, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/fapi/v2/account", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseAccount(bytes)
+}
+
+// Balance
+// Futures Account Balance V2 (USER_DATA)
+// GET /fapi/v2/balance
+// https://binance-docs.github.io/apidocs/futures/en/#futures-account-balance-v2-user_data
+func (r *API) Balance(param *AccountParam) ([]*Balance, error) {
+	return r.BalanceContext(r.api.Context(), param)
+}
+
+// BalanceContext
+// Balance with context of the request
+func (r *API) BalanceContext(ctx context.Context, param *AccountParam) ([]*Balance, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/fapi/v2/balance", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseBalance(bytes)
+}
@@ -0,0 +1,1107 @@
+package futures
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"github.com/buger/jsonparser"
+	"time"
+)
+
+// OrderParam
+//   - PositionSide is required in Hedge Mode, LONG or SHORT
+//   - ReduceOnly can not be sent in Hedge Mode, or with ClosePosition
+//   - ClosePosition closes all of the position with STOP_MARKET or TAKE_PROFIT_MARKET
+type OrderParam struct {
+	Symbol           string                  `json:"symbol" param:"symbol" validate:"required"`
+	Side             model.OrderSide         `json:"side" param:"side" validate:"required"`
+	PositionSide     PositionSide            `json:"positionSide" param:"positionSide"`
+	OrderType        OrderType               `json:"type" param:"type" validate:"required"`
+	TimeInForce      model.TimeInForce       `json:"timeInForce" param:"timeInForce"`
+	Quantity         model.Decimal           `json:"quantity" param:"quantity"`
+	ReduceOnly       bool                    `json:"reduceOnly" param:"reduceOnly"`
+	Price            model.Decimal           `json:"price" param:"price"`
+	NewClientOrderId string                  `json:"newClientOrderId" param:"newClientOrderId"`
+	StopPrice        model.Decimal           `json:"stopPrice" param:"stopPrice"`
+	ClosePosition    bool                    `json:"closePosition" param:"closePosition"`
+	ActivationPrice  model.Decimal           `json:"activationPrice" param:"activationPrice"`
+	CallbackRate     model.Decimal           `json:"callbackRate" param:"callbackRate"`
+	WorkingType      WorkingType             `json:"workingType" param:"workingType"`
+	PriceProtect     bool                    `json:"priceProtect" param:"priceProtect"`
+	NewOrderRespType model.OrderResponseType `json:"newOrderRespType" param:"newOrderRespType"`
+	RecvWindow       int64                   `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+// CancelOrderParam
+// OrderId or OrigClientOrderId must be sent
+type CancelOrderParam struct {
+	Symbol            string `json:"symbol" param:"symbol" validate:"required"`
+	OrderId           int64  `json:"orderId" param:"orderId"`
+	OrigClientOrderId string `json:"origClientOrderId" param:"origClientOrderId"`
+	RecvWindow        int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+// GetOrderParam
+// OrderId or OrigClientOrderId must be sent
+type GetOrderParam struct {
+	Symbol            string `json:"symbol" param:"symbol" validate:"required"`
+	OrderId           int64  `json:"orderId" param:"orderId"`
+	OrigClientOrderId string `json:"origClientOrderId" param:"origClientOrderId"`
+	RecvWindow        int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+// GetOpenOrdersParam
+// all symbols when Symbol is empty
+type GetOpenOrdersParam struct {
+	Symbol     string `json:"symbol" param:"symbol"`
+	RecvWindow int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type Order struct {
+	Symbol        string        `json:"symbol"`
+	OrderId       int64         `json:"orderId"`
+	ClientOrderId string        `json:"clientOrderId"`
+	Price         model.Decimal `json:"price"`
+	AvgPrice      model.Decimal `json:"avgPrice"`
+	OrigQty       model.Decimal `json:"origQty"`
+	ExecutedQty   model.Decimal `json:"executedQty"`
+	CumQty        model.Decimal `json:"cumQty"`
+	CumQuote      model.Decimal `json:"cumQuote"`
+	Status        string        `json:"status"`
+	TimeInForce   string        `json:"timeInForce"`
+	OrderType     string        `json:"type"`
+	OrigType      string        `json:"origType"`
+	Side          string        `json:"side"`
+	PositionSide  string        `json:"positionSide"`
+	StopPrice     model.Decimal `json:"stopPrice"`
+	ActivatePrice model.Decimal `json:"activatePrice"`
+	PriceRate     model.Decimal `json:"priceRate"`
+	ReduceOnly    bool          `json:"reduceOnly"`
+	ClosePosition bool          `json:"closePosition"`
+	WorkingType   string        `json:"workingType"`
+	PriceProtect  bool          `json:"priceProtect"`
+	Time          time.Time     `json:"time"`
+	UpdateTime    time.Time     `json:"updateTime"`
+}
+
+func (r *Parser) ParseOrder(b []byte) (*Order, error) {
+	result := new(Order)
+
+	if v, err := jsonparser.GetString(b, "symbol"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "orderId"); err == nil {
+		result.OrderId = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "clientOrderId"); err == nil {
+		result.ClientOrderId = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "price"); err == nil {
+		result.Price = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "avgPrice"); err == nil {
+		result.AvgPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "origQty"); err == nil {
+		result.OrigQty = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "executedQty"); err == nil {
+		result.ExecutedQty = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "cumQty"); err == nil {
+		result.CumQty = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "cumQuote"); err == nil {
+		result.CumQuote = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "status"); err == nil {
+		result.Status = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "timeInForce"); err == nil {
+		result.TimeInForce = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "type"); err == nil {
+		result.OrderType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "origType"); err == nil {
+		result.OrigType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "side"); err == nil {
+		result.Side = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "positionSide"); err == nil {
+		result.PositionSide = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "stopPrice"); err == nil {
+		result.StopPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "activatePrice"); err == nil {
+		result.ActivatePrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "priceRate"); err == nil {
+		result.PriceRate = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "reduceOnly"); err == nil {
+		result.ReduceOnly = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "closePosition"); err == nil {
+		result.ClosePosition = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "workingType"); err == nil {
+		result.WorkingType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "priceProtect"); err == nil {
+		result.PriceProtect = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "time"); err == nil {
+		result.Time = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "updateTime"); err == nil {
+		result.UpdateTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) ParseOrders(b []byte) ([]*Order, error) {
+	results := make([]*Order, 0)
+
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.ParseOrder(value); err == nil {
+			results = append(results, item)
+		} else {
+			if _err = r.ParseError(err); _err != nil {
+				return
+			}
+		}
+	})
+	if err = r.ParseError(err); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// PositionParam
+// all symbols when Symbol is empty
+type PositionParam struct {
+	Symbol     string `json:"symbol" param:"symbol"`
+	RecvWindow int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type Position struct {
+	Symbol           string        `json:"symbol"`
+	PositionSide     string        `json:"positionSide"`
+	PositionAmt      model.Decimal `json:"positionAmt"`
+	EntryPrice       model.Decimal `json:"entryPrice"`
+	MarkPrice        model.Decimal `json:"markPrice"`
+	UnRealizedProfit model.Decimal `json:"unRealizedProfit"`
+	LiquidationPrice model.Decimal `json:"liquidationPrice"`
+	Leverage         model.Decimal `json:"leverage"`
+	MaxNotionalValue model.Decimal `json:"maxNotionalValue"`
+	MarginType       string        `json:"marginType"`
+	IsolatedMargin   model.Decimal `json:"isolatedMargin"`
+	IsAutoAddMargin  string        `json:"isAutoAddMargin"`
+	Notional         model.Decimal `json:"notional"`
+	IsolatedWallet   model.Decimal `json:"isolatedWallet"`
+	UpdateTime       time.Time     `json:"updateTime"`
+}
+
+func (r *Parser) ParsePositions(b []byte) ([]*Position, error) {
+	results := make([]*Position, 0)
+
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parsePosition(value); err == nil {
+			results = append(results, item)
+		} else {
+			if _err = r.ParseError(err); _err != nil {
+				return
+			}
+		}
+	})
+	if err = r.ParseError(err); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *Parser) parsePosition(b []byte) (*Position, error) {
+	result := new(Position)
+
+	if v, err := jsonparser.GetString(b, "symbol"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "positionSide"); err == nil {
+		result.PositionSide = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "positionAmt"); err == nil {
+		result.PositionAmt = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "entryPrice"); err == nil {
+		result.EntryPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "markPrice"); err == nil {
+		result.MarkPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "unRealizedProfit"); err == nil {
+		result.UnRealizedProfit = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "liquidationPrice"); err == nil {
+		result.LiquidationPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "leverage"); err == nil {
+		result.Leverage = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "maxNotionalValue"); err == nil {
+		result.MaxNotionalValue = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "marginType"); err == nil {
+		result.MarginType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "isolatedMargin"); err == nil {
+		result.IsolatedMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "isAutoAddMargin"); err == nil {
+		result.IsAutoAddMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "notional"); err == nil {
+		result.Notional = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "isolatedWallet"); err == nil {
+		result.IsolatedWallet = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "updateTime"); err == nil {
+		result.UpdateTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+type LeverageParam struct {
+	Symbol     string `json:"symbol" param:"symbol" validate:"required"`
+	Leverage   int64  `json:"leverage" param:"leverage" validate:"required,min=1,max=125"`
+	RecvWindow int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type Leverage struct {
+	Symbol           string        `json:"symbol"`
+	Leverage         int64         `json:"leverage"`
+	MaxNotionalValue model.Decimal `json:"maxNotionalValue"`
+}
+
+func (r *Parser) ParseLeverage(b []byte) (*Leverage, error) {
+	result := new(Leverage)
+
+	if v, err := jsonparser.GetString(b, "symbol"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "leverage"); err == nil {
+		result.Leverage = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "maxNotionalValue"); err == nil {
+		result.MaxNotionalValue = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+type MarginTypeParam struct {
+	Symbol     string     `json:"symbol" param:"symbol" validate:"required"`
+	MarginType MarginType `json:"marginType" param:"marginType" validate:"required,oneof=ISOLATED CROSSED"`
+	RecvWindow int64      `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+// PositionModeParam
+// DualSidePosition "true" for Hedge Mode, "false" for One-way Mode
+type PositionModeParam struct {
+	DualSidePosition string `json:"dualSidePosition" param:"dualSidePosition" validate:"required,oneof=true false"`
+	RecvWindow       int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+func (r *Parser) ParsePositionMode(b []byte) (bool, error) {
+	v, err := jsonparser.GetBoolean(b, "dualSidePosition")
+	if err != nil {
+		return false, err
+	}
+
+	return v, nil
+}
+
+type IncomeParam struct {
+	Symbol     string     `json:"symbol" param:"symbol"`
+	IncomeType IncomeType `json:"incomeType" param:"incomeType"`
+	StartTime  time.Time  `json:"startTime" param:"startTime"`
+	EndTime    time.Time  `json:"endTime" param:"endTime"`
+	Limit      int64      `json:"limit" param:"limit" validate:"min=0,max=1000"`
+	RecvWindow int64      `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type Income struct {
+	Symbol     string        `json:"symbol"`
+	IncomeType string        `json:"incomeType"`
+	Income     model.Decimal `json:"income"`
+	Asset      string        `json:"asset"`
+	Info       string        `json:"info"`
+	Time       time.Time     `json:"time"`
+	TranId     int64         `json:"tranId"`
+	TradeId    string        `json:"tradeId"`
+}
+
+func (r *Parser) ParseIncome(b []byte) ([]*Income, error) {
+	results := make([]*Income, 0)
+
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseIncome(value); err == nil {
+			results = append(results, item)
+		} else {
+			if _err = r.ParseError(err); _err != nil {
+				return
+			}
+		}
+	})
+	if err = r.ParseError(err); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *Parser) parseIncome(b []byte) (*Income, error) {
+	result := new(Income)
+
+	if v, err := jsonparser.GetString(b, "symbol"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "incomeType"); err == nil {
+		result.IncomeType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "income"); err == nil {
+		result.Income = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "asset"); err == nil {
+		result.Asset = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "info"); err == nil {
+		result.Info = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "time"); err == nil {
+		result.Time = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "tranId"); err == nil {
+		result.TranId = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "tradeId"); err == nil {
+		result.TradeId = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+type AccountParam struct {
+	RecvWindow int64 `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type Account struct {
+	FeeTier                     int64              `json:"feeTier"`
+	CanTrade                    bool               `json:"canTrade"`
+	CanDeposit                  bool               `json:"canDeposit"`
+	CanWithdraw                 bool               `json:"canWithdraw"`
+	UpdateTime                  time.Time          `json:"updateTime"`
+	TotalInitialMargin          model.Decimal      `json:"totalInitialMargin"`
+	TotalMaintMargin            model.Decimal      `json:"totalMaintMargin"`
+	TotalWalletBalance          model.Decimal      `json:"totalWalletBalance"`
+	TotalUnrealizedProfit       model.Decimal      `json:"totalUnrealizedProfit"`
+	TotalMarginBalance          model.Decimal      `json:"totalMarginBalance"`
+	TotalPositionInitialMargin  model.Decimal      `json:"totalPositionInitialMargin"`
+	TotalOpenOrderInitialMargin model.Decimal      `json:"totalOpenOrderInitialMargin"`
+	TotalCrossWalletBalance     model.Decimal      `json:"totalCrossWalletBalance"`
+	TotalCrossUnPnl             model.Decimal      `json:"totalCrossUnPnl"`
+	AvailableBalance            model.Decimal      `json:"availableBalance"`
+	MaxWithdrawAmount           model.Decimal      `json:"maxWithdrawAmount"`
+	Assets                      []*AccountAsset    `json:"assets"`
+	Positions                   []*AccountPosition `json:"positions"`
+}
+
+type AccountAsset struct {
+	Asset                  string        `json:"asset"`
+	WalletBalance          model.Decimal `json:"walletBalance"`
+	UnrealizedProfit       model.Decimal `json:"unrealizedProfit"`
+	MarginBalance          model.Decimal `json:"marginBalance"`
+	MaintMargin            model.Decimal `json:"maintMargin"`
+	InitialMargin          model.Decimal `json:"initialMargin"`
+	PositionInitialMargin  model.Decimal `json:"positionInitialMargin"`
+	OpenOrderInitialMargin model.Decimal `json:"openOrderInitialMargin"`
+	CrossWalletBalance     model.Decimal `json:"crossWalletBalance"`
+	CrossUnPnl             model.Decimal `json:"crossUnPnl"`
+	AvailableBalance       model.Decimal `json:"availableBalance"`
+	MaxWithdrawAmount      model.Decimal `json:"maxWithdrawAmount"`
+	MarginAvailable        bool          `json:"marginAvailable"`
+	UpdateTime             time.Time     `json:"updateTime"`
+}
+
+type AccountPosition struct {
+	Symbol                 string        `json:"symbol"`
+	PositionSide           string        `json:"positionSide"`
+	PositionAmt            model.Decimal `json:"positionAmt"`
+	EntryPrice             model.Decimal `json:"entryPrice"`
+	UnrealizedProfit       model.Decimal `json:"unrealizedProfit"`
+	Leverage               model.Decimal `json:"leverage"`
+	Isolated               bool          `json:"isolated"`
+	InitialMargin          model.Decimal `json:"initialMargin"`
+	MaintMargin            model.Decimal `json:"maintMargin"`
+	PositionInitialMargin  model.Decimal `json:"positionInitialMargin"`
+	OpenOrderInitialMargin model.Decimal `json:"openOrderInitialMargin"`
+	MaxNotional            model.Decimal `json:"maxNotional"`
+	UpdateTime             time.Time     `json:"updateTime"`
+}
+
+func (r *Parser) ParseAccount(b []byte) (*Account, error) {
+	result := new(Account)
+
+	if v, err := jsonparser.GetInt(b, "feeTier"); err == nil {
+		result.FeeTier = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "canTrade"); err == nil {
+		result.CanTrade = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "canDeposit"); err == nil {
+		result.CanDeposit = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "canWithdraw"); err == nil {
+		result.CanWithdraw = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "updateTime"); err == nil {
+		result.UpdateTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "totalInitialMargin"); err == nil {
+		result.TotalInitialMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "totalMaintMargin"); err == nil {
+		result.TotalMaintMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "totalWalletBalance"); err == nil {
+		result.TotalWalletBalance = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "totalUnrealizedProfit"); err == nil {
+		result.TotalUnrealizedProfit = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "totalMarginBalance"); err == nil {
+		result.TotalMarginBalance = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "totalPositionInitialMargin"); err == nil {
+		result.TotalPositionInitialMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "totalOpenOrderInitialMargin"); err == nil {
+		result.TotalOpenOrderInitialMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "totalCrossWalletBalance"); err == nil {
+		result.TotalCrossWalletBalance = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "totalCrossUnPnl"); err == nil {
+		result.TotalCrossUnPnl = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "availableBalance"); err == nil {
+		result.AvailableBalance = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "maxWithdrawAmount"); err == nil {
+		result.MaxWithdrawAmount = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	result.Assets = make([]*AccountAsset, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseAccountAsset(value); err == nil {
+			result.Assets = append(result.Assets, item)
+		} else {
+			if _err = r.ParseError(err); _err != nil {
+				return
+			}
+		}
+	}, "assets"); err != nil {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	result.Positions = make([]*AccountPosition, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseAccountPosition(value); err == nil {
+			result.Positions = append(result.Positions, item)
+		} else {
+			if _err = r.ParseError(err); _err != nil {
+				return
+			}
+		}
+	}, "positions"); err != nil {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseAccountAsset(b []byte) (*AccountAsset, error) {
+	result := new(AccountAsset)
+
+	if v, err := jsonparser.GetString(b, "asset"); err == nil {
+		result.Asset = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "walletBalance"); err == nil {
+		result.WalletBalance = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "unrealizedProfit"); err == nil {
+		result.UnrealizedProfit = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "marginBalance"); err == nil {
+		result.MarginBalance = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "maintMargin"); err == nil {
+		result.MaintMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "initialMargin"); err == nil {
+		result.InitialMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "positionInitialMargin"); err == nil {
+		result.PositionInitialMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "openOrderInitialMargin"); err == nil {
+		result.OpenOrderInitialMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "crossWalletBalance"); err == nil {
+		result.CrossWalletBalance = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "crossUnPnl"); err == nil {
+		result.CrossUnPnl = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "availableBalance"); err == nil {
+		result.AvailableBalance = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "maxWithdrawAmount"); err == nil {
+		result.MaxWithdrawAmount = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "marginAvailable"); err == nil {
+		result.MarginAvailable = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "updateTime"); err == nil {
+		result.UpdateTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseAccountPosition(b []byte) (*AccountPosition, error) {
+	result := new(AccountPosition)
+
+	if v, err := jsonparser.GetString(b, "symbol"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "positionSide"); err == nil {
+		result.PositionSide = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "positionAmt"); err == nil {
+		result.PositionAmt = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "entryPrice"); err == nil {
+		result.EntryPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "unrealizedProfit"); err == nil {
+		result.UnrealizedProfit = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "leverage"); err == nil {
+		result.Leverage = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "isolated"); err == nil {
+		result.Isolated = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "initialMargin"); err == nil {
+		result.InitialMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "maintMargin"); err == nil {
+		result.MaintMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "positionInitialMargin"); err == nil {
+		result.PositionInitialMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "openOrderInitialMargin"); err == nil {
+		result.OpenOrderInitialMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "maxNotional"); err == nil {
+		result.MaxNotional = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "updateTime"); err == nil {
+		result.UpdateTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+type Balance struct {
+	AccountAlias       string        `json:"accountAlias"`
+	Asset              string        `json:"asset"`
+	Balance            model.Decimal `json:"balance"`
+	CrossWalletBalance model.Decimal `json:"crossWalletBalance"`
+	CrossUnPnl         model.Decimal `json:"crossUnPnl"`
+	AvailableBalance   model.Decimal `json:"availableBalance"`
+	MaxWithdrawAmount  model.Decimal `json:"maxWithdrawAmount"`
+	MarginAvailable    bool          `json:"marginAvailable"`
+	UpdateTime         time.Time     `json:"updateTime"`
+}
+
+func (r *Parser) ParseBalance(b []byte) ([]*Balance, error) {
+	results := make([]*Balance, 0)
+
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseBalance(value); err == nil {
+			results = append(results, item)
+		} else {
+			if _err = r.ParseError(err); _err != nil {
+				return
+			}
+		}
+	})
+	if err = r.ParseError(err); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *Parser) parseBalance(b []byte) (*Balance, error) {
+	result := new(Balance)
+
+	if v, err := jsonparser.GetString(b, "accountAlias"); err == nil {
+		result.AccountAlias = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "asset"); err == nil {
+		result.Asset = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "balance"); err == nil {
+		result.Balance = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "crossWalletBalance"); err == nil {
+		result.CrossWalletBalance = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "crossUnPnl"); err == nil {
+		result.CrossUnPnl = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "availableBalance"); err == nil {
+		result.AvailableBalance = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "maxWithdrawAmount"); err == nil {
+		result.MaxWithdrawAmount = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "marginAvailable"); err == nil {
+		result.MarginAvailable = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "updateTime"); err == nil {
+		result.UpdateTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,76 @@
+package futures
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"github.com/NattapornTee22816/binance-connector-golang/spot"
+	"net/http"
+)
+
+// DefaultRateLimits
+// limits of fapi.binance.com, replaced by ExchangeInformation.RateLimits once it is loaded
+var DefaultRateLimits = []*model.RateLimit{
+	{RateLimitType: model.RateLimiterWeight, Interval: model.RateLimitIntervalMinute, IntervalNum: 1, Limit: 2400},
+	{RateLimitType: model.RateLimiterOrders, Interval: model.RateLimitIntervalMinute, IntervalNum: 1, Limit: 1200},
+	{RateLimitType: model.RateLimiterOrders, Interval: model.RateLimitIntervalSecond, IntervalNum: 10, Limit: 300},
+}
+
+// https://binance-docs.github.io/apidocs/futures/en/#limits
+var endpointWeights = []*spot.EndpointWeight{
+	{HttpMethod: http.MethodGet, UrlPath: "/fapi/v1/klines", Weight: 5},
+	{HttpMethod: http.MethodGet, UrlPath: "/fapi/v1/premiumIndex", Weight: 1},
+	{HttpMethod: http.MethodPost, UrlPath: "/fapi/v1/order", Weight: 1, Orders: 1},
+	{HttpMethod: http.MethodGet, UrlPath: "/fapi/v2/positionRisk", Weight: 5},
+	{HttpMethod: http.MethodGet, UrlPath: "/fapi/v1/income", Weight: 30},
+	{HttpMethod: http.MethodGet, UrlPath: "/fapi/v2/account", Weight: 5},
+	{HttpMethod: http.MethodGet, UrlPath: "/fapi/v2/balance", Weight: 5},
+}
+
+var marketConfig = &spot.MarketConfig{
+	ServerTimePath:  "/fapi/v1/time",
+	ListenKeyPath:   "/fapi/v1/listenKey",
+	RateLimits:      DefaultRateLimits,
+	EndpointWeights: endpointWeights,
+}
+
+// NewRateLimiter
+// rate limiter with limits and endpoint weights of USDⓈ-M futures
+func NewRateLimiter(configs ...spot.RateLimiterConfig) *spot.RateLimiter {
+	return marketConfig.NewRateLimiter(configs...)
+}
+
+// API
+//   - client of USDⓈ-M futures, signing, clock, rate limit, retry and error handling are shared with spot.API
+//   - listenKey of user data stream: CreateListenKey, KeepAliveListenKey and CloseListenKey of spot.MarketAPI,
+//     https://binance-docs.github.io/apidocs/futures/en/#user-data-streams
+type API struct {
+	*spot.MarketAPI
+	api    *spot.API
+	logger *lib.BinanceLogger
+	parser *Parser
+}
+
+// NewAPI
+//   - https://binance-docs.github.io/apidocs/futures/en/#general-info
+//   - options of spot.API, base url is https://fapi.binance.com by default
+func NewAPI(key string, secret string, opts ...spot.APIOption) (*API, error) {
+	return newAPI(key, secret, append([]spot.APIOption{spot.WithBaseUrl("https://fapi.binance.com")}, opts...))
+}
+
+func NewTestnetAPI(key, secret string, opts ...spot.APIOption) (*API, error) {
+	return newAPI(key, secret, append([]spot.APIOption{spot.WithBaseUrl("https://testnet.binancefuture.com")}, opts...))
+}
+
+func newAPI(key string, secret string, opts []spot.APIOption) (*API, error) {
+	market, err := spot.NewMarketAPI(key, secret, marketConfig, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &API{
+		MarketAPI: market,
+		api:       market.API(),
+		logger:    market.API().Logger(),
+		parser:    NewParser(),
+	}, nil
+}
@@ -0,0 +1,112 @@
+package futures
+
+import (
+	"errors"
+	"github.com/NattapornTee22816/binance-connector-golang/internal/apitest"
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"github.com/NattapornTee22816/binance-connector-golang/spot"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func newTestAPI(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) (*API, *apitest.Server) {
+	fake := apitest.NewServer(t, "/fapi/v1/time", func(w http.ResponseWriter, r *http.Request, _ int) {
+		handler(w, r)
+	})
+	api, err := NewAPI("key", "secret", spot.WithBaseUrl(fake.URL), spot.WithLogLevel(lib.LogLevelInfo))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(api.Close)
+	api.SetRetryPolicy(&spot.BackoffRetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond})
+	return api, fake
+}
+
+func TestAPI_NewOrder(t *testing.T) {
+	var query url.Values
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"orderId":22542179,"symbol":"BTCUSDT","status":"NEW","reduceOnly":true,"positionSide":"SHORT","side":"BUY","type":"MARKET"}`))
+	})
+
+	order, err := api.NewOrder(&OrderParam{
+		Symbol:       "BTCUSDT",
+		Side:         model.OrderSideBuy,
+		PositionSide: PositionSideShort,
+		OrderType:    OrderTypeMarket,
+		Quantity:     model.MustDecimal("0.01"),
+		ReduceOnly:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.OrderId != 22542179 || !order.ReduceOnly {
+		t.Errorf("order = %+v", order)
+	}
+
+	request := fake.Last()
+	if request.Method != http.MethodPost || request.URL.Path != "/fapi/v1/order" {
+		t.Errorf("request = %s %s", request.Method, request.URL.Path)
+	}
+	if query.Get("reduceOnly") != "true" || query.Get("positionSide") != "SHORT" || query.Get("quantity") != "0.01" {
+		t.Errorf("query = %v", query)
+	}
+	if len(query.Get("signature")) == 0 || request.Header.Get("X-MBX-APIKEY") != "key" {
+		t.Errorf("request is not signed: %v", query)
+	}
+}
+
+func TestAPI_ChangePositionMode(t *testing.T) {
+	var query url.Values
+	api, _ := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"code":200,"msg":"success"}`))
+	})
+
+	if err := api.ChangePositionMode(false); err != nil {
+		t.Fatal(err)
+	}
+	if query.Get("dualSidePosition") != "false" {
+		t.Errorf("query = %v", query)
+	}
+}
+
+func TestAPI_ClientError(t *testing.T) {
+	api, _ := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":-2013,"msg":"Order does not exist."}`))
+	})
+
+	_, err := api.GetOrder(&GetOrderParam{Symbol: "BTCUSDT", OrderId: 1})
+	if !errors.Is(err, spot.ErrNoSuchOrder) {
+		t.Errorf("error = %v", err)
+	}
+}
+
+func TestAPI_ExchangeInformationRateLimits(t *testing.T) {
+	api, _ := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"rateLimits":[{"interval":"MINUTE","intervalNum":1,"limit":1000,"rateLimitType":"REQUEST_WEIGHT"}],"symbols":[]}`))
+	})
+
+	if _, err := api.ExchangeInformation(); err != nil {
+		t.Fatal(err)
+	}
+	usage := api.RateLimitUsage()
+	if len(usage) != 1 || usage[0].Limit != 1000 {
+		t.Errorf("usage = %v", usage)
+	}
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	limiter := NewRateLimiter()
+
+	if weight, orders := limiter.Weight(http.MethodPost, "/fapi/v1/order", nil); weight != 1 || orders != 1 {
+		t.Errorf("order weight = %d, orders = %d", weight, orders)
+	}
+	if weight, _ := limiter.Weight(http.MethodGet, "/fapi/v1/income", nil); weight != 30 {
+		t.Errorf("income weight = %d", weight)
+	}
+}
@@ -0,0 +1,80 @@
+package futures
+
+import "github.com/NattapornTee22816/binance-connector-golang/model"
+
+type PositionSide = string
+
+var (
+	// PositionSideBoth
+	// default of One-way Mode
+	PositionSideBoth  = PositionSide("BOTH")
+	PositionSideLong  = PositionSide("LONG")
+	PositionSideShort = PositionSide("SHORT")
+)
+
+type OrderType = model.OrderType
+
+var (
+	OrderTypeLimit              = OrderType("LIMIT")
+	OrderTypeMarket             = OrderType("MARKET")
+	OrderTypeStop               = OrderType("STOP")
+	OrderTypeStopMarket         = OrderType("STOP_MARKET")
+	OrderTypeTakeProfit         = OrderType("TAKE_PROFIT")
+	OrderTypeTakeProfitMarket   = OrderType("TAKE_PROFIT_MARKET")
+	OrderTypeTrailingStopMarket = OrderType("TRAILING_STOP_MARKET")
+)
+
+var (
+	// TimeInForceGTX
+	// Good Till Crossing (Post Only)
+	TimeInForceGTX = model.TimeInForce("GTX")
+)
+
+type WorkingType = string
+
+var (
+	WorkingTypeMarkPrice     = WorkingType("MARK_PRICE")
+	WorkingTypeContractPrice = WorkingType("CONTRACT_PRICE")
+)
+
+type MarginType = string
+
+var (
+	MarginTypeIsolated = MarginType("ISOLATED")
+	MarginTypeCrossed  = MarginType("CROSSED")
+)
+
+type ContractType = string
+
+var (
+	ContractTypePerpetual           = ContractType("PERPETUAL")
+	ContractTypeCurrentMonth        = ContractType("CURRENT_MONTH")
+	ContractTypeNextMonth           = ContractType("NEXT_MONTH")
+	ContractTypeCurrentQuarter      = ContractType("CURRENT_QUARTER")
+	ContractTypeNextQuarter         = ContractType("NEXT_QUARTER")
+	ContractTypePerpetualDelivering = ContractType("PERPETUAL_DELIVERING")
+)
+
+type IncomeType = string
+
+var (
+	IncomeTypeTransfer                 = IncomeType("TRANSFER")
+	IncomeTypeWelcomeBonus             = IncomeType("WELCOME_BONUS")
+	IncomeTypeRealizedPnl              = IncomeType("REALIZED_PNL")
+	IncomeTypeFundingFee               = IncomeType("FUNDING_FEE")
+	IncomeTypeCommission               = IncomeType("COMMISSION")
+	IncomeTypeInsuranceClear           = IncomeType("INSURANCE_CLEAR")
+	IncomeTypeReferralKickback         = IncomeType("REFERRAL_KICKBACK")
+	IncomeTypeCommissionRebate         = IncomeType("COMMISSION_REBATE")
+	IncomeTypeApiRebate                = IncomeType("API_REBATE")
+	IncomeTypeContestReward            = IncomeType("CONTEST_REWARD")
+	IncomeTypeCrossCollateral          = IncomeType("CROSS_COLLATERAL_TRANSFER")
+	IncomeTypeOptionsPremiumFee        = IncomeType("OPTIONS_PREMIUM_FEE")
+	IncomeTypeOptionsSettleProfit      = IncomeType("OPTIONS_SETTLE_PROFIT")
+	IncomeTypeInternalTransfer         = IncomeType("INTERNAL_TRANSFER")
+	IncomeTypeAutoExchange             = IncomeType("AUTO_EXCHANGE")
+	IncomeTypeDeliveredSettelment      = IncomeType("DELIVERED_SETTELMENT")
+	IncomeTypeCoinSwapDeposit          = IncomeType("COIN_SWAP_DEPOSIT")
+	IncomeTypeCoinSwapWithdraw         = IncomeType("COIN_SWAP_WITHDRAW")
+	IncomeTypePositionLimitIncreaseFee = IncomeType("POSITION_LIMIT_INCREASE_FEE")
+)
@@ -0,0 +1,142 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"net/http"
+)
+
+// Ping
+// Test connectivity to the Rest API.
+// GET /fapi/v1/ping
+// https://binance-docs.github.io/apidocs/futures/en/#test-connectivity
+func (r *API) Ping() error {
+	return r.PingContext(r.api.Context())
+}
+
+// PingContext
+// Ping with context of the request
+func (r *API) PingContext(ctx context.Context) error {
+	_, err := r.api.Request(ctx, http.MethodGet, "/fapi/v1/ping", nil, model.EndpointSecurityTypeNone)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+	}
+	return err
+}
+
+// CheckServerTime
+// Test connectivity to the Rest API and get the current server time.
+// GET /fapi/v1/time
+// https://binance-docs.github.io/apidocs/futures/en/#check-server-time
+func (r *API) CheckServerTime() (int64, error) {
+	return r.CheckServerTimeContext(r.api.Context())
+}
+
+// CheckServerTimeContext
+// CheckServerTime with context of the request
+func (r *API) CheckServerTimeContext(ctx context.Context) (int64, error) {
+	return r.api.CheckServerTimeContext(ctx)
+}
+
+// ExchangeInformation
+// Current exchange trading rules and symbol information
+// GET /fapi/v1/exchangeInfo
+// https://binance-docs.github.io/apidocs/futures/en/#exchange-information
+func (r *API) ExchangeInformation() (*ExchangeInformation, error) {
+	return r.ExchangeInformationContext(r.api.Context())
+}
+
+// ExchangeInformationContext
+// ExchangeInformation with context of the request
+func (r *API) ExchangeInformationContext(ctx context.Context) (*ExchangeInformation, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/fapi/v1/exchangeInfo", nil, model.EndpointSecurityTypeNone)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	body := new(ExchangeInformation)
+	if err := json.Unmarshal(bytes, &body); err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+	if limiter := r.RateLimiter(); limiter != nil && len(body.RateLimits) > 0 {
+		limiter.SetRateLimits(body.RateLimits)
+	}
+
+	return body, nil
+}
+
+// Klines
+// Kline/candlestick bars for a symbol.
+// Klines are uniquely identified by their open time.
+// GET /fapi/v1/klines
+// https://binance-docs.github.io/apidocs/futures/en/#kline-candlestick-data
+func (r *API) Klines(param *KlineParam) ([]*model.Kline, error) {
+	return r.KlinesContext(r.api.Context(), param)
+}
+
+// KlinesContext
+// Klines with context of the request
+func (r *API) KlinesContext(ctx context.Context, param *KlineParam) ([]*model.Kline, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/fapi/v1/klines", param, model.EndpointSecurityTypeNone)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseKline(bytes)
+}
+
+// MarkPrice
+// Mark Price and Funding Rate, all symbols when Symbol is empty
+// GET /fapi/v1/premiumIndex
+// https://binance-docs.github.io/apidocs/futures/en/#mark-price
+func (r *API) MarkPrice(param *MarkPriceParam) ([]*MarkPrice, error) {
+	return r.MarkPriceContext(r.api.Context(), param)
+}
+
+// MarkPriceContext
+// MarkPrice with context of the request
+func (r *API) MarkPriceContext(ctx context.Context, param *MarkPriceParam) ([]*MarkPrice, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/fapi/v1/premiumIndex", param, model.EndpointSecurityTypeNone)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseMarkPrice(bytes)
+}
+
+// FundingRate
+// Get Funding Rate History
+// GET /fapi/v1/fundingRate
+// https://binance-docs.github.io/apidocs/futures/en/#get-funding-rate-history
+func (r *API) FundingRate(param *FundingRateParam) ([]*FundingRate, error) {
+	return r.FundingRateContext(r.api.Context(), param)
+}
+
+// FundingRateContext
+// FundingRate with context of the request
+func (r *API) FundingRateContext(ctx context.Context, param *FundingRateParam) ([]*FundingRate, error) {
+	bytes, err := r.api.Request(ctx, http.MethodGet, "/fapi/v1/fundingRate", param, model.EndpointSecurityTypeNone)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseFundingRate(bytes)
+}
@@ -0,0 +1,256 @@
+package futures
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"github.com/buger/jsonparser"
+	"time"
+)
+
+type ExchangeInformationParam struct {
+}
+
+type ExchangeInformation struct {
+	Timezone        string             `json:"timezone"`
+	ServerTime      int64              `json:"serverTime"`
+	FuturesType     string             `json:"futuresType"`
+	RateLimits      []*model.RateLimit `json:"rateLimits"`
+	ExchangeFilters []struct{}         `json:"exchangeFilters"`
+	Assets          []*AssetInfo       `json:"assets"`
+	Symbols         []*SymbolInfo      `json:"symbols"`
+}
+
+// SymbolInfo
+// find symbol information by name, return false when the symbol is not listed
+func (e *ExchangeInformation) SymbolInfo(symbol string) (*SymbolInfo, bool) {
+	for _, info := range e.Symbols {
+		if info.Symbol == symbol {
+			return info, true
+		}
+	}
+	return nil, false
+}
+
+type AssetInfo struct {
+	Asset             string        `json:"asset"`
+	MarginAvailable   bool          `json:"marginAvailable"`
+	AutoAssetExchange model.Decimal `json:"autoAssetExchange"`
+}
+
+type SymbolInfo struct {
+	Symbol                string                `json:"symbol"`
+	Pair                  string                `json:"pair"`
+	ContractType          ContractType          `json:"contractType"`
+	DeliveryDate          int64                 `json:"deliveryDate"`
+	OnboardDate           int64                 `json:"onboardDate"`
+	Status                model.SymbolStatus    `json:"status"`
+	MaintMarginPercent    model.Decimal         `json:"maintMarginPercent"`
+	RequiredMarginPercent model.Decimal         `json:"requiredMarginPercent"`
+	BaseAsset             string                `json:"baseAsset"`
+	QuoteAsset            string                `json:"quoteAsset"`
+	MarginAsset           string                `json:"marginAsset"`
+	PricePrecision        int64                 `json:"pricePrecision"`
+	QuantityPrecision     int64                 `json:"quantityPrecision"`
+	BaseAssetPrecision    int64                 `json:"baseAssetPrecision"`
+	QuotePrecision        int64                 `json:"quotePrecision"`
+	UnderlyingType        string                `json:"underlyingType"`
+	UnderlyingSubType     []string              `json:"underlyingSubType"`
+	SettlePlan            int64                 `json:"settlePlan"`
+	TriggerProtect        model.Decimal         `json:"triggerProtect"`
+	LiquidationFee        model.Decimal         `json:"liquidationFee"`
+	MarketTakeBound       model.Decimal         `json:"marketTakeBound"`
+	Filters               []*model.SymbolFilter `json:"filters"`
+	OrderTypes            []OrderType           `json:"orderTypes"`
+	TimeInForce           []model.TimeInForce   `json:"timeInForce"`
+}
+
+// KlineParam
+// same as model.KlineParam, limit of futures is up to 1500
+type KlineParam struct {
+	Symbol    string         `json:"symbol" param:"symbol" validate:"required"`
+	Interval  model.Interval `json:"interval" param:"interval" validate:"required"`
+	StartTime time.Time      `json:"startTime" param:"startTime"`
+	EndTime   time.Time      `json:"endTime" param:"endTime"`
+	Limit     int64          `json:"limit" param:"limit" validate:"min=0,max=1500"`
+}
+
+// MarkPriceParam
+// all symbols when Symbol is empty
+type MarkPriceParam struct {
+	Symbol string `json:"symbol" param:"symbol"`
+}
+
+type MarkPrice struct {
+	Symbol               string        `json:"symbol"`
+	MarkPrice            model.Decimal `json:"markPrice"`
+	IndexPrice           model.Decimal `json:"indexPrice"`
+	EstimatedSettlePrice model.Decimal `json:"estimatedSettlePrice"`
+	LastFundingRate      model.Decimal `json:"lastFundingRate"`
+	NextFundingTime      time.Time     `json:"nextFundingTime"`
+	InterestRate         model.Decimal `json:"interestRate"`
+	Time                 time.Time     `json:"time"`
+}
+
+// ParseMarkPrice
+// response is an object when symbol is sent, otherwise an array
+func (r *Parser) ParseMarkPrice(b []byte) ([]*MarkPrice, error) {
+	_, dataType, _, err := jsonparser.Get(b)
+	if err != nil {
+		return nil, err
+	}
+	if dataType == jsonparser.Object {
+		item, err := r.parseMarkPrice(b)
+		if err != nil {
+			return nil, err
+		}
+		return []*MarkPrice{item}, nil
+	}
+
+	results := make([]*MarkPrice, 0)
+
+	_, err = jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseMarkPrice(value); err == nil {
+			results = append(results, item)
+		} else {
+			if _err = r.ParseError(err); _err != nil {
+				return
+			}
+		}
+	})
+	if err = r.ParseError(err); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *Parser) parseMarkPrice(b []byte) (*MarkPrice, error) {
+	result := new(MarkPrice)
+
+	if v, err := jsonparser.GetString(b, "symbol"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "markPrice"); err == nil {
+		result.MarkPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "indexPrice"); err == nil {
+		result.IndexPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "estimatedSettlePrice"); err == nil {
+		result.EstimatedSettlePrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "lastFundingRate"); err == nil {
+		result.LastFundingRate = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "nextFundingTime"); err == nil {
+		result.NextFundingTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "interestRate"); err == nil {
+		result.InterestRate = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "time"); err == nil {
+		result.Time = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+type FundingRateParam struct {
+	Symbol    string    `json:"symbol" param:"symbol"`
+	StartTime time.Time `json:"startTime" param:"startTime"`
+	EndTime   time.Time `json:"endTime" param:"endTime"`
+	Limit     int64     `json:"limit" param:"limit" validate:"min=0,max=1000"`
+}
+
+func (r *Parser) ParseFundingRate(b []byte) ([]*FundingRate, error) {
+	results := make([]*FundingRate, 0)
+
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseFundingRate(value); err == nil {
+			results = append(results, item)
+		} else {
+			if _err = r.ParseError(err); _err != nil {
+				return
+			}
+		}
+	})
+	if err = r.ParseError(err); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+type FundingRate struct {
+	Symbol      string        `json:"symbol"`
+	FundingRate model.Decimal `json:"fundingRate"`
+	FundingTime time.Time     `json:"fundingTime"`
+	MarkPrice   model.Decimal `json:"markPrice"`
+}
+
+func (r *Parser) parseFundingRate(b []byte) (*FundingRate, error) {
+	result := new(FundingRate)
+
+	if v, err := jsonparser.GetString(b, "symbol"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "fundingRate"); err == nil {
+		result.FundingRate = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "fundingTime"); err == nil {
+		result.FundingTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "markPrice"); err == nil {
+		result.MarkPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,161 @@
+package futures
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// ExchangeInformation
+func TestExchangeInformation_Unmarshal(t *testing.T) {
+	bytes := []byte(`{"exchangeFilters":[],"rateLimits":[{"interval":"MINUTE","intervalNum":1,"limit":2400,"rateLimitType":"REQUEST_WEIGHT"},{"interval":"MINUTE","intervalNum":1,"limit":1200,"rateLimitType":"ORDERS"}],"serverTime":1565613908500,"assets":[{"asset":"BUSD","marginAvailable":true,"autoAssetExchange":"0"}],"symbols":[{"symbol":"BLZUSDT","pair":"BLZUSDT","contractType":"PERPETUAL","deliveryDate":4133404800000,"onboardDate":1598252400000,"status":"TRADING","maintMarginPercent":"2.5000","requiredMarginPercent":"5.0000","baseAsset":"BLZ","quoteAsset":"USDT","marginAsset":"USDT","pricePrecision":5,"quantityPrecision":0,"baseAssetPrecision":8,"quotePrecision":8,"underlyingType":"COIN","underlyingSubType":["STORAGE"],"settlePlan":0,"triggerProtect":"0.15","filters":[{"filterType":"PRICE_FILTER","maxPrice":"300","minPrice":"0.0001","tickSize":"0.0001"},{"filterType":"LOT_SIZE","maxQty":"10000000","minQty":"1","stepSize":"1"},{"filterType":"MAX_NUM_ORDERS","limit":200},{"filterType":"PERCENT_PRICE","multiplierUp":"1.1500","multiplierDown":"0.8500","multiplierDecimal":"4"}],"orderTypes":["LIMIT","MARKET","STOP"],"timeInForce":["GTC","IOC","FOK","GTX"],"liquidationFee":"0.010000","marketTakeBound":"0.30"}],"timezone":"UTC"}`)
+
+	result := new(ExchangeInformation)
+	if err := json.Unmarshal(bytes, result); err != nil {
+		t.Fatal(err)
+	}
+	info, ok := result.SymbolInfo("BLZUSDT")
+	if !ok || info.ContractType != ContractTypePerpetual || len(info.Filters) != 4 || info.LiquidationFee.String() != "0.010000" || len(info.OrderTypes) != 3 {
+		t.Errorf("symbol = %+v", info)
+	}
+	if len(result.RateLimits) != 2 || result.RateLimits[0].Limit != 2400 {
+		t.Errorf("rateLimits = %+v", result.RateLimits)
+	}
+}
+
+// Klines
+func TestParser_ParseKline(t *testing.T) {
+	bytes := []byte(`[[1499040000000,"0.01634790","0.80000000","0.01575800","0.01577100","148976.11427815",1499644799999,"2434.19055334",308,"1756.87402397","28.46694368","17928899.62484339"]]`)
+
+	parser := NewParser()
+	result, err := parser.ParseKline(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 1 || result[0].NumberOfTrades != 308 || result[0].Open.String() != "0.01634790" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+// MarkPrice
+func TestParser_ParseMarkPrice(t *testing.T) {
+	object := []byte(`{"symbol":"BTCUSDT","markPrice":"11793.63104562","indexPrice":"11781.80495970","estimatedSettlePrice":"11781.16138815","lastFundingRate":"0.00038246","nextFundingTime":1597392000000,"interestRate":"0.00010000","time":1597370495002}`)
+	array := []byte(`[` + string(object) + `,` + string(object) + `]`)
+
+	parser := NewParser()
+	result, err := parser.ParseMarkPrice(object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 1 || result[0].LastFundingRate.String() != "0.00038246" || result[0].NextFundingTime.UnixMilli() != 1597392000000 {
+		t.Errorf("object = %+v", result)
+	}
+
+	result, err = parser.ParseMarkPrice(array)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 2 {
+		t.Errorf("array = %+v", result)
+	}
+}
+
+// FundingRate
+func TestParser_ParseFundingRate(t *testing.T) {
+	bytes := []byte(`[{"symbol":"BTCUSDT","fundingRate":"-0.03750000","fundingTime":1570608000000,"markPrice":"34287.54619963"},{"symbol":"BTCUSDT","fundingRate":"0.00010000","fundingTime":1570636800000,"markPrice":"34287.54619963"}]`)
+
+	parser := NewParser()
+	result, err := parser.ParseFundingRate(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 2 || result[0].FundingRate.String() != "-0.03750000" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+// NewOrder, CancelOrder, GetOrder
+func TestParser_ParseOrder(t *testing.T) {
+	bytes := []byte(`{"clientOrderId":"testOrder","cumQty":"0","cumQuote":"0","executedQty":"0","orderId":22542179,"avgPrice":"0.00000","origQty":"10","price":"0","reduceOnly":true,"side":"BUY","positionSide":"SHORT","status":"NEW","stopPrice":"9300","closePosition":false,"symbol":"BTCUSDT","timeInForce":"GTD","type":"TRAILING_STOP_MARKET","origType":"TRAILING_STOP_MARKET","activatePrice":"9020","priceRate":"0.3","updateTime":1566818724722,"workingType":"CONTRACT_PRICE","priceProtect":false}`)
+
+	parser := NewParser()
+	result, err := parser.ParseOrder(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.OrderId != 22542179 || !result.ReduceOnly || result.PositionSide != PositionSideShort || result.ActivatePrice.String() != "9020" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+// Positions
+func TestParser_ParsePositions(t *testing.T) {
+	bytes := []byte(`[{"entryPrice":"0.00000","marginType":"isolated","isAutoAddMargin":"false","isolatedMargin":"0.00000000","leverage":"10","liquidationPrice":"0","markPrice":"6679.50671178","maxNotionalValue":"20000000","positionAmt":"0.000","notional":"0","isolatedWallet":"0","symbol":"BTCUSDT","unRealizedProfit":"0.00000000","positionSide":"BOTH","updateTime":0}]`)
+
+	parser := NewParser()
+	result, err := parser.ParsePositions(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 1 || result[0].Leverage.String() != "10" || result[0].MarkPrice.String() != "6679.50671178" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+// ChangeLeverage
+func TestParser_ParseLeverage(t *testing.T) {
+	bytes := []byte(`{"leverage":21,"maxNotionalValue":"1000000","symbol":"BTCUSDT"}`)
+
+	parser := NewParser()
+	result, err := parser.ParseLeverage(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Leverage != 21 || result.MaxNotionalValue.String() != "1000000" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+// Income
+func TestParser_ParseIncome(t *testing.T) {
+	bytes := []byte(`[{"symbol":"","incomeType":"TRANSFER","income":"-0.37500000","asset":"USDT","info":"TRANSFER","time":1570608000000,"tranId":9689322392,"tradeId":""},{"symbol":"BTCUSDT","incomeType":"COMMISSION","income":"-0.01000000","asset":"USDT","info":"COMMISSION","time":1570636800000,"tranId":9689322392,"tradeId":"2059192"}]`)
+
+	parser := NewParser()
+	result, err := parser.ParseIncome(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 2 || result[1].IncomeType != IncomeTypeCommission || result[1].TradeId != "2059192" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+// Account
+func TestParser_ParseAccount(t *testing.T) {
+	bytes := []byte(`{"feeTier":0,"canTrade":true,"canDeposit":true,"canWithdraw":true,"updateTime":0,"totalInitialMargin":"0.00000000","totalMaintMargin":"0.00000000","totalWalletBalance":"23.72469206","totalUnrealizedProfit":"0.00000000","totalMarginBalance":"23.72469206","totalPositionInitialMargin":"0.00000000","totalOpenOrderInitialMargin":"0.00000000","totalCrossWalletBalance":"23.72469206","totalCrossUnPnl":"0.00000000","availableBalance":"23.72469206","maxWithdrawAmount":"23.72469206","assets":[{"asset":"USDT","walletBalance":"23.72469206","unrealizedProfit":"0.00000000","marginBalance":"23.72469206","maintMargin":"0.00000000","initialMargin":"0.00000000","positionInitialMargin":"0.00000000","openOrderInitialMargin":"0.00000000","crossWalletBalance":"23.72469206","crossUnPnl":"0.00000000","availableBalance":"23.72469206","maxWithdrawAmount":"23.72469206","marginAvailable":true,"updateTime":1625474304765}],"positions":[{"symbol":"BTCUSDT","initialMargin":"0","maintMargin":"0","unrealizedProfit":"0.00000000","positionInitialMargin":"0","openOrderInitialMargin":"0","leverage":"100","isolated":true,"entryPrice":"0.00000","maxNotional":"250000","bidNotional":"0","askNotional":"0","positionSide":"BOTH","positionAmt":"0","updateTime":0}]}`)
+
+	parser := NewParser()
+	result, err := parser.ParseAccount(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Assets) != 1 || !result.Assets[0].MarginAvailable || len(result.Positions) != 1 || !result.Positions[0].Isolated {
+		t.Errorf("result = %+v", result)
+	}
+	if result.AvailableBalance.String() != "23.72469206" {
+		t.Errorf("availableBalance = %s", result.AvailableBalance)
+	}
+}
+
+// Balance
+func TestParser_ParseBalance(t *testing.T) {
+	bytes := []byte(`[{"accountAlias":"SgsR","asset":"USDT","balance":"122607.35137903","crossWalletBalance":"23.72469206","crossUnPnl":"0.00000000","availableBalance":"23.72469206","maxWithdrawAmount":"23.72469206","marginAvailable":true,"updateTime":1617939110373}]`)
+
+	parser := NewParser()
+	result, err := parser.ParseBalance(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 1 || result[0].Balance.String() != "122607.35137903" {
+		t.Errorf("result = %+v", result)
+	}
+}
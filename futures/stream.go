@@ -0,0 +1,183 @@
+package futures
+
+import (
+	"fmt"
+	"github.com/NattapornTee22816/binance-connector-golang/websocket"
+	"github.com/buger/jsonparser"
+	"regexp"
+	"strings"
+)
+
+var (
+	AllMarketMarkPriceStreamType   = websocket.StreamType("!markPrice@arr")
+	AllMarketLiquidationStreamType = websocket.StreamType("!forceOrder@arr")
+)
+
+// MarketStream
+//   - market streams of USDⓈ-M futures
+//   - streams in the same format as spot are subscribed by websocket.Stream, e.g. SubscribeKlineStreams, SubscribeAggregateTradeStreams
+//   - futures only streams are subscribed by SubscribeMarkPriceStreams and SubscribeLiquidationStreams
+type MarketStream struct {
+	*websocket.Stream
+	parser *Parser
+}
+
+// NewMarketStream
+//
+// https://binance-docs.github.io/apidocs/futures/en/#websocket-market-streams
+func NewMarketStream(opts ...websocket.StreamOption) (*MarketStream, error) {
+	return newMarketStream(append([]websocket.StreamOption{websocket.WithBaseUrl("wss://fstream.binance.com/stream")}, opts...))
+}
+
+func NewTestnetMarketStream(opts ...websocket.StreamOption) (*MarketStream, error) {
+	return newMarketStream(append([]websocket.StreamOption{websocket.WithBaseUrl("wss://stream.binancefuture.com/stream")}, opts...))
+}
+
+func newMarketStream(opts []websocket.StreamOption) (*MarketStream, error) {
+	stream, err := websocket.NewWsStream(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MarketStream{
+		Stream: stream,
+		parser: NewParser(),
+	}, nil
+}
+
+// NewMarkPriceStreamType
+//   - create stream name '<symbol>@markPrice' or '<symbol>@markPrice@1s'
+//   - Mark price and funding rate for a single symbol pushed every 3 seconds or every second.
+//   - https://binance-docs.github.io/apidocs/futures/en/#mark-price-stream
+func NewMarkPriceStreamType(symbol string, everySecond bool) (string, error) {
+	if len(symbol) == 0 {
+		return "", websocket.ErrStreamSymbolInvalid
+	}
+
+	stream := fmt.Sprintf("%s@markPrice", strings.ToLower(symbol))
+	if everySecond {
+		stream += "@1s"
+	}
+	return stream, nil
+}
+
+// SubscribeMarkPriceStreams
+//   - streams of NewMarkPriceStreamType or AllMarketMarkPriceStreamType
+//   - https://binance-docs.github.io/apidocs/futures/en/#mark-price-stream-for-all-market
+func (s *MarketStream) SubscribeMarkPriceStreams(streams []string, handler ...MarkPriceStreamHandler) error {
+	if len(handler) == 0 {
+		return websocket.ErrNoStreamHandler
+	}
+	if err := validateStreams(streams, "^([a-z0-9_]+@markPrice|!markPrice@arr)(@1s)?$"); err != nil {
+		return err
+	}
+
+	handlers := append([]MarkPriceStreamHandler(nil), handler...)
+	return s.SubscribeRawStreams(streams, func(stream string, data []byte) {
+		s.eachEvent(data, func(value []byte) {
+			if r, err := s.parser.ParseMarkPriceStream(value); err == nil {
+				callMarkPriceStreamHandler(handlers, stream, r)
+			}
+		})
+	})
+}
+
+// NewLiquidationStreamType
+//   - create stream name '<symbol>@forceOrder'
+//   - The Liquidation Order Snapshot Streams push force liquidation order information for specific symbol.
+//   - https://binance-docs.github.io/apidocs/futures/en/#liquidation-order-streams
+func NewLiquidationStreamType(symbol string) (string, error) {
+	if len(symbol) == 0 {
+		return "", websocket.ErrStreamSymbolInvalid
+	}
+
+	return fmt.Sprintf("%s@forceOrder", strings.ToLower(symbol)), nil
+}
+
+// SubscribeLiquidationStreams
+//   - streams of NewLiquidationStreamType or AllMarketLiquidationStreamType
+//   - https://binance-docs.github.io/apidocs/futures/en/#all-market-liquidation-order-streams
+func (s *MarketStream) SubscribeLiquidationStreams(streams []string, handler ...LiquidationStreamHandler) error {
+	if len(handler) == 0 {
+		return websocket.ErrNoStreamHandler
+	}
+	if err := validateStreams(streams, "^([a-z0-9_]+@forceOrder|!forceOrder@arr)$"); err != nil {
+		return err
+	}
+
+	handlers := append([]LiquidationStreamHandler(nil), handler...)
+	return s.SubscribeRawStreams(streams, func(stream string, data []byte) {
+		s.eachEvent(data, func(value []byte) {
+			if r, err := s.parser.ParseLiquidationStream(value); err == nil {
+				callLiquidationStreamHandler(handlers, stream, r)
+			}
+		})
+	})
+}
+
+func validateStreams(streams []string, pattern string) error {
+	if len(streams) == 0 {
+		return websocket.ErrRequireStreamSymbol
+	}
+
+	regex := regexp.MustCompile(pattern)
+	for _, stream := range streams {
+		if !regex.MatchString(stream) {
+			return websocket.ErrStreamSymbolInvalid
+		}
+	}
+	return nil
+}
+
+// eachEvent
+// data of all market streams is an array of events
+func (s *MarketStream) eachEvent(data []byte, fn func(value []byte)) {
+	_, dataType, _, err := jsonparser.Get(data)
+	if err != nil {
+		return
+	}
+	if dataType != jsonparser.Array {
+		fn(data)
+		return
+	}
+
+	_, _ = jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		fn(value)
+	})
+}
+
+// MarkPriceStreamHandler
+//
+// func(stream string, value *MarkPriceStream, err error) {
+//   do something
+//   when have error then set to err and return for stop next handler
+// }
+type MarkPriceStreamHandler = func(string, *MarkPriceStream, error)
+
+func callMarkPriceStreamHandler(handlers []MarkPriceStreamHandler, stream string, data *MarkPriceStream) {
+	var err error
+	for _, handler := range handlers {
+		handler(stream, data, err)
+		if err != nil {
+			break
+		}
+	}
+}
+
+// LiquidationStreamHandler
+//
+// func(stream string, value *LiquidationStream, err error) {
+//   do something
+//   when have error then set to err and return for stop next handler
+// }
+type LiquidationStreamHandler = func(string, *LiquidationStream, error)
+
+func callLiquidationStreamHandler(handlers []LiquidationStreamHandler, stream string, data *LiquidationStream) {
+	var err error
+	for _, handler := range handlers {
+		handler(stream, data, err)
+		if err != nil {
+			break
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package futures
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"github.com/buger/jsonparser"
+	"time"
+)
+
+type EventType = string
+
+var (
+	MarkPriceUpdateEventType  = EventType("markPriceUpdate")
+	ForceOrderEventType       = EventType("forceOrder")
+	OrderTradeUpdateEventType = EventType("ORDER_TRADE_UPDATE")
+	AccountUpdateEventType    = EventType("ACCOUNT_UPDATE")
+	MarginCallEventType       = EventType("MARGIN_CALL")
+)
+
+// MarkPriceStream
+//   - <symbol>@markPrice or <symbol>@markPrice@1s
+//   - https://binance-docs.github.io/apidocs/futures/en/#mark-price-stream
+type MarkPriceStream struct {
+	EventType            string        `json:"e"`
+	EventTime            time.Time     `json:"E"`
+	Symbol               string        `json:"s"`
+	MarkPrice            model.Decimal `json:"p"`
+	IndexPrice           model.Decimal `json:"i"`
+	EstimatedSettlePrice model.Decimal `json:"P"`
+	FundingRate          model.Decimal `json:"r"`
+	NextFundingTime      time.Time     `json:"T"`
+}
+
+func (r *Parser) ParseMarkPriceStream(b []byte) (*MarkPriceStream, error) {
+	result := new(MarkPriceStream)
+
+	if v, err := jsonparser.GetString(b, "e"); err == nil {
+		result.EventType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "E"); err == nil {
+		result.EventTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "s"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "p"); err == nil {
+		result.MarkPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "i"); err == nil {
+		result.IndexPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "P"); err == nil {
+		result.EstimatedSettlePrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "r"); err == nil {
+		result.FundingRate = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "T"); err == nil {
+		result.NextFundingTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// LiquidationStream
+//   - <symbol>@forceOrder or !forceOrder@arr
+//   - https://binance-docs.github.io/apidocs/futures/en/#liquidation-order-streams
+type LiquidationStream struct {
+	EventType string            `json:"e"`
+	EventTime time.Time         `json:"E"`
+	Order     *LiquidationOrder `json:"o"`
+}
+
+func (r *Parser) ParseLiquidationStream(b []byte) (*LiquidationStream, error) {
+	result := new(LiquidationStream)
+
+	if v, err := jsonparser.GetString(b, "e"); err == nil {
+		result.EventType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "E"); err == nil {
+		result.EventTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, _, _, err := jsonparser.Get(b, "o"); err == nil {
+		if result.Order, err = r.parseLiquidationOrder(v); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+type LiquidationOrder struct {
+	Symbol               string        `json:"s"`
+	Side                 string        `json:"S"`
+	OrderType            string        `json:"o"`
+	TimeInForce          string        `json:"f"`
+	OrigQty              model.Decimal `json:"q"`
+	Price                model.Decimal `json:"p"`
+	AvgPrice             model.Decimal `json:"ap"`
+	Status               string        `json:"X"`
+	LastFilledQty        model.Decimal `json:"l"`
+	FilledAccumulatedQty model.Decimal `json:"z"`
+	TradeTime            time.Time     `json:"T"`
+}
+
+func (r *Parser) parseLiquidationOrder(b []byte) (*LiquidationOrder, error) {
+	result := new(LiquidationOrder)
+
+	if v, err := jsonparser.GetString(b, "s"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "S"); err == nil {
+		result.Side = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "o"); err == nil {
+		result.OrderType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "f"); err == nil {
+		result.TimeInForce = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "q"); err == nil {
+		result.OrigQty = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "p"); err == nil {
+		result.Price = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "ap"); err == nil {
+		result.AvgPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "X"); err == nil {
+		result.Status = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "l"); err == nil {
+		result.LastFilledQty = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "z"); err == nil {
+		result.FilledAccumulatedQty = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "T"); err == nil {
+		result.TradeTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,71 @@
+package futures
+
+import "testing"
+
+func TestParser_ParseMarkPriceStream(t *testing.T) {
+	bytes := []byte(`{"e":"markPriceUpdate","E":1562305380000,"s":"BTCUSDT","p":"11794.15000000","i":"11784.62659091","P":"11784.25641265","r":"0.00038167","T":1562306400000}`)
+
+	parser := NewParser()
+	result, err := parser.ParseMarkPriceStream(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Symbol != "BTCUSDT" || result.FundingRate.String() != "0.00038167" || result.NextFundingTime.UnixMilli() != 1562306400000 {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+func TestParser_ParseLiquidationStream(t *testing.T) {
+	bytes := []byte(`{"e":"forceOrder","E":1568014460893,"o":{"s":"BTCUSDT","S":"SELL","o":"LIMIT","f":"IOC","q":"0.014","p":"9910","ap":"9910","X":"FILLED","l":"0.014","z":"0.014","T":1568014460893}}`)
+
+	parser := NewParser()
+	result, err := parser.ParseLiquidationStream(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.EventType != ForceOrderEventType || result.Order == nil || result.Order.Side != "SELL" || result.Order.Price.String() != "9910" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+func TestParser_ParseOrderTradeUpdateEvent(t *testing.T) {
+	bytes := []byte(`{"e":"ORDER_TRADE_UPDATE","E":1568879465651,"T":1568879465650,"o":{"s":"BTCUSDT","c":"TEST","S":"SELL","o":"TRAILING_STOP_MARKET","f":"GTC","q":"0.001","p":"0","ap":"0","sp":"7103.04","x":"NEW","X":"NEW","i":8886774,"l":"0","z":"0","L":"0","N":"USDT","n":"0","T":1568879465650,"t":0,"b":"0","a":"9.91","m":false,"R":false,"wt":"CONTRACT_PRICE","ot":"TRAILING_STOP_MARKET","ps":"LONG","cp":false,"AP":"7476.89","cr":"5.0","pP":false,"si":0,"ss":0,"rp":"0"}}`)
+
+	parser := NewParser()
+	result, err := parser.ParseOrderTradeUpdateEvent(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Order == nil || result.Order.OrderId != 8886774 || result.Order.PositionSide != PositionSideLong || result.Order.CallbackRate.String() != "5.0" {
+		t.Errorf("result = %+v", result.Order)
+	}
+}
+
+func TestParser_ParseAccountUpdateEvent(t *testing.T) {
+	bytes := []byte(`{"e":"ACCOUNT_UPDATE","E":1564745798939,"T":1564745798938,"a":{"m":"ORDER","B":[{"a":"USDT","wb":"122624.12345678","cw":"100.12345678","bc":"50.12345678"},{"a":"BUSD","wb":"1.00000000","cw":"0.00000000","bc":"-49.12345678"}],"P":[{"s":"BTCUSDT","pa":"0","ep":"0.00000","cr":"200","up":"0","mt":"crossed","iw":"0.00000000","ps":"BOTH"},{"s":"BTCUSDT","pa":"20","ep":"6563.66500","cr":"0","up":"2850.21200","mt":"isolated","iw":"13200.70726908","ps":"LONG"}]}}`)
+
+	parser := NewParser()
+	result, err := parser.ParseAccountUpdateEvent(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Reason != "ORDER" || len(result.Balances) != 2 || len(result.Positions) != 2 {
+		t.Errorf("result = %+v", result)
+	}
+	if result.Balances[1].BalanceChange.String() != "-49.12345678" || result.Positions[1].IsolatedWallet.String() != "13200.70726908" {
+		t.Errorf("balance = %+v, position = %+v", result.Balances[1], result.Positions[1])
+	}
+}
+
+func TestParser_ParseMarginCallEvent(t *testing.T) {
+	bytes := []byte(`{"e":"MARGIN_CALL","E":1587727187525,"cw":"3.16812045","p":[{"s":"ETHUSDT","ps":"LONG","pa":"1.327","mt":"CROSSED","iw":"0","mp":"187.17127","up":"-1.166074","mm":"1.614445"}]}`)
+
+	parser := NewParser()
+	result, err := parser.ParseMarginCallEvent(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Positions) != 1 || result.Positions[0].MaintenanceMargin.String() != "1.614445" {
+		t.Errorf("result = %+v", result)
+	}
+}
@@ -0,0 +1,632 @@
+package futures
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"github.com/buger/jsonparser"
+	"time"
+)
+
+// OrderTradeUpdateEvent
+//   - is sent when new order created, order status changed
+//   - https://binance-docs.github.io/apidocs/futures/en/#event-order-update
+type OrderTradeUpdateEvent struct {
+	EventType       string       `json:"e"`
+	EventTime       time.Time    `json:"E"`
+	TransactionTime time.Time    `json:"T"`
+	Order           *OrderUpdate `json:"o"`
+}
+
+type OrderUpdate struct {
+	Symbol               string        `json:"s"`
+	ClientOrderId        string        `json:"c"`
+	Side                 string        `json:"S"`
+	OrderType            string        `json:"o"`
+	TimeInForce          string        `json:"f"`
+	OrigQty              model.Decimal `json:"q"`
+	Price                model.Decimal `json:"p"`
+	AvgPrice             model.Decimal `json:"ap"`
+	StopPrice            model.Decimal `json:"sp"`
+	ExecutionType        string        `json:"x"`
+	Status               string        `json:"X"`
+	OrderId              int64         `json:"i"`
+	LastFilledQty        model.Decimal `json:"l"`
+	FilledAccumulatedQty model.Decimal `json:"z"`
+	LastFilledPrice      model.Decimal `json:"L"`
+	CommissionAsset      string        `json:"N"`
+	Commission           model.Decimal `json:"n"`
+	TradeTime            time.Time     `json:"T"`
+	TradeId              int64         `json:"t"`
+	BidsNotional         model.Decimal `json:"b"`
+	AsksNotional         model.Decimal `json:"a"`
+	IsMaker              bool          `json:"m"`
+	ReduceOnly           bool          `json:"R"`
+	WorkingType          string        `json:"wt"`
+	OrigType             string        `json:"ot"`
+	PositionSide         string        `json:"ps"`
+	ClosePosition        bool          `json:"cp"`
+	ActivationPrice      model.Decimal `json:"AP"`
+	CallbackRate         model.Decimal `json:"cr"`
+	RealizedProfit       model.Decimal `json:"rp"`
+}
+
+func (r *Parser) ParseOrderTradeUpdateEvent(b []byte) (*OrderTradeUpdateEvent, error) {
+	result := new(OrderTradeUpdateEvent)
+
+	if v, err := jsonparser.GetString(b, "e"); err == nil {
+		result.EventType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "E"); err == nil {
+		result.EventTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "T"); err == nil {
+		result.TransactionTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, _, _, err := jsonparser.Get(b, "o"); err == nil {
+		if result.Order, err = r.parseOrderUpdate(v); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseOrderUpdate(b []byte) (*OrderUpdate, error) {
+	result := new(OrderUpdate)
+
+	if v, err := jsonparser.GetString(b, "s"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "c"); err == nil {
+		result.ClientOrderId = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "S"); err == nil {
+		result.Side = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "o"); err == nil {
+		result.OrderType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "f"); err == nil {
+		result.TimeInForce = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "q"); err == nil {
+		result.OrigQty = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "p"); err == nil {
+		result.Price = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "ap"); err == nil {
+		result.AvgPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "sp"); err == nil {
+		result.StopPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "x"); err == nil {
+		result.ExecutionType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "X"); err == nil {
+		result.Status = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "i"); err == nil {
+		result.OrderId = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "l"); err == nil {
+		result.LastFilledQty = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "z"); err == nil {
+		result.FilledAccumulatedQty = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "L"); err == nil {
+		result.LastFilledPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "N"); err == nil {
+		result.CommissionAsset = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "n"); err == nil {
+		result.Commission = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "T"); err == nil {
+		result.TradeTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "t"); err == nil {
+		result.TradeId = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "b"); err == nil {
+		result.BidsNotional = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "a"); err == nil {
+		result.AsksNotional = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "m"); err == nil {
+		result.IsMaker = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "R"); err == nil {
+		result.ReduceOnly = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "wt"); err == nil {
+		result.WorkingType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "ot"); err == nil {
+		result.OrigType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "ps"); err == nil {
+		result.PositionSide = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "cp"); err == nil {
+		result.ClosePosition = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "AP"); err == nil {
+		result.ActivationPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "cr"); err == nil {
+		result.CallbackRate = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "rp"); err == nil {
+		result.RealizedProfit = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// AccountUpdateEvent
+//   - is sent when balance or position get updated
+//   - Reason is the reason type of the event, e.g. ORDER, FUNDING_FEE, DEPOSIT
+//   - https://binance-docs.github.io/apidocs/futures/en/#event-balance-and-position-update
+type AccountUpdateEvent struct {
+	EventType       string                   `json:"e"`
+	EventTime       time.Time                `json:"E"`
+	TransactionTime time.Time                `json:"T"`
+	Reason          string                   `json:"m"`
+	Balances        []*AccountUpdateBalance  `json:"B"`
+	Positions       []*AccountUpdatePosition `json:"P"`
+}
+
+type AccountUpdateBalance struct {
+	Asset              string        `json:"a"`
+	WalletBalance      model.Decimal `json:"wb"`
+	CrossWalletBalance model.Decimal `json:"cw"`
+	BalanceChange      model.Decimal `json:"bc"`
+}
+
+type AccountUpdatePosition struct {
+	Symbol              string        `json:"s"`
+	PositionAmt         model.Decimal `json:"pa"`
+	EntryPrice          model.Decimal `json:"ep"`
+	AccumulatedRealized model.Decimal `json:"cr"`
+	UnrealizedPnl       model.Decimal `json:"up"`
+	MarginType          string        `json:"mt"`
+	IsolatedWallet      model.Decimal `json:"iw"`
+	PositionSide        string        `json:"ps"`
+}
+
+func (r *Parser) ParseAccountUpdateEvent(b []byte) (*AccountUpdateEvent, error) {
+	result := new(AccountUpdateEvent)
+
+	if v, err := jsonparser.GetString(b, "e"); err == nil {
+		result.EventType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "E"); err == nil {
+		result.EventTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "T"); err == nil {
+		result.TransactionTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	// balances and positions are in "a"
+	data, _, _, err := jsonparser.Get(b, "a")
+	if err != nil {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+	if v, err := jsonparser.GetString(data, "m"); err == nil {
+		result.Reason = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	result.Balances = make([]*AccountUpdateBalance, 0)
+	if _, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseAccountUpdateBalance(value); err == nil {
+			result.Balances = append(result.Balances, item)
+		} else {
+			if _err = r.ParseError(err); _err != nil {
+				return
+			}
+		}
+	}, "B"); err != nil {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	result.Positions = make([]*AccountUpdatePosition, 0)
+	if _, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseAccountUpdatePosition(value); err == nil {
+			result.Positions = append(result.Positions, item)
+		} else {
+			if _err = r.ParseError(err); _err != nil {
+				return
+			}
+		}
+	}, "P"); err != nil {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseAccountUpdateBalance(b []byte) (*AccountUpdateBalance, error) {
+	result := new(AccountUpdateBalance)
+
+	if v, err := jsonparser.GetString(b, "a"); err == nil {
+		result.Asset = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "wb"); err == nil {
+		result.WalletBalance = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "cw"); err == nil {
+		result.CrossWalletBalance = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "bc"); err == nil {
+		result.BalanceChange = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseAccountUpdatePosition(b []byte) (*AccountUpdatePosition, error) {
+	result := new(AccountUpdatePosition)
+
+	if v, err := jsonparser.GetString(b, "s"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "pa"); err == nil {
+		result.PositionAmt = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "ep"); err == nil {
+		result.EntryPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "cr"); err == nil {
+		result.AccumulatedRealized = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "up"); err == nil {
+		result.UnrealizedPnl = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "mt"); err == nil {
+		result.MarginType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "iw"); err == nil {
+		result.IsolatedWallet = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "ps"); err == nil {
+		result.PositionSide = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// MarginCallEvent
+//   - is sent when the margin ratio of positions is high
+//   - https://binance-docs.github.io/apidocs/futures/en/#event-margin-call
+type MarginCallEvent struct {
+	EventType          string                `json:"e"`
+	EventTime          time.Time             `json:"E"`
+	CrossWalletBalance model.Decimal         `json:"cw"`
+	Positions          []*MarginCallPosition `json:"p"`
+}
+
+type MarginCallPosition struct {
+	Symbol            string        `json:"s"`
+	PositionSide      string        `json:"ps"`
+	PositionAmt       model.Decimal `json:"pa"`
+	MarginType        string        `json:"mt"`
+	IsolatedWallet    model.Decimal `json:"iw"`
+	MarkPrice         model.Decimal `json:"mp"`
+	UnrealizedPnl     model.Decimal `json:"up"`
+	MaintenanceMargin model.Decimal `json:"mm"`
+}
+
+func (r *Parser) ParseMarginCallEvent(b []byte) (*MarginCallEvent, error) {
+	result := new(MarginCallEvent)
+
+	if v, err := jsonparser.GetString(b, "e"); err == nil {
+		result.EventType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "E"); err == nil {
+		result.EventTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "cw"); err == nil {
+		result.CrossWalletBalance = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	result.Positions = make([]*MarginCallPosition, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseMarginCallPosition(value); err == nil {
+			result.Positions = append(result.Positions, item)
+		} else {
+			if _err = r.ParseError(err); _err != nil {
+				return
+			}
+		}
+	}, "p"); err != nil {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseMarginCallPosition(b []byte) (*MarginCallPosition, error) {
+	result := new(MarginCallPosition)
+
+	if v, err := jsonparser.GetString(b, "s"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "ps"); err == nil {
+		result.PositionSide = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "pa"); err == nil {
+		result.PositionAmt = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "mt"); err == nil {
+		result.MarginType = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "iw"); err == nil {
+		result.IsolatedWallet = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "mp"); err == nil {
+		result.MarkPrice = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "up"); err == nil {
+		result.UnrealizedPnl = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := model.GetDecimal(b, "mm"); err == nil {
+		result.MaintenanceMargin = v
+	} else {
+		if err := r.ParseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,163 @@
+package futures
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/websocket"
+	"sync"
+)
+
+// UserDataStream
+//   - user data stream of USDⓈ-M futures, listenKey is kept alive by websocket.UserDataStream
+//   - events are parsed by Parser, e.g. ORDER_TRADE_UPDATE, ACCOUNT_UPDATE and MARGIN_CALL
+type UserDataStream struct {
+	*websocket.UserDataStream
+	parser                  *Parser
+	mu                      sync.RWMutex
+	orderTradeUpdateHandler []OrderTradeUpdateHandler
+	accountUpdateHandler    []AccountUpdateHandler
+	marginCallHandler       []MarginCallHandler
+}
+
+// NewUserDataStream
+//   - obtain listenKey from service, e.g. futures.API, and connect to user data stream
+//   - https://binance-docs.github.io/apidocs/futures/en/#user-data-streams
+func NewUserDataStream(service websocket.ListenKeyService, opts ...websocket.StreamOption) (*UserDataStream, error) {
+	return newUserDataStream(service, append([]websocket.StreamOption{websocket.WithBaseUrl("wss://fstream.binance.com/ws")}, opts...))
+}
+
+func NewTestnetUserDataStream(service websocket.ListenKeyService, opts ...websocket.StreamOption) (*UserDataStream, error) {
+	return newUserDataStream(service, append([]websocket.StreamOption{websocket.WithBaseUrl("wss://stream.binancefuture.com/ws")}, opts...))
+}
+
+func newUserDataStream(service websocket.ListenKeyService, opts []websocket.StreamOption) (*UserDataStream, error) {
+	uds, err := websocket.NewUserDataStream(service, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &UserDataStream{
+		UserDataStream:          uds,
+		parser:                  NewParser(),
+		orderTradeUpdateHandler: make([]OrderTradeUpdateHandler, 0),
+		accountUpdateHandler:    make([]AccountUpdateHandler, 0),
+		marginCallHandler:       make([]MarginCallHandler, 0),
+	}
+	if err = uds.SubscribeRawEvent(s.eventHandler); err != nil {
+		uds.Shutdown()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *UserDataStream) SubscribeOrderTradeUpdate(handler ...OrderTradeUpdateHandler) error {
+	if len(handler) == 0 {
+		return websocket.ErrNoStreamHandler
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.orderTradeUpdateHandler = append(s.orderTradeUpdateHandler, handler...)
+	return nil
+}
+
+func (s *UserDataStream) SubscribeAccountUpdate(handler ...AccountUpdateHandler) error {
+	if len(handler) == 0 {
+		return websocket.ErrNoStreamHandler
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accountUpdateHandler = append(s.accountUpdateHandler, handler...)
+	return nil
+}
+
+func (s *UserDataStream) SubscribeMarginCall(handler ...MarginCallHandler) error {
+	if len(handler) == 0 {
+		return websocket.ErrNoStreamHandler
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.marginCallHandler = append(s.marginCallHandler, handler...)
+	return nil
+}
+
+func (s *UserDataStream) eventHandler(eventType string, message []byte) {
+	switch eventType {
+	case OrderTradeUpdateEventType:
+		if r, err := s.parser.ParseOrderTradeUpdateEvent(message); err == nil {
+			s.callOrderTradeUpdateHandler(r)
+		}
+	case AccountUpdateEventType:
+		if r, err := s.parser.ParseAccountUpdateEvent(message); err == nil {
+			s.callAccountUpdateHandler(r)
+		}
+	case MarginCallEventType:
+		if r, err := s.parser.ParseMarginCallEvent(message); err == nil {
+			s.callMarginCallHandler(r)
+		}
+	}
+}
+
+// OrderTradeUpdateHandler
+//
+// func(value *OrderTradeUpdateEvent, err error) {
+//   do something
+//   when have error then set to err and return for stop next handler
+// }
+type OrderTradeUpdateHandler = func(*OrderTradeUpdateEvent, error)
+
+func (s *UserDataStream) callOrderTradeUpdateHandler(data *OrderTradeUpdateEvent) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var err error
+	for _, handler := range s.orderTradeUpdateHandler {
+		handler(data, err)
+		if err != nil {
+			break
+		}
+	}
+}
+
+// AccountUpdateHandler
+//
+// func(value *AccountUpdateEvent, err error) {
+//   do something
+//   when have error then set to err and return for stop next handler
+// }
+type AccountUpdateHandler = func(*AccountUpdateEvent, error)
+
+func (s *UserDataStream) callAccountUpdateHandler(data *AccountUpdateEvent) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var err error
+	for _, handler := range s.accountUpdateHandler {
+		handler(data, err)
+		if err != nil {
+			break
+		}
+	}
+}
+
+// MarginCallHandler
+//
+// func(value *MarginCallEvent, err error) {
+//   do something
+//   when have error then set to err and return for stop next handler
+// }
+type MarginCallHandler = func(*MarginCallEvent, error)
+
+func (s *UserDataStream) callMarginCallHandler(data *MarginCallEvent) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var err error
+	for _, handler := range s.marginCallHandler {
+		handler(data, err)
+		if err != nil {
+			break
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Package apitest
+// fake REST server shared by the tests of spot, futures and delivery
+package apitest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// ServerTime
+// serverTime of the server time endpoint
+const ServerTime = 1499827319559
+
+type Server struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []*http.Request
+}
+
+// NewServer
+// serverTimePath answers ServerTime, other requests are recorded and passed to handler with their number, start from 1.
+// The server is closed by the cleanup of t
+func NewServer(t *testing.T, serverTimePath string, handler func(w http.ResponseWriter, r *http.Request, n int)) *Server {
+	s := &Server{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == serverTimePath {
+			_, _ = w.Write([]byte(`{"serverTime":` + strconv.FormatInt(ServerTime, 10) + `}`))
+			return
+		}
+
+		s.mu.Lock()
+		s.requests = append(s.requests, r)
+		n := len(s.requests)
+		s.mu.Unlock()
+
+		handler(w, r, n)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Calls
+// method and path of the recorded requests, e.g. "GET /api/v3/order"
+func (s *Server) Calls() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	calls := make([]string, 0, len(s.requests))
+	for _, r := range s.requests {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+	}
+	return calls
+}
+
+// Last
+// the latest recorded request, nil when there is none
+func (s *Server) Last() *http.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.requests) == 0 {
+		return nil
+	}
+	return s.requests[len(s.requests)-1]
+}
@@ -0,0 +1,237 @@
+package lib
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ServerTimeFunc
+// return server time in milliseconds, e.g. serverTime of GET /api/v3/time
+type ServerTimeFunc = func(ctx context.Context) (int64, error)
+
+type ClockConfig struct {
+	// By default, 1 minute
+	SyncInterval time.Duration
+	// number of requests of each sync, the sample of the lowest latency is used.
+	// By default, 3
+	Samples int
+	// drift handlers are called when the offset changes at least the threshold.
+	// By default, 0 (every sync)
+	DriftThreshold time.Duration
+}
+
+// ClockDrift
+// Offset is local time - server time, Drift is the change of Offset since the previous sync
+type ClockDrift struct {
+	Offset   time.Duration
+	Drift    time.Duration
+	Latency  time.Duration
+	SyncedAt time.Time
+}
+
+type ClockDriftHandler = func(*ClockDrift)
+
+// Clock
+// estimate server time from local time, shared by REST and websocket clients
+type Clock struct {
+	mu         sync.RWMutex
+	serverTime ServerTimeFunc
+	config     ClockConfig
+	logger     *BinanceLogger
+	offset     time.Duration
+	latency    time.Duration
+	syncedAt   time.Time
+	handlers   []ClockDriftHandler
+	started    bool
+	done       chan struct{}
+	closeOnce  sync.Once
+	now        func() time.Time
+	syncMu     sync.Mutex
+}
+
+func NewClock(serverTime ServerTimeFunc, configs ...ClockConfig) *Clock {
+	config := ClockConfig{}
+	if len(configs) > 0 {
+		config = configs[0]
+	}
+	if config.SyncInterval <= 0 {
+		config.SyncInterval = time.Minute
+	}
+	if config.Samples <= 0 {
+		config.Samples = 3
+	}
+
+	return &Clock{
+		serverTime: serverTime,
+		config:     config,
+		logger:     NewLogger("clock", LogLevelDebug),
+		handlers:   make([]ClockDriftHandler, 0),
+		done:       make(chan struct{}),
+		now:        time.Now,
+	}
+}
+
+// OnDrift
+// handlers are called after a sync when the offset changes at least DriftThreshold
+func (c *Clock) OnDrift(handler ...ClockDriftHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.handlers = append(c.handlers, handler...)
+}
+
+// Sync
+// sample server time now, offset is estimated at the middle of the round trip
+func (c *Clock) Sync(ctx context.Context) error {
+	var offset, latency time.Duration
+	sampled := false
+	for i := 0; i < c.config.Samples; i++ {
+		start := c.now()
+		serverTime, err := c.serverTime(ctx)
+		if err != nil {
+			if sampled {
+				break
+			}
+			return err
+		}
+		end := c.now()
+
+		rtt := end.Sub(start)
+		if !sampled || rtt < latency {
+			offset = start.Add(rtt / 2).Sub(time.UnixMilli(serverTime))
+			latency = rtt
+			sampled = true
+		}
+	}
+
+	c.mu.Lock()
+	drift := &ClockDrift{
+		Offset:   offset,
+		Drift:    offset - c.offset,
+		Latency:  latency,
+		SyncedAt: c.now(),
+	}
+	if c.syncedAt.IsZero() {
+		drift.Drift = 0
+	}
+	c.offset = offset
+	c.latency = latency
+	c.syncedAt = drift.SyncedAt
+	handlers := c.handlers
+	c.mu.Unlock()
+
+	if abs(drift.Drift) >= c.config.DriftThreshold {
+		for _, handler := range handlers {
+			handler(drift)
+		}
+	}
+	return nil
+}
+
+// SyncIfStale
+// sync when the clock is not synced yet or the last sync is older than SyncInterval,
+// concurrent callers wait for the same sync
+func (c *Clock) SyncIfStale(ctx context.Context) error {
+	c.syncMu.Lock()
+	defer c.syncMu.Unlock()
+
+	if syncedAt := c.SyncedAt(); !syncedAt.IsZero() && c.now().Sub(syncedAt) < c.config.SyncInterval {
+		return nil
+	}
+	return c.Sync(ctx)
+}
+
+// Start
+// sync periodically in background until Close
+func (c *Clock) Start() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.started {
+		return
+	}
+	c.started = true
+	go c.syncLoop()
+}
+
+func (c *Clock) Close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+}
+
+// Offset
+// local time - server time
+func (c *Clock) Offset() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.offset
+}
+
+// Latency
+// round trip time of the last sync
+func (c *Clock) Latency() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.latency
+}
+
+func (c *Clock) SyncedAt() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.syncedAt
+}
+
+// Now
+// estimated server time
+func (c *Clock) Now() time.Time {
+	return c.now().Add(-c.Offset())
+}
+
+// Timestamp
+// estimated server time in milliseconds, e.g. timestamp of SIGNED requests
+func (c *Clock) Timestamp() int64 {
+	return c.Now().UnixMilli()
+}
+
+// ConvertIntToTime
+// convert server time in milliseconds to local time, same as lib.ConvertIntToTime with the current offset
+func (c *Clock) ConvertIntToTime(i int64) time.Time {
+	return ConvertIntToTime(i, c.Offset().Milliseconds())
+}
+
+// ConvertTimeToInt
+// convert local time to server time in milliseconds
+func (c *Clock) ConvertTimeToInt(t time.Time) int64 {
+	return t.UnixMilli() - c.Offset().Milliseconds()
+}
+
+func (c *Clock) syncLoop() {
+	ticker := time.NewTicker(c.config.SyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), c.config.SyncInterval)
+			if err := c.Sync(ctx); err != nil {
+				c.logger.Error(fmt.Sprintf("sync server time error: %s", err.Error()))
+			}
+			cancel()
+		}
+	}
+}
+
+func abs(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
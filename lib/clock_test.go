@@ -0,0 +1,94 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestClock_Sync(t *testing.T) {
+	local := time.UnixMilli(1_000_000)
+	latencies := []time.Duration{40 * time.Millisecond, 10 * time.Millisecond, 30 * time.Millisecond}
+	serverAhead := 2 * time.Second
+
+	sample := 0
+	clock := NewClock(func(ctx context.Context) (int64, error) {
+		// server answers at the middle of the round trip
+		latency := latencies[sample%len(latencies)]
+		sample++
+		local = local.Add(latency / 2)
+		serverTime := local.Add(serverAhead).UnixMilli()
+		local = local.Add(latency / 2)
+		return serverTime, nil
+	})
+	clock.now = func() time.Time { return local }
+
+	drifts := make([]*ClockDrift, 0)
+	clock.OnDrift(func(drift *ClockDrift) {
+		drifts = append(drifts, drift)
+	})
+
+	if err := clock.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if clock.Offset() != -serverAhead || clock.Latency() != 10*time.Millisecond {
+		t.Errorf("offset = %v, latency = %v", clock.Offset(), clock.Latency())
+	}
+	if ts := clock.Timestamp(); ts != local.Add(serverAhead).UnixMilli() {
+		t.Errorf("timestamp = %d", ts)
+	}
+	if tm := clock.ConvertIntToTime(local.Add(serverAhead).UnixMilli()); !tm.Equal(local) {
+		t.Errorf("convert to local time = %v, want %v", tm, local)
+	}
+
+	serverAhead = 2500 * time.Millisecond
+	if err := clock.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(drifts) != 2 || drifts[0].Drift != 0 || drifts[1].Drift != -500*time.Millisecond {
+		t.Errorf("drifts = %v", drifts)
+	}
+}
+
+func TestClock_SyncError(t *testing.T) {
+	errServer := errors.New("server error")
+	clock := NewClock(func(ctx context.Context) (int64, error) {
+		return 0, errServer
+	})
+
+	if err := clock.Sync(context.Background()); err != errServer {
+		t.Errorf("error = %v", err)
+	}
+	if !clock.SyncedAt().IsZero() {
+		t.Error("clock should not be synced")
+	}
+}
+
+func TestClock_SyncIfStale(t *testing.T) {
+	local := time.UnixMilli(1_000_000)
+	syncs := 0
+	clock := NewClock(func(ctx context.Context) (int64, error) {
+		syncs++
+		return local.UnixMilli(), nil
+	}, ClockConfig{Samples: 1})
+	clock.now = func() time.Time { return local }
+
+	for i := 0; i < 2; i++ {
+		if err := clock.SyncIfStale(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if syncs != 1 {
+		t.Errorf("syncs = %d", syncs)
+	}
+
+	// synced again after SyncInterval
+	local = local.Add(time.Minute)
+	if err := clock.SyncIfStale(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if syncs != 2 {
+		t.Errorf("syncs = %d", syncs)
+	}
+}
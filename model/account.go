@@ -13,12 +13,12 @@ type OrderParam struct {
 	Side             string            `json:"side" param:"side" validate:"required"`
 	OrderType        OrderType         `json:"type" param:"type" validate:"required"`
 	TimeInForce      TimeInForce       `json:"timeInForce" param:"timeInForce"`
-	Quantity         float64           `json:"quantity" param:"quantity"`
-	QuoteOrderQty    float64           `json:"quoteOrderQty" param:"quoteOrderQty"`
-	Price            float64           `json:"price"`
+	Quantity         Decimal           `json:"quantity" param:"quantity"`
+	QuoteOrderQty    Decimal           `json:"quoteOrderQty" param:"quoteOrderQty"`
+	Price            Decimal           `json:"price" param:"price"`
 	NewClientOrderId string            `json:"newClientOrderId" param:"newClientOrderId"`
-	StopPrice        float64           `json:"stopPrice" param:"stopPrice"`
-	IcebergQty       float64           `json:"icebergQty" param:"icebergQty"`
+	StopPrice        Decimal           `json:"stopPrice" param:"stopPrice"`
+	IcebergQty       Decimal           `json:"icebergQty" param:"icebergQty"`
 	NewOrderRespType OrderResponseType `json:"newOrderRespType" param:"newOrderRespType"`
 	RecvWindow       int64             `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
 }
@@ -29,10 +29,10 @@ type Order struct {
 	OrderListId         int64        `json:"orderListId"`
 	ClientOrderId       string       `json:"clientOrderId"`
 	TransactTime        time.Time    `json:"transactTime"`
-	Price               string       `json:"price"`
-	OrigQty             string       `json:"origQty"`
-	ExecutedQty         string       `json:"executedQty"`
-	CummulativeQuoteQty string       `json:"cummulativeQuoteQty"`
+	Price               Decimal      `json:"price"`
+	OrigQty             Decimal      `json:"origQty"`
+	ExecutedQty         Decimal      `json:"executedQty"`
+	CummulativeQuoteQty Decimal      `json:"cummulativeQuoteQty"`
 	Status              string       `json:"status"`
 	TimeInForce         string       `json:"timeInForce"`
 	Type                string       `json:"type"`
@@ -41,11 +41,11 @@ type Order struct {
 }
 
 type OrderFill struct {
-	Price           string `json:"price"`
-	Qty             string `json:"qty"`
-	Commission      string `json:"commission"`
-	CommissionAsset string `json:"commissionAsset"`
-	TradeId         int64  `json:"tradeId"`
+	Price           Decimal `json:"price"`
+	Qty             Decimal `json:"qty"`
+	Commission      Decimal `json:"commission"`
+	CommissionAsset string  `json:"commissionAsset"`
+	TradeId         int64   `json:"tradeId"`
 }
 
 func (r *Parser) ParseOrder(b []byte) (*Order, error) {
@@ -86,28 +86,28 @@ func (r *Parser) ParseOrder(b []byte) (*Order, error) {
 			return nil, err
 		}
 	}
-	if v, err := jsonparser.GetString(b, "price"); err == nil {
+	if v, err := GetDecimal(b, "price"); err == nil {
 		result.Price = v
 	} else {
 		if err = r.errorParser(err); err != nil {
 			return nil, err
 		}
 	}
-	if v, err := jsonparser.GetString(b, "origQty"); err == nil {
+	if v, err := GetDecimal(b, "origQty"); err == nil {
 		result.OrigQty = v
 	} else {
 		if err = r.errorParser(err); err != nil {
 			return nil, err
 		}
 	}
-	if v, err := jsonparser.GetString(b, "executedQty"); err == nil {
+	if v, err := GetDecimal(b, "executedQty"); err == nil {
 		result.ExecutedQty = v
 	} else {
 		if err = r.errorParser(err); err != nil {
 			return nil, err
 		}
 	}
-	if v, err := jsonparser.GetString(b, "cummulativeQuoteQty"); err == nil {
+	if v, err := GetDecimal(b, "cummulativeQuoteQty"); err == nil {
 		result.CummulativeQuoteQty = v
 	} else {
 		if err = r.errorParser(err); err != nil {
@@ -147,21 +147,21 @@ func (r *Parser) ParseOrder(b []byte) (*Order, error) {
 	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
 		fill := new(OrderFill)
 
-		if v, err := jsonparser.GetString(value, "price"); err != nil {
+		if v, err := GetDecimal(value, "price"); err != nil {
 			fill.Price = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "qty"); err != nil {
+		if v, err := GetDecimal(value, "qty"); err != nil {
 			fill.Qty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "commission"); err != nil {
+		if v, err := GetDecimal(value, "commission"); err != nil {
 			fill.Commission = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
@@ -203,19 +203,19 @@ type CancelOrderParam struct {
 }
 
 type CancelOrder struct {
-	Symbol              string `json:"symbol"`
-	OrigClientOrderId   string `json:"origClientOrderId"`
-	OrderId             int64  `json:"orderId"`
-	OrderListId         int64  `json:"orderListId"`
-	ClientOrderId       string `json:"clientOrderId"`
-	Price               string `json:"price"`
-	OrigQty             string `json:"origQty"`
-	ExecutedQty         string `json:"executedQty"`
-	CummulativeQuoteQty string `json:"cummulativeQuoteQty"`
-	Status              string `json:"status"`
-	TimeInForce         string `json:"timeInForce"`
-	Type                string `json:"type"`
-	Side                string `json:"side"`
+	Symbol              string  `json:"symbol"`
+	OrigClientOrderId   string  `json:"origClientOrderId"`
+	OrderId             int64   `json:"orderId"`
+	OrderListId         int64   `json:"orderListId"`
+	ClientOrderId       string  `json:"clientOrderId"`
+	Price               Decimal `json:"price"`
+	OrigQty             Decimal `json:"origQty"`
+	ExecutedQty         Decimal `json:"executedQty"`
+	CummulativeQuoteQty Decimal `json:"cummulativeQuoteQty"`
+	Status              string  `json:"status"`
+	TimeInForce         string  `json:"timeInForce"`
+	Type                string  `json:"type"`
+	Side                string  `json:"side"`
 }
 
 func (r *Parser) ParseCancelOrder(b []byte) (*CancelOrder, error) {
@@ -263,28 +263,28 @@ func (r *Parser) ParseCancelOrder(b []byte) (*CancelOrder, error) {
 			return nil, err
 		}
 	}
-	if v, err := jsonparser.GetString(b, "price"); err == nil {
+	if v, err := GetDecimal(b, "price"); err == nil {
 		result.Price = v
 	} else {
 		if err = r.errorParser(err); err != nil {
 			return nil, err
 		}
 	}
-	if v, err := jsonparser.GetString(b, "origQty"); err == nil {
+	if v, err := GetDecimal(b, "origQty"); err == nil {
 		result.OrigQty = v
 	} else {
 		if err = r.errorParser(err); err != nil {
 			return nil, err
 		}
 	}
-	if v, err := jsonparser.GetString(b, "executedQty"); err == nil {
+	if v, err := GetDecimal(b, "executedQty"); err == nil {
 		result.ExecutedQty = v
 	} else {
 		if err = r.errorParser(err); err != nil {
 			return nil, err
 		}
 	}
-	if v, err := jsonparser.GetString(b, "cummulativeQuoteQty"); err == nil {
+	if v, err := GetDecimal(b, "cummulativeQuoteQty"); err == nil {
 		result.CummulativeQuoteQty = v
 	} else {
 		if err = r.errorParser(err); err != nil {
@@ -323,6 +323,112 @@ func (r *Parser) ParseCancelOrder(b []byte) (*CancelOrder, error) {
 	return result, nil
 }
 
+// CancelReplaceOrder
+
+type CancelReplaceOrderParam struct {
+	// OrderParam (OrderParam): the new order
+	OrderParam
+	CancelReplaceMode       CancelReplaceMode `json:"cancelReplaceMode" param:"cancelReplaceMode" validate:"required"`
+	CancelNewClientOrderId  string            `json:"cancelNewClientOrderId" param:"cancelNewClientOrderId"`
+	CancelOrigClientOrderId string            `json:"cancelOrigClientOrderId" param:"cancelOrigClientOrderId"`
+	CancelOrderId           int64             `json:"cancelOrderId" param:"cancelOrderId"`
+	TrailingDelta           int64             `json:"trailingDelta" param:"trailingDelta"`
+}
+
+// ErrorResponse
+// error payload of the exchange, {"code": -2011, "msg": "Unknown order sent."}
+type ErrorResponse struct {
+	Code int64  `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// CancelReplaceOrder
+// for each of the cancel and the new order, either the response or the error is set,
+// both are nil when the new order is NOT_ATTEMPTED
+type CancelReplaceOrder struct {
+	CancelResult     CancelReplaceResult `json:"cancelResult"`
+	NewOrderResult   CancelReplaceResult `json:"newOrderResult"`
+	CancelResponse   *CancelOrder        `json:"cancelResponse,omitempty"`
+	CancelError      *ErrorResponse      `json:"cancelError,omitempty"`
+	NewOrderResponse *Order              `json:"newOrderResponse,omitempty"`
+	NewOrderError    *ErrorResponse      `json:"newOrderError,omitempty"`
+}
+
+// ParseCancelReplaceOrder
+// parse the success response, or the "data" of the failure response (HTTP 400 and 409)
+func (r *Parser) ParseCancelReplaceOrder(b []byte) (*CancelReplaceOrder, error) {
+	if v, dataType, _, err := jsonparser.Get(b, "data"); err == nil && dataType == jsonparser.Object {
+		b = v
+	}
+
+	result := new(CancelReplaceOrder)
+
+	if v, err := jsonparser.GetString(b, "cancelResult"); err == nil {
+		result.CancelResult = v
+	} else {
+		if err = r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "newOrderResult"); err == nil {
+		result.NewOrderResult = v
+	} else {
+		if err = r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, dataType, _, err := jsonparser.Get(b, "cancelResponse"); err == nil && dataType == jsonparser.Object {
+		if isErrorResponse(v) {
+			if result.CancelError, err = r.parseErrorResponse(v); err != nil {
+				return nil, err
+			}
+		} else {
+			if result.CancelResponse, err = r.ParseCancelOrder(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if v, dataType, _, err := jsonparser.Get(b, "newOrderResponse"); err == nil && dataType == jsonparser.Object {
+		if isErrorResponse(v) {
+			if result.NewOrderError, err = r.parseErrorResponse(v); err != nil {
+				return nil, err
+			}
+		} else {
+			if result.NewOrderResponse, err = r.ParseOrder(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func isErrorResponse(b []byte) bool {
+	_, _, _, err := jsonparser.Get(b, "code")
+	return err == nil
+}
+
+func (r *Parser) parseErrorResponse(b []byte) (*ErrorResponse, error) {
+	result := new(ErrorResponse)
+
+	if v, err := jsonparser.GetInt(b, "code"); err == nil {
+		result.Code = v
+	} else {
+		if err = r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "msg"); err == nil {
+		result.Msg = v
+	} else {
+		if err = r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
 // CancelOpenOrder
 
 type CancelOpenOrderParam struct {
@@ -336,10 +442,10 @@ type CancelOpenOrder struct {
 	OrderId             int64                `json:"orderId,omitempty"`
 	OrderListId         int64                `json:"orderListId,omitempty"`
 	ClientOrderId       string               `json:"clientOrderId,omitempty"`
-	Price               string               `json:"price,omitempty"`
-	OrigQty             string               `json:"origQty,omitempty"`
-	ExecutedQty         string               `json:"executedQty,omitempty"`
-	CummulativeQuoteQty string               `json:"cummulativeQuoteQty,omitempty"`
+	Price               Decimal              `json:"price,omitempty"`
+	OrigQty             Decimal              `json:"origQty,omitempty"`
+	ExecutedQty         Decimal              `json:"executedQty,omitempty"`
+	CummulativeQuoteQty Decimal              `json:"cummulativeQuoteQty,omitempty"`
 	Status              string               `json:"status,omitempty"`
 	TimeInForce         string               `json:"timeInForce,omitempty"`
 	Type                string               `json:"type,omitempty"`
@@ -360,21 +466,21 @@ type CancelOrderId struct {
 }
 
 type CancelOrderReport struct {
-	Symbol              string `json:"symbol"`
-	OrigClientOrderId   string `json:"origClientOrderId"`
-	OrderId             int64  `json:"orderId"`
-	OrderListId         int64  `json:"orderListId"`
-	ClientOrderId       string `json:"clientOrderId"`
-	Price               string `json:"price"`
-	OrigQty             string `json:"origQty"`
-	ExecutedQty         string `json:"executedQty"`
-	CummulativeQuoteQty string `json:"cummulativeQuoteQty"`
-	Status              string `json:"status"`
-	TimeInForce         string `json:"timeInForce"`
-	Type                string `json:"type"`
-	Side                string `json:"side"`
-	StopPrice           string `json:"stopPrice"`
-	IcebergQty          string `json:"icebergQty"`
+	Symbol              string  `json:"symbol"`
+	OrigClientOrderId   string  `json:"origClientOrderId"`
+	OrderId             int64   `json:"orderId"`
+	OrderListId         int64   `json:"orderListId"`
+	ClientOrderId       string  `json:"clientOrderId"`
+	Price               Decimal `json:"price"`
+	OrigQty             Decimal `json:"origQty"`
+	ExecutedQty         Decimal `json:"executedQty"`
+	CummulativeQuoteQty Decimal `json:"cummulativeQuoteQty"`
+	Status              string  `json:"status"`
+	TimeInForce         string  `json:"timeInForce"`
+	Type                string  `json:"type"`
+	Side                string  `json:"side"`
+	StopPrice           Decimal `json:"stopPrice"`
+	IcebergQty          Decimal `json:"icebergQty"`
 }
 
 func (r *Parser) ParseCancelOpenOrder(b []byte) ([]*CancelOpenOrder, error) {
@@ -418,28 +524,28 @@ func (r *Parser) ParseCancelOpenOrder(b []byte) ([]*CancelOpenOrder, error) {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "price"); err == nil {
+		if v, err := GetDecimal(value, "price"); err == nil {
 			item.Price = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "origQty"); err == nil {
+		if v, err := GetDecimal(value, "origQty"); err == nil {
 			item.OrigQty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "executedQty"); err == nil {
+		if v, err := GetDecimal(value, "executedQty"); err == nil {
 			item.ExecutedQty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "cummulativeQuoteQty"); err == nil {
+		if v, err := GetDecimal(value, "cummulativeQuoteQty"); err == nil {
 			item.CummulativeQuoteQty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
@@ -579,28 +685,28 @@ func (r *Parser) ParseCancelOpenOrder(b []byte) ([]*CancelOpenOrder, error) {
 					return
 				}
 			}
-			if v, err := jsonparser.GetString(value, "price"); err == nil {
+			if v, err := GetDecimal(value, "price"); err == nil {
 				report.Price = v
 			} else {
 				if __err = r.errorParser(err); __err != nil {
 					return
 				}
 			}
-			if v, err := jsonparser.GetString(value, "origQty"); err == nil {
+			if v, err := GetDecimal(value, "origQty"); err == nil {
 				report.OrigQty = v
 			} else {
 				if __err = r.errorParser(err); __err != nil {
 					return
 				}
 			}
-			if v, err := jsonparser.GetString(value, "executedQty"); err == nil {
+			if v, err := GetDecimal(value, "executedQty"); err == nil {
 				report.ExecutedQty = v
 			} else {
 				if __err = r.errorParser(err); __err != nil {
 					return
 				}
 			}
-			if v, err := jsonparser.GetString(value, "cummulativeQuoteQty"); err == nil {
+			if v, err := GetDecimal(value, "cummulativeQuoteQty"); err == nil {
 				report.CummulativeQuoteQty = v
 			} else {
 				if __err = r.errorParser(err); __err != nil {
@@ -635,14 +741,14 @@ func (r *Parser) ParseCancelOpenOrder(b []byte) ([]*CancelOpenOrder, error) {
 					return
 				}
 			}
-			if v, err := jsonparser.GetString(value, "stopPrice"); err == nil {
+			if v, err := GetDecimal(value, "stopPrice"); err == nil {
 				report.StopPrice = v
 			} else {
 				if __err = r.errorParser(err); __err != nil {
 					return
 				}
 			}
-			if v, err := jsonparser.GetString(value, "icebergQty"); err == nil {
+			if v, err := GetDecimal(value, "icebergQty"); err == nil {
 				report.IcebergQty = v
 			} else {
 				if __err = r.errorParser(err); __err != nil {
@@ -679,20 +785,20 @@ type GetOrder struct {
 	OrderId             int64     `json:"orderId"`
 	OrderListId         int64     `json:"orderListId"`
 	ClientOrderId       string    `json:"clientOrderId"`
-	Price               string    `json:"price"`
-	OrigQty             string    `json:"origQty"`
-	ExecutedQty         string    `json:"executedQty"`
-	CummulativeQuoteQty string    `json:"cummulativeQuoteQty"`
+	Price               Decimal   `json:"price"`
+	OrigQty             Decimal   `json:"origQty"`
+	ExecutedQty         Decimal   `json:"executedQty"`
+	CummulativeQuoteQty Decimal   `json:"cummulativeQuoteQty"`
 	Status              string    `json:"status"`
 	TimeInForce         string    `json:"timeInForce"`
 	Type                string    `json:"type"`
 	Side                string    `json:"side"`
-	StopPrice           string    `json:"stopPrice"`
-	IcebergQty          string    `json:"icebergQty"`
+	StopPrice           Decimal   `json:"stopPrice"`
+	IcebergQty          Decimal   `json:"icebergQty"`
 	Time                time.Time `json:"time"`
 	UpdateTime          time.Time `json:"updateTime"`
 	IsWorking           bool      `json:"isWorking"`
-	OrigQuoteOrderQty   string    `json:"origQuoteOrderQty"`
+	OrigQuoteOrderQty   Decimal   `json:"origQuoteOrderQty"`
 }
 
 func (r *Parser) ParseGetOrder(b []byte) (*GetOrder, error) {
@@ -726,28 +832,28 @@ func (r *Parser) ParseGetOrder(b []byte) (*GetOrder, error) {
 			return nil, err
 		}
 	}
-	if v, err := jsonparser.GetString(b, "price"); err == nil {
+	if v, err := GetDecimal(b, "price"); err == nil {
 		result.Price = v
 	} else {
 		if err := r.errorParser(err); err != nil {
 			return nil, err
 		}
 	}
-	if v, err := jsonparser.GetString(b, "origQty"); err == nil {
+	if v, err := GetDecimal(b, "origQty"); err == nil {
 		result.OrigQty = v
 	} else {
 		if err := r.errorParser(err); err != nil {
 			return nil, err
 		}
 	}
-	if v, err := jsonparser.GetString(b, "executedQty"); err == nil {
+	if v, err := GetDecimal(b, "executedQty"); err == nil {
 		result.ExecutedQty = v
 	} else {
 		if err := r.errorParser(err); err != nil {
 			return nil, err
 		}
 	}
-	if v, err := jsonparser.GetString(b, "cummulativeQuoteQty"); err == nil {
+	if v, err := GetDecimal(b, "cummulativeQuoteQty"); err == nil {
 		result.CummulativeQuoteQty = v
 	} else {
 		if err := r.errorParser(err); err != nil {
@@ -782,14 +888,14 @@ func (r *Parser) ParseGetOrder(b []byte) (*GetOrder, error) {
 			return nil, err
 		}
 	}
-	if v, err := jsonparser.GetString(b, "stopPrice"); err == nil {
+	if v, err := GetDecimal(b, "stopPrice"); err == nil {
 		result.StopPrice = v
 	} else {
 		if err := r.errorParser(err); err != nil {
 			return nil, err
 		}
 	}
-	if v, err := jsonparser.GetString(b, "icebergQty"); err == nil {
+	if v, err := GetDecimal(b, "icebergQty"); err == nil {
 		result.IcebergQty = v
 	} else {
 		if err := r.errorParser(err); err != nil {
@@ -817,7 +923,7 @@ func (r *Parser) ParseGetOrder(b []byte) (*GetOrder, error) {
 			return nil, err
 		}
 	}
-	if v, err := jsonparser.GetString(b, "origQuoteOrderQty"); err == nil {
+	if v, err := GetDecimal(b, "origQuoteOrderQty"); err == nil {
 		result.OrigQuoteOrderQty = v
 	} else {
 		if err := r.errorParser(err); err != nil {
@@ -875,14 +981,14 @@ type NewOcoOrderParam struct {
 	Symbol               string            `json:"symbol" param:"symbol" validate:"required"`
 	ListClientOrderId    string            `json:"listClientOrderId" param:"listClientOrderId"`
 	Side                 OrderSide         `json:"side" param:"side" validate:"required"`
-	Quantity             float64           `json:"quantity" param:"quantity" validate:"required"`
+	Quantity             Decimal           `json:"quantity" param:"quantity" validate:"required"`
 	LimitClientOrderId   string            `json:"limitClientOrderId" param:"limitClientOrderId"`
-	Price                float64           `json:"price" param:"price" validate:"required"`
-	LimitIcebergQty      float64           `json:"limitIcebergQty" param:"limitIcebergQty"`
+	Price                Decimal           `json:"price" param:"price" validate:"required"`
+	LimitIcebergQty      Decimal           `json:"limitIcebergQty" param:"limitIcebergQty"`
 	StopClientOrderId    string            `json:"stopClientOrderId" param:"stopClientOrderId"`
-	StopPrice            float64           `json:"stopPrice" param:"stopPrice" validate:"required"`
-	StopLimitPrice       float64           `json:"stopLimitPrice" param:"stopLimitPrice"`
-	StopIcebergQty       float64           `json:"stopIcebergQty" param:"stopIcebergQty"`
+	StopPrice            Decimal           `json:"stopPrice" param:"stopPrice" validate:"required"`
+	StopLimitPrice       Decimal           `json:"stopLimitPrice" param:"stopLimitPrice"`
+	StopIcebergQty       Decimal           `json:"stopIcebergQty" param:"stopIcebergQty"`
 	StopLimitTimeInForce TimeInForce       `json:"stopLimitTimeInForce" param:"stopLimitTimeInForce"`
 	NewOrderRespType     OrderResponseType `json:"newOrderRespType" param:"newOrderRespType"`
 	RecvWindow           int64             `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
@@ -912,15 +1018,15 @@ type OcoOrderReport struct {
 	OrderListId         int64     `json:"orderListId"`
 	ClientOrderId       string    `json:"clientOrderId"`
 	TransactionTime     time.Time `json:"transactionTime"`
-	Price               string    `json:"price"`
-	OrigQty             string    `json:"origQty"`
-	ExecutedQty         string    `json:"executedQty"`
-	CummulativeQuoteQty string    `json:"cummulativeQuoteQty"`
+	Price               Decimal   `json:"price"`
+	OrigQty             Decimal   `json:"origQty"`
+	ExecutedQty         Decimal   `json:"executedQty"`
+	CummulativeQuoteQty Decimal   `json:"cummulativeQuoteQty"`
 	Status              string    `json:"status"`
 	TimeInForce         string    `json:"timeInForce"`
 	Type                string    `json:"type"`
 	Side                string    `json:"side"`
-	StopPrice           string    `json:"stopPrice"`
+	StopPrice           Decimal   `json:"stopPrice"`
 }
 
 func (r *Parser) ParseNewOcoOrder(b []byte) (*OcoOrder, error) {
@@ -1027,28 +1133,28 @@ func (r *Parser) ParseNewOcoOrder(b []byte) (*OcoOrder, error) {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(b, "price"); err == nil {
+		if v, err := GetDecimal(b, "price"); err == nil {
 			item.Price = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(b, "origQty"); err == nil {
+		if v, err := GetDecimal(b, "origQty"); err == nil {
 			item.OrigQty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(b, "executedQty"); err == nil {
+		if v, err := GetDecimal(b, "executedQty"); err == nil {
 			item.ExecutedQty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(b, "cummulativeQuoteQty"); err == nil {
+		if v, err := GetDecimal(b, "cummulativeQuoteQty"); err == nil {
 			item.CummulativeQuoteQty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
@@ -1083,7 +1189,7 @@ func (r *Parser) ParseNewOcoOrder(b []byte) (*OcoOrder, error) {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(b, "stopPrice"); err == nil {
+		if v, err := GetDecimal(b, "stopPrice"); err == nil {
 			item.StopPrice = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
@@ -1151,20 +1257,20 @@ type CancelOcoOrder struct {
 }
 
 type CancelOcoOrderReport struct {
-	Symbol              string `json:"symbol"`
-	OrigClientOrderId   string `json:"origClientOrderId"`
-	OrderId             int64  `json:"orderId"`
-	OrderListId         int64  `json:"orderListId"`
-	ClientOrderId       string `json:"clientOrderId"`
-	Price               string `json:"price"`
-	OrigQty             string `json:"origQty"`
-	ExecutedQty         string `json:"executedQty"`
-	CummulativeQuoteQty string `json:"cummulativeQuoteQty"`
-	Status              string `json:"status"`
-	TimeInForce         string `json:"timeInForce"`
-	Type                string `json:"type"`
-	Side                string `json:"side"`
-	StopPrice           string `json:"stopPrice"`
+	Symbol              string  `json:"symbol"`
+	OrigClientOrderId   string  `json:"origClientOrderId"`
+	OrderId             int64   `json:"orderId"`
+	OrderListId         int64   `json:"orderListId"`
+	ClientOrderId       string  `json:"clientOrderId"`
+	Price               Decimal `json:"price"`
+	OrigQty             Decimal `json:"origQty"`
+	ExecutedQty         Decimal `json:"executedQty"`
+	CummulativeQuoteQty Decimal `json:"cummulativeQuoteQty"`
+	Status              string  `json:"status"`
+	TimeInForce         string  `json:"timeInForce"`
+	Type                string  `json:"type"`
+	Side                string  `json:"side"`
+	StopPrice           Decimal `json:"stopPrice"`
 }
 
 func (r *Parser) ParseCancelOcoOrder(b []byte) (*CancelOcoOrder, error) {
@@ -1271,28 +1377,28 @@ func (r *Parser) ParseCancelOcoOrder(b []byte) (*CancelOcoOrder, error) {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "price"); err == nil {
+		if v, err := GetDecimal(value, "price"); err == nil {
 			item.Price = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "origQty"); err == nil {
+		if v, err := GetDecimal(value, "origQty"); err == nil {
 			item.OrigQty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "executedQty"); err == nil {
+		if v, err := GetDecimal(value, "executedQty"); err == nil {
 			item.ExecutedQty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "cummulativeQuoteQty"); err == nil {
+		if v, err := GetDecimal(value, "cummulativeQuoteQty"); err == nil {
 			item.CummulativeQuoteQty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
@@ -1327,7 +1433,7 @@ func (r *Parser) ParseCancelOcoOrder(b []byte) (*CancelOcoOrder, error) {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "stopPrice"); err == nil {
+		if v, err := GetDecimal(value, "stopPrice"); err == nil {
 			item.StopPrice = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
@@ -1492,9 +1598,9 @@ type Account struct {
 }
 
 type AccountBalance struct {
-	Asset  string `json:"asset"`
-	Free   string `json:"free"`
-	Locked string `json:"locked"`
+	Asset  string  `json:"asset"`
+	Free   Decimal `json:"free"`
+	Locked Decimal `json:"locked"`
 }
 
 func (r *Parser) ParseAccount(b []byte) (*Account, error) {
@@ -1574,14 +1680,14 @@ func (r *Parser) ParseAccount(b []byte) (*Account, error) {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "free"); err == nil {
+		if v, err := GetDecimal(value, "free"); err == nil {
 			item.Free = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "locked"); err == nil {
+		if v, err := GetDecimal(value, "locked"); err == nil {
 			item.Locked = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
@@ -1624,10 +1730,10 @@ type MyTrade struct {
 	Id              int64     `json:"id"`
 	OrderId         int64     `json:"orderId"`
 	OrderListId     int64     `json:"orderListId"`
-	Price           string    `json:"price"`
-	Qty             string    `json:"qty"`
-	QuoteQty        string    `json:"quoteQty"`
-	Commission      string    `json:"commission"`
+	Price           Decimal   `json:"price"`
+	Qty             Decimal   `json:"qty"`
+	QuoteQty        Decimal   `json:"quoteQty"`
+	Commission      Decimal   `json:"commission"`
 	CommissionAsset string    `json:"commissionAsset"`
 	Time            time.Time `json:"time"`
 	IsBuyer         bool      `json:"isBuyer"`
@@ -1669,28 +1775,28 @@ func (r *Parser) ParseMyTrades(b []byte) ([]*MyTrade, error) {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "price"); err == nil {
+		if v, err := GetDecimal(value, "price"); err == nil {
 			item.Price = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "qty"); err == nil {
+		if v, err := GetDecimal(value, "qty"); err == nil {
 			item.Qty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "quoteQty"); err == nil {
+		if v, err := GetDecimal(value, "quoteQty"); err == nil {
 			item.QuoteQty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "commission"); err == nil {
+		if v, err := GetDecimal(value, "commission"); err == nil {
 			item.Commission = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
@@ -44,6 +44,55 @@ func TestParser_ParseCancelOrder(t *testing.T) {
 	}
 }
 
+// CancelReplaceOrder
+func TestParser_ParseCancelReplaceOrder(t *testing.T) {
+	bytes := []byte(`{"cancelResult":"SUCCESS","newOrderResult":"SUCCESS","cancelResponse":{"symbol":"BTCUSDT","origClientOrderId":"DnLo3vTAQcjha43lAZhZ0y","orderId":9,"orderListId":-1,"clientOrderId":"osxN3JXAtJvKvCqGeMWMVR","price":"0.01000000","origQty":"0.000100","executedQty":"0.00000000","cummulativeQuoteQty":"0.00000000","status":"CANCELED","timeInForce":"GTC","type":"LIMIT","side":"SELL"},"newOrderResponse":{"symbol":"BTCUSDT","orderId":10,"orderListId":-1,"clientOrderId":"wOceeeOzNORyLiQfw7jd8S","transactTime":1652928801803,"price":"0.02000000","origQty":"0.040000","executedQty":"0.00000000","cummulativeQuoteQty":"0.00000000","status":"NEW","timeInForce":"GTC","type":"LIMIT","side":"BUY","fills":[]}}`)
+
+	parser := NewParser()
+	result, err := parser.ParseCancelReplaceOrder(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.CancelResponse == nil || result.CancelResponse.OrderId != 9 || result.NewOrderResponse == nil || result.NewOrderResponse.OrderId != 10 {
+		t.Errorf("result = %+v", result)
+	}
+	if result.CancelError != nil || result.NewOrderError != nil {
+		t.Errorf("errors = %+v, %+v", result.CancelError, result.NewOrderError)
+	}
+}
+
+func TestParser_ParseCancelReplaceOrder_PartiallyFailed(t *testing.T) {
+	bytes := []byte(`{"code":-2021,"msg":"Order cancel-replace partially failed.","data":{"cancelResult":"FAILURE","newOrderResult":"SUCCESS","cancelResponse":{"code":-2011,"msg":"Unknown order sent."},"newOrderResponse":{"symbol":"BTCUSDT","orderId":11,"orderListId":-1,"clientOrderId":"pfojJMg6IMNDKuJqDxvoxN","transactTime":1648540168818}}}`)
+
+	parser := NewParser()
+	result, err := parser.ParseCancelReplaceOrder(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.CancelResult != CancelReplaceResultFailure || result.NewOrderResult != CancelReplaceResultSuccess {
+		t.Errorf("result = %+v", result)
+	}
+	if result.CancelResponse != nil || result.CancelError == nil || result.CancelError.Code != -2011 || result.CancelError.Msg != "Unknown order sent." {
+		t.Errorf("cancel = %+v, %+v", result.CancelResponse, result.CancelError)
+	}
+	if result.NewOrderResponse == nil || result.NewOrderResponse.OrderId != 11 || result.NewOrderError != nil {
+		t.Errorf("new order = %+v, %+v", result.NewOrderResponse, result.NewOrderError)
+	}
+}
+
+func TestParser_ParseCancelReplaceOrder_Failed(t *testing.T) {
+	bytes := []byte(`{"code":-2022,"msg":"Order cancel-replace failed.","data":{"cancelResult":"FAILURE","newOrderResult":"NOT_ATTEMPTED","cancelResponse":{"code":-2011,"msg":"Unknown order sent."},"newOrderResponse":null}}`)
+
+	parser := NewParser()
+	result, err := parser.ParseCancelReplaceOrder(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.NewOrderResult != CancelReplaceResultNotAttempted || result.CancelError == nil || result.NewOrderResponse != nil || result.NewOrderError != nil {
+		t.Errorf("result = %+v", result)
+	}
+}
+
 // CancelOpenOrder
 func TestParser_ParseCancelOpenOrder(t *testing.T) {
 	bytes := []byte(`[{"symbol":"BTCUSDT","origClientOrderId":"E6APeyTJvkMvLMYMqu1KQ4","orderId":11,"orderListId":-1,"clientOrderId":"pXLV6Hz6mprAcVYpVMTGgx","price":"0.089853","origQty":"0.178622","executedQty":"0.000000","cummulativeQuoteQty":"0.000000","status":"CANCELED","timeInForce":"GTC","type":"LIMIT","side":"BUY"},{"symbol":"BTCUSDT","origClientOrderId":"A3EF2HCwxgZPFMrfwbgrhv","orderId":13,"orderListId":-1,"clientOrderId":"pXLV6Hz6mprAcVYpVMTGgx","price":"0.090430","origQty":"0.178622","executedQty":"0.000000","cummulativeQuoteQty":"0.000000","status":"CANCELED","timeInForce":"GTC","type":"LIMIT","side":"BUY"},{"orderListId":1929,"contingencyType":"OCO","listStatusType":"ALL_DONE","listOrderStatus":"ALL_DONE","listClientOrderId":"2inzWQdDvZLHbbAmAozX2N","transactionTime":1585230948299,"symbol":"BTCUSDT","orders":[{"symbol":"BTCUSDT","orderId":20,"clientOrderId":"CwOOIPHSmYywx6jZX77TdL"},{"symbol":"BTCUSDT","orderId":21,"clientOrderId":"461cPg51vQjV3zIMOXNz39"}],"orderReports":[{"symbol":"BTCUSDT","origClientOrderId":"CwOOIPHSmYywx6jZX77TdL","orderId":20,"orderListId":1929,"clientOrderId":"pXLV6Hz6mprAcVYpVMTGgx","price":"0.668611","origQty":"0.690354","executedQty":"0.000000","cummulativeQuoteQty":"0.000000","status":"CANCELED","timeInForce":"GTC","type":"STOP_LOSS_LIMIT","side":"BUY","stopPrice":"0.378131","icebergQty":"0.017083"},{"symbol":"BTCUSDT","origClientOrderId":"461cPg51vQjV3zIMOXNz39","orderId":21,"orderListId":1929,"clientOrderId":"pXLV6Hz6mprAcVYpVMTGgx","price":"0.008791","origQty":"0.690354","executedQty":"0.000000","cummulativeQuoteQty":"0.000000","status":"CANCELED","timeInForce":"GTC","type":"LIMIT_MAKER","side":"BUY","icebergQty":"0.639962"}]}]`)
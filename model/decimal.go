@@ -0,0 +1,404 @@
+package model
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/buger/jsonparser"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrDecimalInvalid = errors.New("invalid decimal")
+	ErrDecimalStep    = errors.New("step must be greater than zero")
+)
+
+var bigTen = big.NewInt(10)
+
+// maxDecimalExponent
+// limit of the exponent of NewDecimalFromString, e.g. "1e2000000000" is rejected instead of allocating a huge number
+const maxDecimalExponent = 1000
+
+// Decimal
+// exact fixed-point number for price and quantity, the value is coefficient * 10^-scale.
+// the zero value is 0 and a Decimal is immutable, every operation returns a new Decimal
+type Decimal struct {
+	coefficient *big.Int
+	scale       int32
+}
+
+// NewDecimal
+// create decimal coefficient * 10^-scale, e.g. NewDecimal(123, 2) is 1.23
+func NewDecimal(coefficient int64, scale int32) Decimal {
+	if scale < 0 {
+		c := new(big.Int).Mul(big.NewInt(coefficient), pow10(-scale))
+		return Decimal{coefficient: c}
+	}
+	return Decimal{coefficient: big.NewInt(coefficient), scale: scale}
+}
+
+func NewDecimalFromInt(i int64) Decimal {
+	return NewDecimal(i, 0)
+}
+
+// NewDecimalFromFloat
+// create decimal from the shortest representation of float, NaN and Inf are 0
+func NewDecimalFromFloat(f float64) Decimal {
+	d, err := NewDecimalFromString(strconv.FormatFloat(f, 'f', -1, 64))
+	if err != nil {
+		return Decimal{}
+	}
+	return d
+}
+
+// NewDecimalFromString
+// parse decimal from string e.g. "0.00100000", "-12.5", "1e-8"
+func NewDecimalFromString(s string) (Decimal, error) {
+	value := strings.TrimSpace(s)
+	if len(value) == 0 {
+		return Decimal{}, fmt.Errorf("%w: empty string", ErrDecimalInvalid)
+	}
+
+	exponent := int64(0)
+	if i := strings.IndexAny(value, "eE"); i >= 0 {
+		exp, err := strconv.ParseInt(value[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("%w: %s", ErrDecimalInvalid, s)
+		}
+		if exp > maxDecimalExponent || exp < -maxDecimalExponent {
+			return Decimal{}, fmt.Errorf("%w: exponent out of range %s", ErrDecimalInvalid, s)
+		}
+		exponent = exp
+		value = value[:i]
+	}
+
+	scale := int64(0)
+	if i := strings.IndexByte(value, '.'); i >= 0 {
+		scale = int64(len(value) - i - 1)
+		value = value[:i] + value[i+1:]
+	}
+
+	digits := strings.TrimLeft(value, "+-")
+	if len(digits) == 0 || len(value)-len(digits) > 1 || strings.IndexFunc(digits, isNotDigit) >= 0 {
+		return Decimal{}, fmt.Errorf("%w: %s", ErrDecimalInvalid, s)
+	}
+
+	coefficient, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("%w: %s", ErrDecimalInvalid, s)
+	}
+
+	scale -= exponent
+	if scale > math.MaxInt32 {
+		return Decimal{}, fmt.Errorf("%w: scale out of range %s", ErrDecimalInvalid, s)
+	}
+	if scale < 0 {
+		coefficient.Mul(coefficient, pow10(int32(-scale)))
+		scale = 0
+	}
+
+	return Decimal{coefficient: coefficient, scale: int32(scale)}, nil
+}
+
+// MustDecimal
+// same as NewDecimalFromString but panic when string is invalid
+func MustDecimal(s string) Decimal {
+	d, err := NewDecimalFromString(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// GetDecimal
+// get decimal from json by keys, the value can be string or number
+func GetDecimal(data []byte, keys ...string) (Decimal, error) {
+	v, dataType, _, err := jsonparser.Get(data, keys...)
+	if err != nil {
+		return Decimal{}, err
+	}
+	if dataType != jsonparser.String && dataType != jsonparser.Number {
+		return Decimal{}, fmt.Errorf("Value is not a number: %s", string(v))
+	}
+
+	return NewDecimalFromString(string(v))
+}
+
+func (d Decimal) coef() *big.Int {
+	if d.coefficient == nil {
+		return new(big.Int)
+	}
+	return d.coefficient
+}
+
+// rescale
+// coefficient of d at scale, scale must be >= d.scale
+func (d Decimal) rescale(scale int32) *big.Int {
+	c := new(big.Int).Set(d.coef())
+	if scale > d.scale {
+		c.Mul(c, pow10(scale-d.scale))
+	}
+	return c
+}
+
+// Scale
+// number of digits after decimal point
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+func (d Decimal) Sign() int {
+	return d.coef().Sign()
+}
+
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+func (d Decimal) IsPositive() bool {
+	return d.Sign() > 0
+}
+
+func (d Decimal) IsNegative() bool {
+	return d.Sign() < 0
+}
+
+func (d Decimal) Add(d2 Decimal) Decimal {
+	scale := maxScale(d, d2)
+	return Decimal{coefficient: new(big.Int).Add(d.rescale(scale), d2.rescale(scale)), scale: scale}
+}
+
+func (d Decimal) Sub(d2 Decimal) Decimal {
+	scale := maxScale(d, d2)
+	return Decimal{coefficient: new(big.Int).Sub(d.rescale(scale), d2.rescale(scale)), scale: scale}
+}
+
+func (d Decimal) Mul(d2 Decimal) Decimal {
+	return Decimal{coefficient: new(big.Int).Mul(d.coef(), d2.coef()), scale: d.scale + d2.scale}
+}
+
+// Div
+// d / d2 truncated toward zero at scale digits, panic when d2 is zero
+func (d Decimal) Div(d2 Decimal, scale int32) Decimal {
+	if d2.IsZero() {
+		panic("decimal division by zero")
+	}
+	if scale < 0 {
+		scale = 0
+	}
+
+	numerator := new(big.Int).Mul(d.coef(), pow10(scale+d2.scale))
+	denominator := new(big.Int).Mul(d2.coef(), pow10(d.scale))
+	return Decimal{coefficient: numerator.Quo(numerator, denominator), scale: scale}
+}
+
+func (d Decimal) Neg() Decimal {
+	return Decimal{coefficient: new(big.Int).Neg(d.coef()), scale: d.scale}
+}
+
+func (d Decimal) Abs() Decimal {
+	return Decimal{coefficient: new(big.Int).Abs(d.coef()), scale: d.scale}
+}
+
+// Cmp
+// -1 if d < d2, 0 if d == d2, +1 if d > d2
+func (d Decimal) Cmp(d2 Decimal) int {
+	scale := maxScale(d, d2)
+	return d.rescale(scale).Cmp(d2.rescale(scale))
+}
+
+func (d Decimal) Equal(d2 Decimal) bool {
+	return d.Cmp(d2) == 0
+}
+
+func (d Decimal) LessThan(d2 Decimal) bool {
+	return d.Cmp(d2) < 0
+}
+
+func (d Decimal) LessThanOrEqual(d2 Decimal) bool {
+	return d.Cmp(d2) <= 0
+}
+
+func (d Decimal) GreaterThan(d2 Decimal) bool {
+	return d.Cmp(d2) > 0
+}
+
+func (d Decimal) GreaterThanOrEqual(d2 Decimal) bool {
+	return d.Cmp(d2) >= 0
+}
+
+// Truncate
+// drop digits after places toward zero
+func (d Decimal) Truncate(places int32) Decimal {
+	if places < 0 || d.scale <= places {
+		return d
+	}
+
+	c := new(big.Int).Quo(d.coef(), pow10(d.scale-places))
+	return Decimal{coefficient: c, scale: places}
+}
+
+// Round
+// round to places, half away from zero
+func (d Decimal) Round(places int32) Decimal {
+	if places < 0 || d.scale <= places {
+		return d
+	}
+
+	divisor := pow10(d.scale - places)
+	q, r := new(big.Int).QuoRem(d.coef(), divisor, new(big.Int))
+	if r.Abs(r).Mul(r, big.NewInt(2)).Cmp(divisor) >= 0 {
+		q.Add(q, big.NewInt(int64(d.Sign())))
+	}
+	return Decimal{coefficient: q, scale: places}
+}
+
+// FloorToStep
+// the largest multiple of step that is less than or equal to d, e.g. quantity to LOT_SIZE stepSize
+func (d Decimal) FloorToStep(step Decimal) (Decimal, error) {
+	if !step.IsPositive() {
+		return Decimal{}, ErrDecimalStep
+	}
+
+	scale := maxScale(d, step)
+	s := step.rescale(scale)
+	q := new(big.Int).Div(d.rescale(scale), s)
+	return Decimal{coefficient: q.Mul(q, s), scale: scale}, nil
+}
+
+// CeilToStep
+// the smallest multiple of step that is greater than or equal to d
+func (d Decimal) CeilToStep(step Decimal) (Decimal, error) {
+	v, err := d.Neg().FloorToStep(step)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return v.Neg(), nil
+}
+
+// RoundToStep
+// the nearest multiple of step, half up, e.g. price to PRICE_FILTER tickSize
+func (d Decimal) RoundToStep(step Decimal) (Decimal, error) {
+	if !step.IsPositive() {
+		return Decimal{}, ErrDecimalStep
+	}
+
+	scale := maxScale(d, step)
+	s := step.rescale(scale)
+	q, r := new(big.Int).DivMod(d.rescale(scale), s, new(big.Int))
+	if r.Mul(r, big.NewInt(2)).Cmp(s) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return Decimal{coefficient: q.Mul(q, s), scale: scale}, nil
+}
+
+// IsMultipleOf
+// true when d is multiple of step
+func (d Decimal) IsMultipleOf(step Decimal) bool {
+	if step.IsZero() {
+		return false
+	}
+
+	scale := maxScale(d, step)
+	r := new(big.Int).Rem(d.rescale(scale), step.rescale(scale))
+	return r.Sign() == 0
+}
+
+// Trim
+// remove trailing zeros after decimal point, e.g. 1.2300 to 1.23
+func (d Decimal) Trim() Decimal {
+	c := new(big.Int).Set(d.coef())
+	scale := d.scale
+	r := new(big.Int)
+	for scale > 0 {
+		q, m := new(big.Int).QuoRem(c, bigTen, r)
+		if m.Sign() != 0 {
+			break
+		}
+		c = q
+		scale--
+	}
+	return Decimal{coefficient: c, scale: scale}
+}
+
+// String
+// exact value without exponent, keep all digits of scale
+func (d Decimal) String() string {
+	return d.format(d.scale)
+}
+
+// StringFixed
+// value with exactly places digits after decimal point, rounded half away from zero
+func (d Decimal) StringFixed(places int32) string {
+	if places < 0 {
+		places = 0
+	}
+	return d.Round(places).format(places)
+}
+
+func (d Decimal) format(places int32) string {
+	c := d.coef()
+	if places > d.scale {
+		c = d.rescale(places)
+	}
+
+	digits := new(big.Int).Abs(c).String()
+	if places > 0 {
+		if len(digits) <= int(places) {
+			digits = strings.Repeat("0", int(places)-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-int(places)] + "." + digits[len(digits)-int(places):]
+	}
+	if c.Sign() < 0 {
+		digits = "-" + digits
+	}
+	return digits
+}
+
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Decimal) UnmarshalText(text []byte) error {
+	v, err := NewDecimalFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*d = Decimal{}
+		return nil
+	}
+	return d.UnmarshalText(bytes.Trim(b, `"`))
+}
+
+func maxScale(d Decimal, d2 Decimal) int32 {
+	if d.scale > d2.scale {
+		return d.scale
+	}
+	return d2.scale
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+func isNotDigit(r rune) bool {
+	return r < '0' || r > '9'
+}
@@ -0,0 +1,124 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewDecimalFromString(t *testing.T) {
+	cases := map[string]string{
+		"0.00100000": "0.00100000",
+		"-12.5":      "-12.5",
+		"+3":         "3",
+		".5":         "0.5",
+		"1e-8":       "0.00000001",
+		"1.5E3":      "1500",
+		"0":          "0",
+	}
+	for input, want := range cases {
+		d, err := NewDecimalFromString(input)
+		if err != nil {
+			t.Errorf("parse %s error: %v", input, err)
+			continue
+		}
+		if d.String() != want {
+			t.Errorf("parse %s = %s, want %s", input, d.String(), want)
+		}
+	}
+
+	if d, err := NewDecimalFromString("1e1000"); err != nil || len(d.String()) != 1001 {
+		t.Errorf("parse 1e1000 = %s, err = %v", d.String(), err)
+	}
+	for _, input := range []string{"", "abc", "1.2.3", "--1", "1e", "1e2000000000", "1e-1001", "1e1001"} {
+		if _, err := NewDecimalFromString(input); err == nil {
+			t.Errorf("parse %q expected error", input)
+		}
+	}
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	a := MustDecimal("0.1")
+	b := MustDecimal("0.2")
+
+	if v := a.Add(b); v.String() != "0.3" {
+		t.Errorf("0.1 + 0.2 = %s", v)
+	}
+	if v := a.Sub(b); v.String() != "-0.1" {
+		t.Errorf("0.1 - 0.2 = %s", v)
+	}
+	if v := a.Mul(b); v.String() != "0.02" {
+		t.Errorf("0.1 * 0.2 = %s", v)
+	}
+	if v := MustDecimal("1").Div(MustDecimal("3"), 8); v.String() != "0.33333333" {
+		t.Errorf("1 / 3 = %s", v)
+	}
+	if !MustDecimal("1.50").Equal(MustDecimal("1.5")) || !a.LessThan(b) {
+		t.Error("compare error")
+	}
+	if v := (Decimal{}).Add(a); v.String() != "0.1" {
+		t.Errorf("zero value + 0.1 = %s", v)
+	}
+}
+
+func TestDecimal_Rounding(t *testing.T) {
+	d := MustDecimal("1.23456")
+
+	if v := d.Truncate(2); v.String() != "1.23" {
+		t.Errorf("truncate = %s", v)
+	}
+	if v := d.Round(3); v.String() != "1.235" {
+		t.Errorf("round = %s", v)
+	}
+	if v := MustDecimal("-1.5").Round(0); v.String() != "-2" {
+		t.Errorf("round negative = %s", v)
+	}
+	if v, _ := d.FloorToStep(MustDecimal("0.01000000")); v.String() != "1.23000000" {
+		t.Errorf("floor to step = %s", v)
+	}
+	if v, _ := d.CeilToStep(MustDecimal("0.01")); v.String() != "1.24000" {
+		t.Errorf("ceil to step = %s", v)
+	}
+	if v, _ := MustDecimal("1.235").RoundToStep(MustDecimal("0.01")); v.String() != "1.240" {
+		t.Errorf("round to step = %s", v)
+	}
+	if _, err := d.FloorToStep(Decimal{}); err != ErrDecimalStep {
+		t.Errorf("floor to zero step error = %v", err)
+	}
+	if !MustDecimal("0.003").IsMultipleOf(MustDecimal("0.001")) || MustDecimal("0.0031").IsMultipleOf(MustDecimal("0.001")) {
+		t.Error("multiple of step error")
+	}
+	if v := MustDecimal("1.2300").Trim(); v.String() != "1.23" {
+		t.Errorf("trim = %s", v)
+	}
+	if v := MustDecimal("0.005").StringFixed(2); v != "0.01" {
+		t.Errorf("string fixed = %s", v)
+	}
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	var value struct {
+		Price Decimal `json:"price"`
+		Qty   Decimal `json:"qty"`
+	}
+	if err := json.Unmarshal([]byte(`{"price":"0.00000001","qty":12.5}`), &value); err != nil {
+		t.Fatal(err)
+	}
+	if value.Price.String() != "0.00000001" || value.Qty.String() != "12.5" {
+		t.Errorf("unmarshal = %s, %s", value.Price, value.Qty)
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"price":"0.00000001","qty":"12.5"}` {
+		t.Errorf("marshal = %s", string(b))
+	}
+
+	if v := NewDecimalFromFloat(0.1); v.String() != "0.1" {
+		t.Errorf("from float = %s", v)
+	}
+	if v, err := GetDecimal([]byte(`["4.00000200","12.0"]`), "[0]"); err != nil || v.String() != "4.00000200" {
+		t.Errorf("get decimal = %s, %v", v, err)
+	}
+}
@@ -26,6 +26,19 @@ var (
 	SymbolStatusBreak        = SymbolStatus("BREAK")
 )
 
+type SymbolFilterType = string
+
+var (
+	SymbolFilterTypePrice            = SymbolFilterType("PRICE_FILTER")
+	SymbolFilterTypePercentPrice     = SymbolFilterType("PERCENT_PRICE")
+	SymbolFilterTypeLotSize          = SymbolFilterType("LOT_SIZE")
+	SymbolFilterTypeMinNotional      = SymbolFilterType("MIN_NOTIONAL")
+	SymbolFilterTypeIcebergParts     = SymbolFilterType("ICEBERG_PARTS")
+	SymbolFilterTypeMarketLotSize    = SymbolFilterType("MARKET_LOT_SIZE")
+	SymbolFilterTypeMaxNumOrders     = SymbolFilterType("MAX_NUM_ORDERS")
+	SymbolFilterTypeMaxNumAlgoOrders = SymbolFilterType("MAX_NUM_ALGO_ORDERS")
+)
+
 type SymbolType = string
 
 var (
@@ -86,6 +99,25 @@ var (
 	OrderResponseTypeFull   = OrderResponseType("FULL")
 )
 
+type CancelReplaceMode = string
+
+var (
+	// CancelReplaceModeStopOnFailure
+	// the new order is not attempted when the cancel fails
+	CancelReplaceModeStopOnFailure = CancelReplaceMode("STOP_ON_FAILURE")
+	// CancelReplaceModeAllowFailure
+	// the new order is attempted whether the cancel succeeds or not
+	CancelReplaceModeAllowFailure = CancelReplaceMode("ALLOW_FAILURE")
+)
+
+type CancelReplaceResult = string
+
+var (
+	CancelReplaceResultSuccess      = CancelReplaceResult("SUCCESS")
+	CancelReplaceResultFailure      = CancelReplaceResult("FAILURE")
+	CancelReplaceResultNotAttempted = CancelReplaceResult("NOT_ATTEMPTED")
+)
+
 type OrderSide = string
 
 var (
@@ -146,6 +178,7 @@ type RateLimitInterval = string
 var (
 	RateLimitIntervalSecond = RateLimitInterval("SECOND")
 	RateLimitIntervalMinute = RateLimitInterval("MINUTE")
+	RateLimitIntervalHour   = RateLimitInterval("HOUR")
 	RateLimitIntervalDay    = RateLimitInterval("DAY")
 )
 
@@ -156,3 +189,87 @@ var (
 	DeptLevel10 = StreamDeptLevel("10")
 	DeptLevel20 = StreamDeptLevel("20")
 )
+
+// DepositStatus
+// status of DepositHistoryParam, Status of DepositHistory is the same number
+type DepositStatus = string
+
+var (
+	DepositStatusPending                = DepositStatus("0")
+	DepositStatusSuccess                = DepositStatus("1")
+	DepositStatusCreditedCannotWithdraw = DepositStatus("6")
+)
+
+// WithdrawStatus
+// status of WithdrawHistoryParam, Status of WithdrawHistory is the same number
+type WithdrawStatus = string
+
+var (
+	WithdrawStatusEmailSent        = WithdrawStatus("0")
+	WithdrawStatusCancelled        = WithdrawStatus("1")
+	WithdrawStatusAwaitingApproval = WithdrawStatus("2")
+	WithdrawStatusRejected         = WithdrawStatus("3")
+	WithdrawStatusProcessing       = WithdrawStatus("4")
+	WithdrawStatusFailure          = WithdrawStatus("5")
+	WithdrawStatusCompleted        = WithdrawStatus("6")
+)
+
+type UniversalTransferType = string
+
+var (
+	UniversalTransferMainUMFuture                 = UniversalTransferType("MAIN_UMFUTURE")
+	UniversalTransferMainCMFuture                 = UniversalTransferType("MAIN_CMFUTURE")
+	UniversalTransferMainMargin                   = UniversalTransferType("MAIN_MARGIN")
+	UniversalTransferMainFunding                  = UniversalTransferType("MAIN_FUNDING")
+	UniversalTransferUMFutureMain                 = UniversalTransferType("UMFUTURE_MAIN")
+	UniversalTransferUMFutureMargin               = UniversalTransferType("UMFUTURE_MARGIN")
+	UniversalTransferUMFutureFunding              = UniversalTransferType("UMFUTURE_FUNDING")
+	UniversalTransferCMFutureMain                 = UniversalTransferType("CMFUTURE_MAIN")
+	UniversalTransferCMFutureMargin               = UniversalTransferType("CMFUTURE_MARGIN")
+	UniversalTransferCMFutureFunding              = UniversalTransferType("CMFUTURE_FUNDING")
+	UniversalTransferMarginMain                   = UniversalTransferType("MARGIN_MAIN")
+	UniversalTransferMarginUMFuture               = UniversalTransferType("MARGIN_UMFUTURE")
+	UniversalTransferMarginCMFuture               = UniversalTransferType("MARGIN_CMFUTURE")
+	UniversalTransferMarginFunding                = UniversalTransferType("MARGIN_FUNDING")
+	UniversalTransferMarginIsolatedMargin         = UniversalTransferType("MARGIN_ISOLATEDMARGIN")
+	UniversalTransferIsolatedMarginMargin         = UniversalTransferType("ISOLATEDMARGIN_MARGIN")
+	UniversalTransferIsolatedMarginIsolatedMargin = UniversalTransferType("ISOLATEDMARGIN_ISOLATEDMARGIN")
+	UniversalTransferFundingMain                  = UniversalTransferType("FUNDING_MAIN")
+	UniversalTransferFundingUMFuture              = UniversalTransferType("FUNDING_UMFUTURE")
+	UniversalTransferFundingCMFuture              = UniversalTransferType("FUNDING_CMFUTURE")
+	UniversalTransferFundingMargin                = UniversalTransferType("FUNDING_MARGIN")
+)
+
+type SideEffectType = string
+
+var (
+	SideEffectTypeNoSideEffect = SideEffectType("NO_SIDE_EFFECT")
+	SideEffectTypeMarginBuy    = SideEffectType("MARGIN_BUY")
+	SideEffectTypeAutoRepay    = SideEffectType("AUTO_REPAY")
+)
+
+type SubAccountType = string
+
+var (
+	SubAccountTypeSpot           = SubAccountType("SPOT")
+	SubAccountTypeUsdtFuture     = SubAccountType("USDT_FUTURE")
+	SubAccountTypeCoinFuture     = SubAccountType("COIN_FUTURE")
+	SubAccountTypeMargin         = SubAccountType("MARGIN")
+	SubAccountTypeIsolatedMargin = SubAccountType("ISOLATED_MARGIN")
+)
+
+type FuturesType = int64
+
+var (
+	FuturesTypeUsdM  = FuturesType(1)
+	FuturesTypeCoinM = FuturesType(2)
+)
+
+type IpRestrictionStatus = string
+
+var (
+	IpRestrictionStatusUnrestricted = IpRestrictionStatus("1")
+	// IpRestrictionStatusRestricted
+	// access from trusted IPs only
+	IpRestrictionStatusRestricted = IpRestrictionStatus("2")
+)
@@ -0,0 +1,857 @@
+package model
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/buger/jsonparser"
+	"time"
+)
+
+// MarginAccount
+
+type MarginAccountParam struct {
+	RecvWindow int64 `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type MarginAccount struct {
+	BorrowEnabled       bool           `json:"borrowEnabled"`
+	TradeEnabled        bool           `json:"tradeEnabled"`
+	TransferEnabled     bool           `json:"transferEnabled"`
+	MarginLevel         Decimal        `json:"marginLevel"`
+	TotalAssetOfBtc     Decimal        `json:"totalAssetOfBtc"`
+	TotalLiabilityOfBtc Decimal        `json:"totalLiabilityOfBtc"`
+	TotalNetAssetOfBtc  Decimal        `json:"totalNetAssetOfBtc"`
+	UserAssets          []*MarginAsset `json:"userAssets"`
+}
+
+type MarginAsset struct {
+	Asset    string  `json:"asset"`
+	Free     Decimal `json:"free"`
+	Locked   Decimal `json:"locked"`
+	Borrowed Decimal `json:"borrowed"`
+	Interest Decimal `json:"interest"`
+	NetAsset Decimal `json:"netAsset"`
+}
+
+func (r *Parser) ParseMarginAccount(b []byte) (*MarginAccount, error) {
+	result := new(MarginAccount)
+
+	if v, err := jsonparser.GetBoolean(b, "borrowEnabled"); err == nil {
+		result.BorrowEnabled = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "tradeEnabled"); err == nil {
+		result.TradeEnabled = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "transferEnabled"); err == nil {
+		result.TransferEnabled = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "marginLevel"); err == nil {
+		result.MarginLevel = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "totalAssetOfBtc"); err == nil {
+		result.TotalAssetOfBtc = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "totalLiabilityOfBtc"); err == nil {
+		result.TotalLiabilityOfBtc = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "totalNetAssetOfBtc"); err == nil {
+		result.TotalNetAssetOfBtc = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	result.UserAssets = make([]*MarginAsset, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseMarginAsset(value); err == nil {
+			result.UserAssets = append(result.UserAssets, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	}, "userAssets"); err != nil {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseMarginAsset(b []byte) (*MarginAsset, error) {
+	result := new(MarginAsset)
+
+	if v, err := jsonparser.GetString(b, "asset"); err == nil {
+		result.Asset = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "free"); err == nil {
+		result.Free = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "locked"); err == nil {
+		result.Locked = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "borrowed"); err == nil {
+		result.Borrowed = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "interest"); err == nil {
+		result.Interest = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "netAsset"); err == nil {
+		result.NetAsset = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// MarginBorrow, MarginRepay
+
+type MarginLoanParam struct {
+	Asset      string `json:"asset" param:"asset" validate:"required"`
+	IsIsolated bool   `json:"isIsolated" param:"isIsolated"`
+	// Symbol (string): isolated symbol, required when IsIsolated is true
+	Symbol     string  `json:"symbol" param:"symbol" validate:"required_if=IsIsolated true"`
+	Amount     Decimal `json:"amount" param:"amount"`
+	RecvWindow int64   `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type MarginTransaction struct {
+	TranId int64 `json:"tranId"`
+}
+
+func (r *Parser) ParseMarginTransaction(b []byte) (*MarginTransaction, error) {
+	result := new(MarginTransaction)
+
+	if v, err := jsonparser.GetInt(b, "tranId"); err == nil {
+		result.TranId = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// MarginNewOrder
+
+type MarginOrderParam struct {
+	OrderParam
+	IsIsolated bool `json:"isIsolated" param:"isIsolated"`
+	// SideEffectType (SideEffectType): By default, SideEffectTypeNoSideEffect
+	SideEffectType SideEffectType `json:"sideEffectType" param:"sideEffectType"`
+}
+
+type MarginOrder struct {
+	Order
+	MarginBuyBorrowAmount Decimal `json:"marginBuyBorrowAmount"`
+	MarginBuyBorrowAsset  string  `json:"marginBuyBorrowAsset"`
+	IsIsolated            bool    `json:"isIsolated"`
+}
+
+func (r *Parser) ParseMarginOrder(b []byte) (*MarginOrder, error) {
+	order, err := r.ParseOrder(b)
+	if err != nil {
+		return nil, err
+	}
+	result := &MarginOrder{Order: *order}
+
+	if v, err := GetDecimal(b, "marginBuyBorrowAmount"); err == nil {
+		result.MarginBuyBorrowAmount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "marginBuyBorrowAsset"); err == nil {
+		result.MarginBuyBorrowAsset = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "isIsolated"); err == nil {
+		result.IsIsolated = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// MarginCancelOrder
+
+type MarginCancelOrderParam struct {
+	CancelOrderParam
+	IsIsolated bool `json:"isIsolated" param:"isIsolated"`
+}
+
+// MarginGetOrder
+
+type MarginGetOrderParam struct {
+	GetOrderParam
+	IsIsolated bool `json:"isIsolated" param:"isIsolated"`
+}
+
+// MarginGetOpenOrders
+
+type MarginGetOpenOrdersParam struct {
+	GetOpenOrdersParam
+	IsIsolated bool `json:"isIsolated" param:"isIsolated"`
+}
+
+// MarginGetOrders
+
+type MarginGetOrdersParam struct {
+	GetOrdersParam
+	IsIsolated bool `json:"isIsolated" param:"isIsolated"`
+}
+
+// MarginNewOcoOrder
+
+type MarginOcoOrderParam struct {
+	NewOcoOrderParam
+	IsIsolated bool `json:"isIsolated" param:"isIsolated"`
+	// SideEffectType (SideEffectType): By default, SideEffectTypeNoSideEffect
+	SideEffectType SideEffectType `json:"sideEffectType" param:"sideEffectType"`
+}
+
+type MarginOcoOrder struct {
+	OcoOrder
+	MarginBuyBorrowAmount Decimal `json:"marginBuyBorrowAmount"`
+	MarginBuyBorrowAsset  string  `json:"marginBuyBorrowAsset"`
+	IsIsolated            bool    `json:"isIsolated"`
+}
+
+func (r *Parser) ParseMarginOcoOrder(b []byte) (*MarginOcoOrder, error) {
+	order, err := r.ParseNewOcoOrder(b)
+	if err != nil {
+		return nil, err
+	}
+	result := &MarginOcoOrder{OcoOrder: *order}
+
+	if v, err := GetDecimal(b, "marginBuyBorrowAmount"); err == nil {
+		result.MarginBuyBorrowAmount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "marginBuyBorrowAsset"); err == nil {
+		result.MarginBuyBorrowAsset = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "isIsolated"); err == nil {
+		result.IsIsolated = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// MarginCancelOcoOrder
+
+type MarginCancelOcoOrderParam struct {
+	CancelOcoOrderParam
+	IsIsolated bool `json:"isIsolated" param:"isIsolated"`
+}
+
+// MarginGetOcoOrder
+
+type MarginGetOcoOrderParam struct {
+	GetOcoOrderParam
+	IsIsolated bool `json:"isIsolated" param:"isIsolated"`
+	// Symbol (string): required when IsIsolated is true
+	Symbol string `json:"symbol" param:"symbol" validate:"required_if=IsIsolated true"`
+}
+
+// MarginMaxBorrowable
+
+type MarginMaxBorrowableParam struct {
+	Asset          string `json:"asset" param:"asset" validate:"required"`
+	IsolatedSymbol string `json:"isolatedSymbol" param:"isolatedSymbol"`
+	RecvWindow     int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type MarginMaxBorrowable struct {
+	Amount      Decimal `json:"amount"`
+	BorrowLimit Decimal `json:"borrowLimit"`
+}
+
+func (r *Parser) ParseMarginMaxBorrowable(b []byte) (*MarginMaxBorrowable, error) {
+	result := new(MarginMaxBorrowable)
+
+	if v, err := GetDecimal(b, "amount"); err == nil {
+		result.Amount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "borrowLimit"); err == nil {
+		result.BorrowLimit = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// MarginMaxTransferable
+
+type MarginMaxTransferableParam struct {
+	Asset          string `json:"asset" param:"asset" validate:"required"`
+	IsolatedSymbol string `json:"isolatedSymbol" param:"isolatedSymbol"`
+	RecvWindow     int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type MarginMaxTransferable struct {
+	Amount Decimal `json:"amount"`
+}
+
+func (r *Parser) ParseMarginMaxTransferable(b []byte) (*MarginMaxTransferable, error) {
+	result := new(MarginMaxTransferable)
+
+	if v, err := GetDecimal(b, "amount"); err == nil {
+		result.Amount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// MarginInterestHistory
+
+type MarginInterestHistoryParam struct {
+	Asset          string    `json:"asset" param:"asset"`
+	IsolatedSymbol string    `json:"isolatedSymbol" param:"isolatedSymbol"`
+	StartTime      time.Time `json:"startTime" param:"startTime"`
+	EndTime        time.Time `json:"endTime" param:"endTime"`
+	// Current (int64): page, start from 1. By default, 1
+	Current int64 `json:"current" param:"current"`
+	// Size (int64): By default, 10
+	Size int64 `json:"size" param:"size" validate:"max=100"`
+	// Archived (bool): true for records before 6 months ago
+	Archived   bool  `json:"archived" param:"archived"`
+	RecvWindow int64 `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type MarginInterestHistory struct {
+	Total int64             `json:"total"`
+	Rows  []*MarginInterest `json:"rows"`
+}
+
+type MarginInterest struct {
+	TxId                int64     `json:"txId"`
+	InterestAccuredTime time.Time `json:"interestAccuredTime"`
+	Asset               string    `json:"asset"`
+	RawAsset            string    `json:"rawAsset"`
+	Principal           Decimal   `json:"principal"`
+	Interest            Decimal   `json:"interest"`
+	InterestRate        Decimal   `json:"interestRate"`
+	Type                string    `json:"type"`
+	IsolatedSymbol      string    `json:"isolatedSymbol"`
+}
+
+func (r *Parser) ParseMarginInterestHistory(b []byte) (*MarginInterestHistory, error) {
+	result := new(MarginInterestHistory)
+
+	if v, err := jsonparser.GetInt(b, "total"); err == nil {
+		result.Total = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	result.Rows = make([]*MarginInterest, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseMarginInterest(value); err == nil {
+			result.Rows = append(result.Rows, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	}, "rows"); err != nil {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseMarginInterest(b []byte) (*MarginInterest, error) {
+	result := new(MarginInterest)
+
+	if v, err := jsonparser.GetInt(b, "txId"); err == nil {
+		result.TxId = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "interestAccuredTime"); err == nil {
+		result.InterestAccuredTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "asset"); err == nil {
+		result.Asset = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "rawAsset"); err == nil {
+		result.RawAsset = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "principal"); err == nil {
+		result.Principal = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "interest"); err == nil {
+		result.Interest = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "interestRate"); err == nil {
+		result.InterestRate = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "type"); err == nil {
+		result.Type = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "isolatedSymbol"); err == nil {
+		result.IsolatedSymbol = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// IsolatedMarginPair
+
+type IsolatedMarginPairParam struct {
+	Symbol     string `json:"symbol" param:"symbol" validate:"required"`
+	RecvWindow int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type IsolatedMarginAllPairsParam struct {
+	RecvWindow int64 `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type IsolatedMarginPair struct {
+	Symbol        string `json:"symbol"`
+	Base          string `json:"base"`
+	Quote         string `json:"quote"`
+	IsMarginTrade bool   `json:"isMarginTrade"`
+	IsBuyAllowed  bool   `json:"isBuyAllowed"`
+	IsSellAllowed bool   `json:"isSellAllowed"`
+}
+
+func (r *Parser) ParseIsolatedMarginPair(b []byte) (*IsolatedMarginPair, error) {
+	result := new(IsolatedMarginPair)
+
+	if v, err := jsonparser.GetString(b, "symbol"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "base"); err == nil {
+		result.Base = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "quote"); err == nil {
+		result.Quote = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "isMarginTrade"); err == nil {
+		result.IsMarginTrade = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "isBuyAllowed"); err == nil {
+		result.IsBuyAllowed = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "isSellAllowed"); err == nil {
+		result.IsSellAllowed = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) ParseIsolatedMarginAllPairs(b []byte) ([]*IsolatedMarginPair, error) {
+	results := make([]*IsolatedMarginPair, 0)
+
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.ParseIsolatedMarginPair(value); err == nil {
+			results = append(results, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	})
+	if err = r.errorParser(err); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// IsolatedMarginAccount
+
+type IsolatedMarginAccountParam struct {
+	// Symbols ([]string): max 5 symbols, By default, every isolated symbol
+	Symbols    []string `json:"symbols" param:"symbols,comma" validate:"max=5"`
+	RecvWindow int64    `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type IsolatedMarginAccount struct {
+	TotalAssetOfBtc     Decimal                 `json:"totalAssetOfBtc"`
+	TotalLiabilityOfBtc Decimal                 `json:"totalLiabilityOfBtc"`
+	TotalNetAssetOfBtc  Decimal                 `json:"totalNetAssetOfBtc"`
+	Assets              []*IsolatedMarginSymbol `json:"assets"`
+}
+
+type IsolatedMarginSymbol struct {
+	Symbol            string               `json:"symbol"`
+	BaseAsset         *IsolatedMarginAsset `json:"baseAsset"`
+	QuoteAsset        *IsolatedMarginAsset `json:"quoteAsset"`
+	IsolatedCreated   bool                 `json:"isolatedCreated"`
+	Enabled           bool                 `json:"enabled"`
+	TradeEnabled      bool                 `json:"tradeEnabled"`
+	MarginLevel       Decimal              `json:"marginLevel"`
+	MarginLevelStatus string               `json:"marginLevelStatus"`
+	MarginRatio       Decimal              `json:"marginRatio"`
+	IndexPrice        Decimal              `json:"indexPrice"`
+	LiquidatePrice    Decimal              `json:"liquidatePrice"`
+	LiquidateRate     Decimal              `json:"liquidateRate"`
+}
+
+type IsolatedMarginAsset struct {
+	Asset         string  `json:"asset"`
+	BorrowEnabled bool    `json:"borrowEnabled"`
+	RepayEnabled  bool    `json:"repayEnabled"`
+	Free          Decimal `json:"free"`
+	Locked        Decimal `json:"locked"`
+	Borrowed      Decimal `json:"borrowed"`
+	Interest      Decimal `json:"interest"`
+	NetAsset      Decimal `json:"netAsset"`
+	NetAssetOfBtc Decimal `json:"netAssetOfBtc"`
+	TotalAsset    Decimal `json:"totalAsset"`
+}
+
+func (r *Parser) ParseIsolatedMarginAccount(b []byte) (*IsolatedMarginAccount, error) {
+	result := new(IsolatedMarginAccount)
+
+	if v, err := GetDecimal(b, "totalAssetOfBtc"); err == nil {
+		result.TotalAssetOfBtc = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "totalLiabilityOfBtc"); err == nil {
+		result.TotalLiabilityOfBtc = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "totalNetAssetOfBtc"); err == nil {
+		result.TotalNetAssetOfBtc = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	result.Assets = make([]*IsolatedMarginSymbol, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseIsolatedMarginSymbol(value); err == nil {
+			result.Assets = append(result.Assets, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	}, "assets"); err != nil {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseIsolatedMarginSymbol(b []byte) (*IsolatedMarginSymbol, error) {
+	result := new(IsolatedMarginSymbol)
+
+	if v, err := jsonparser.GetString(b, "symbol"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "isolatedCreated"); err == nil {
+		result.IsolatedCreated = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "enabled"); err == nil {
+		result.Enabled = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "tradeEnabled"); err == nil {
+		result.TradeEnabled = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "marginLevel"); err == nil {
+		result.MarginLevel = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "marginLevelStatus"); err == nil {
+		result.MarginLevelStatus = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "marginRatio"); err == nil {
+		result.MarginRatio = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "indexPrice"); err == nil {
+		result.IndexPrice = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "liquidatePrice"); err == nil {
+		result.LiquidatePrice = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "liquidateRate"); err == nil {
+		result.LiquidateRate = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, _, _, err := jsonparser.Get(b, "baseAsset"); err == nil {
+		if result.BaseAsset, err = r.parseIsolatedMarginAsset(v); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, _, _, err := jsonparser.Get(b, "quoteAsset"); err == nil {
+		if result.QuoteAsset, err = r.parseIsolatedMarginAsset(v); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseIsolatedMarginAsset(b []byte) (*IsolatedMarginAsset, error) {
+	result := new(IsolatedMarginAsset)
+
+	if v, err := jsonparser.GetString(b, "asset"); err == nil {
+		result.Asset = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "borrowEnabled"); err == nil {
+		result.BorrowEnabled = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "repayEnabled"); err == nil {
+		result.RepayEnabled = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "free"); err == nil {
+		result.Free = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "locked"); err == nil {
+		result.Locked = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "borrowed"); err == nil {
+		result.Borrowed = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "interest"); err == nil {
+		result.Interest = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "netAsset"); err == nil {
+		result.NetAsset = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "netAssetOfBtc"); err == nil {
+		result.NetAssetOfBtc = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "totalAsset"); err == nil {
+		result.TotalAsset = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
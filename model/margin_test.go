@@ -0,0 +1,123 @@
+package model
+
+import "testing"
+
+// MarginAccount
+func TestParser_ParseMarginAccount(t *testing.T) {
+	bytes := []byte(`{"borrowEnabled":true,"marginLevel":"11.64405625","totalAssetOfBtc":"6.82728457","totalLiabilityOfBtc":"0.58633215","totalNetAssetOfBtc":"6.24095242","tradeEnabled":true,"transferEnabled":true,"userAssets":[{"asset":"BTC","borrowed":"0.00000000","free":"0.00499500","interest":"0.00000000","locked":"0.00000000","netAsset":"0.00499500"},{"asset":"BNB","borrowed":"201.66666672","free":"2346.50000000","interest":"0.00000000","locked":"0.00000000","netAsset":"2144.83333328"}]}`)
+
+	parser := NewParser()
+	result, err := parser.ParseMarginAccount(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.UserAssets) != 2 || result.UserAssets[1].Borrowed.String() != "201.66666672" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+// MarginBorrow, MarginRepay
+func TestParser_ParseMarginTransaction(t *testing.T) {
+	bytes := []byte(`{"tranId":100000001}`)
+
+	parser := NewParser()
+	result, err := parser.ParseMarginTransaction(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.TranId != 100000001 {
+		t.Errorf("tranId = %d", result.TranId)
+	}
+}
+
+// MarginNewOrder
+func TestParser_ParseMarginOrder(t *testing.T) {
+	bytes := []byte(`{"symbol":"BTCUSDT","orderId":28,"clientOrderId":"6gCrw2kRUAF9CvJDGP16IP","transactTime":1507725176595,"price":"1.00000000","origQty":"10.00000000","executedQty":"10.00000000","cummulativeQuoteQty":"10.00000000","status":"FILLED","timeInForce":"GTC","type":"MARKET","side":"SELL","marginBuyBorrowAmount":"5","marginBuyBorrowAsset":"BTC","isIsolated":true,"fills":[{"price":"4000.00000000","qty":"1.00000000","commission":"4.00000000","commissionAsset":"USDT"}]}`)
+
+	parser := NewParser()
+	result, err := parser.ParseMarginOrder(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.OrderId != 28 || len(result.Fills) != 1 || !result.IsIsolated || result.MarginBuyBorrowAsset != "BTC" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+// MarginNewOcoOrder
+func TestParser_ParseMarginOcoOrder(t *testing.T) {
+	bytes := []byte(`{"orderListId":0,"contingencyType":"OCO","listStatusType":"EXEC_STARTED","listOrderStatus":"EXECUTING","listClientOrderId":"JYVpp3F0f5CAG15DhtrqLp","transactionTime":1563417480525,"symbol":"LTCBTC","marginBuyBorrowAmount":"5","marginBuyBorrowAsset":"BTC","isIsolated":false,"orders":[{"symbol":"LTCBTC","orderId":2,"clientOrderId":"Kk7sqHb9J6mJWTMDVW7Vos"},{"symbol":"LTCBTC","orderId":3,"clientOrderId":"xTXKaGYd4bluPVp78IVRvl"}],"orderReports":[{"symbol":"LTCBTC","orderId":2,"orderListId":0,"clientOrderId":"Kk7sqHb9J6mJWTMDVW7Vos","transactTime":1563417480525,"price":"0.000000","origQty":"0.624363","executedQty":"0.000000","cummulativeQuoteQty":"0.000000","status":"NEW","timeInForce":"GTC","type":"STOP_LOSS","side":"BUY","stopPrice":"0.960664"},{"symbol":"LTCBTC","orderId":3,"orderListId":0,"clientOrderId":"xTXKaGYd4bluPVp78IVRvl","transactTime":1563417480525,"price":"0.036435","origQty":"0.624363","executedQty":"0.000000","cummulativeQuoteQty":"0.000000","status":"NEW","timeInForce":"GTC","type":"LIMIT_MAKER","side":"BUY"}]}`)
+
+	parser := NewParser()
+	result, err := parser.ParseMarginOcoOrder(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Orders) != 2 || len(result.OrderReports) != 2 || result.MarginBuyBorrowAmount.String() != "5" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+// MarginMaxBorrowable
+func TestParser_ParseMarginMaxBorrowable(t *testing.T) {
+	bytes := []byte(`{"amount":"1.69248805","borrowLimit":"60"}`)
+
+	parser := NewParser()
+	_, err := parser.ParseMarginMaxBorrowable(bytes)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+// MarginMaxTransferable
+func TestParser_ParseMarginMaxTransferable(t *testing.T) {
+	bytes := []byte(`{"amount":"3.59498107"}`)
+
+	parser := NewParser()
+	_, err := parser.ParseMarginMaxTransferable(bytes)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+// MarginInterestHistory
+func TestParser_ParseMarginInterestHistory(t *testing.T) {
+	bytes := []byte(`{"rows":[{"txId":1352286576452864727,"interestAccuredTime":1672160400000,"asset":"USDT","rawAsset":"USDT","principal":"45.3313","interest":"0.00024995","interestRate":"0.00013233","type":"ON_BORROW","isolatedSymbol":"BNBUSDT"}],"total":1}`)
+
+	parser := NewParser()
+	result, err := parser.ParseMarginInterestHistory(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Total != 1 || len(result.Rows) != 1 || result.Rows[0].IsolatedSymbol != "BNBUSDT" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+// IsolatedMarginAllPairs
+func TestParser_ParseIsolatedMarginAllPairs(t *testing.T) {
+	bytes := []byte(`[{"base":"BNB","isBuyAllowed":true,"isMarginTrade":true,"isSellAllowed":true,"quote":"BTC","symbol":"BNBBTC"},{"base":"TRX","isBuyAllowed":true,"isMarginTrade":true,"isSellAllowed":true,"quote":"BTC","symbol":"TRXBTC"}]`)
+
+	parser := NewParser()
+	results, err := parser.ParseIsolatedMarginAllPairs(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[1].Base != "TRX" {
+		t.Errorf("results = %+v", results)
+	}
+}
+
+// IsolatedMarginAccount
+func TestParser_ParseIsolatedMarginAccount(t *testing.T) {
+	bytes := []byte(`{"assets":[{"baseAsset":{"asset":"BTC","borrowEnabled":true,"borrowed":"0.00000000","free":"0.00000000","interest":"0.00000000","locked":"0.00000000","netAsset":"0.00000000","netAssetOfBtc":"0.00000000","repayEnabled":true,"totalAsset":"0.00000000"},"quoteAsset":{"asset":"USDT","borrowEnabled":true,"borrowed":"0.00000000","free":"0.00000000","interest":"0.00000000","locked":"0.00000000","netAsset":"0.00000000","netAssetOfBtc":"0.00000000","repayEnabled":true,"totalAsset":"0.00000000"},"symbol":"BTCUSDT","isolatedCreated":true,"enabled":true,"marginLevel":"0.00000000","marginLevelStatus":"EXCESSIVE","marginRatio":"0.00000000","indexPrice":"10000.00000000","liquidatePrice":"1000.00000000","liquidateRate":"1.00000000","tradeEnabled":true}],"totalAssetOfBtc":"0.00000000","totalLiabilityOfBtc":"0.00000000","totalNetAssetOfBtc":"0.00000000"}`)
+
+	parser := NewParser()
+	result, err := parser.ParseIsolatedMarginAccount(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Assets) != 1 || result.Assets[0].QuoteAsset.Asset != "USDT" || result.Assets[0].IndexPrice.String() != "10000.00000000" {
+		t.Errorf("result = %+v", result)
+	}
+}
@@ -10,55 +10,208 @@ import (
 
 type ExchangeInformationParam struct {
 	Symbol  string   `json:"symbol" param:"symbol"`
-	Symbols []string `json:"symbols" param:"symbols"`
+	Symbols []string `json:"symbols" param:"symbols,json"`
 }
 
 type ExchangeInformation struct {
-	Timezone   string `json:"timezone"`
-	ServerTime int64  `json:"serverTime"`
-	RateLimits []struct {
-		RateLimitType string `json:"rateLimitType"`
-		Interval      string `json:"interval"`
-		IntervalNum   int64  `json:"intervalNum"`
-		Limit         int64  `json:"limit"`
-	} `json:"rateLimits"`
-	ExchangeFilters []struct{} `json:"exchangeFilters"`
-	Symbols         []struct {
-		Symbol                     string   `json:"symbol"`
-		Status                     string   `json:"status"`
-		BaseAsset                  string   `json:"baseAsset"`
-		BaseAssetPrecision         int64    `json:"baseAssetPrecision"`
-		QuoteAsset                 string   `json:"quoteAsset"`
-		QuotePrecision             int64    `json:"quotePrecision"`
-		QuoteAssetPrecision        int64    `json:"quoteAssetPrecision"`
-		BaseCommissionPrecision    int64    `json:"baseCommissionPrecision"`
-		QuoteCommissionPrecision   int64    `json:"quoteCommissionPrecision"`
-		OrderTypes                 []string `json:"orderTypes"`
-		IcebergAllowed             bool     `json:"icebergAllowed"`
-		OcoAllowed                 bool     `json:"ocoAllowed"`
-		QuoteOrderQtyMarketAllowed bool     `json:"quoteOrderQtyMarketAllowed"`
-		AllowTrailingStop          bool     `json:"allowTrailingStop"`
-		IsSpotTradingAllowed       bool     `json:"isSpotTradingAllowed"`
-		IsMarginTradingAllowed     bool     `json:"isMarginTradingAllowed"`
-		Filters                    []struct {
-			FilterType       string `json:"filterType"`
-			MinPrice         string `json:"minPrice,omitempty"`
-			MaxPrice         string `json:"maxPrice,omitempty"`
-			TickSize         string `json:"tickSize,omitempty"`
-			MultiplierUp     string `json:"multiplierUp,omitempty"`
-			MultiplierDown   string `json:"multiplierDown,omitempty"`
-			AvgPriceMins     int64  `json:"avgPriceMins,omitempty"`
-			MinQty           string `json:"minQty,omitempty"`
-			MaxQty           string `json:"maxQty,omitempty"`
-			StepSize         string `json:"stepSize,omitempty"`
-			MinNotional      string `json:"minNotional,omitempty"`
-			ApplyToMarket    bool   `json:"applyToMarket,omitempty"`
-			Limit            int64  `json:"limit,omitempty"`
-			MaxNumOrders     int64  `json:"maxNumOrders,omitempty"`
-			MaxNumAlgoOrders int    `json:"maxNumAlgoOrders,omitempty"`
-		} `json:"filters"`
-		Permissions []string `json:"permissions"`
-	} `json:"symbols"`
+	Timezone        string        `json:"timezone"`
+	ServerTime      int64         `json:"serverTime"`
+	RateLimits      []*RateLimit  `json:"rateLimits"`
+	ExchangeFilters []struct{}    `json:"exchangeFilters"`
+	Symbols         []*SymbolInfo `json:"symbols"`
+}
+
+type RateLimit struct {
+	RateLimitType RateLimiter       `json:"rateLimitType"`
+	Interval      RateLimitInterval `json:"interval"`
+	IntervalNum   int64             `json:"intervalNum"`
+	Limit         int64             `json:"limit"`
+}
+
+// SymbolInfo
+// find symbol information by name, return false when the symbol is not listed
+func (e *ExchangeInformation) SymbolInfo(symbol string) (*SymbolInfo, bool) {
+	for _, info := range e.Symbols {
+		if info.Symbol == symbol {
+			return info, true
+		}
+	}
+	return nil, false
+}
+
+type SymbolInfo struct {
+	Symbol                     string          `json:"symbol"`
+	Status                     SymbolStatus    `json:"status"`
+	BaseAsset                  string          `json:"baseAsset"`
+	BaseAssetPrecision         int64           `json:"baseAssetPrecision"`
+	QuoteAsset                 string          `json:"quoteAsset"`
+	QuotePrecision             int64           `json:"quotePrecision"`
+	QuoteAssetPrecision        int64           `json:"quoteAssetPrecision"`
+	BaseCommissionPrecision    int64           `json:"baseCommissionPrecision"`
+	QuoteCommissionPrecision   int64           `json:"quoteCommissionPrecision"`
+	OrderTypes                 []OrderType     `json:"orderTypes"`
+	IcebergAllowed             bool            `json:"icebergAllowed"`
+	OcoAllowed                 bool            `json:"ocoAllowed"`
+	QuoteOrderQtyMarketAllowed bool            `json:"quoteOrderQtyMarketAllowed"`
+	AllowTrailingStop          bool            `json:"allowTrailingStop"`
+	IsSpotTradingAllowed       bool            `json:"isSpotTradingAllowed"`
+	IsMarginTradingAllowed     bool            `json:"isMarginTradingAllowed"`
+	Filters                    []*SymbolFilter `json:"filters"`
+	Permissions                []string        `json:"permissions"`
+}
+
+// SymbolFilter
+// raw filter as returned by server, only the fields of its FilterType are set
+// https://binance-docs.github.io/apidocs/spot/en/#filters
+type SymbolFilter struct {
+	FilterType       SymbolFilterType `json:"filterType"`
+	MinPrice         Decimal          `json:"minPrice,omitempty"`
+	MaxPrice         Decimal          `json:"maxPrice,omitempty"`
+	TickSize         Decimal          `json:"tickSize,omitempty"`
+	MultiplierUp     Decimal          `json:"multiplierUp,omitempty"`
+	MultiplierDown   Decimal          `json:"multiplierDown,omitempty"`
+	AvgPriceMins     int64            `json:"avgPriceMins,omitempty"`
+	MinQty           Decimal          `json:"minQty,omitempty"`
+	MaxQty           Decimal          `json:"maxQty,omitempty"`
+	StepSize         Decimal          `json:"stepSize,omitempty"`
+	MinNotional      Decimal          `json:"minNotional,omitempty"`
+	ApplyToMarket    bool             `json:"applyToMarket,omitempty"`
+	Limit            int64            `json:"limit,omitempty"`
+	MaxNumOrders     int64            `json:"maxNumOrders,omitempty"`
+	MaxNumAlgoOrders int64            `json:"maxNumAlgoOrders,omitempty"`
+}
+
+type PriceFilter struct {
+	MinPrice Decimal `json:"minPrice"`
+	MaxPrice Decimal `json:"maxPrice"`
+	TickSize Decimal `json:"tickSize"`
+}
+
+type PercentPriceFilter struct {
+	MultiplierUp   Decimal `json:"multiplierUp"`
+	MultiplierDown Decimal `json:"multiplierDown"`
+	AvgPriceMins   int64   `json:"avgPriceMins"`
+}
+
+type LotSizeFilter struct {
+	MinQty   Decimal `json:"minQty"`
+	MaxQty   Decimal `json:"maxQty"`
+	StepSize Decimal `json:"stepSize"`
+}
+
+type MinNotionalFilter struct {
+	MinNotional   Decimal `json:"minNotional"`
+	ApplyToMarket bool    `json:"applyToMarket"`
+	AvgPriceMins  int64   `json:"avgPriceMins"`
+}
+
+type IcebergPartsFilter struct {
+	Limit int64 `json:"limit"`
+}
+
+type MaxNumOrdersFilter struct {
+	MaxNumOrders int64 `json:"maxNumOrders"`
+}
+
+type MaxNumAlgoOrdersFilter struct {
+	MaxNumAlgoOrders int64 `json:"maxNumAlgoOrders"`
+}
+
+// Filter
+// find raw filter by type
+func (s *SymbolInfo) Filter(filterType SymbolFilterType) (*SymbolFilter, bool) {
+	for _, filter := range s.Filters {
+		if filter.FilterType == filterType {
+			return filter, true
+		}
+	}
+	return nil, false
+}
+
+func (s *SymbolInfo) PriceFilter() (*PriceFilter, bool) {
+	if f, ok := s.Filter(SymbolFilterTypePrice); ok {
+		return &PriceFilter{
+			MinPrice: f.MinPrice,
+			MaxPrice: f.MaxPrice,
+			TickSize: f.TickSize,
+		}, true
+	}
+	return nil, false
+}
+
+func (s *SymbolInfo) PercentPriceFilter() (*PercentPriceFilter, bool) {
+	if f, ok := s.Filter(SymbolFilterTypePercentPrice); ok {
+		return &PercentPriceFilter{
+			MultiplierUp:   f.MultiplierUp,
+			MultiplierDown: f.MultiplierDown,
+			AvgPriceMins:   f.AvgPriceMins,
+		}, true
+	}
+	return nil, false
+}
+
+func (s *SymbolInfo) LotSizeFilter() (*LotSizeFilter, bool) {
+	return s.lotSizeFilter(SymbolFilterTypeLotSize)
+}
+
+// MarketLotSizeFilter
+// LOT_SIZE rules applied to MARKET orders
+func (s *SymbolInfo) MarketLotSizeFilter() (*LotSizeFilter, bool) {
+	return s.lotSizeFilter(SymbolFilterTypeMarketLotSize)
+}
+
+func (s *SymbolInfo) lotSizeFilter(filterType SymbolFilterType) (*LotSizeFilter, bool) {
+	if f, ok := s.Filter(filterType); ok {
+		return &LotSizeFilter{
+			MinQty:   f.MinQty,
+			MaxQty:   f.MaxQty,
+			StepSize: f.StepSize,
+		}, true
+	}
+	return nil, false
+}
+
+func (s *SymbolInfo) MinNotionalFilter() (*MinNotionalFilter, bool) {
+	if f, ok := s.Filter(SymbolFilterTypeMinNotional); ok {
+		return &MinNotionalFilter{
+			MinNotional:   f.MinNotional,
+			ApplyToMarket: f.ApplyToMarket,
+			AvgPriceMins:  f.AvgPriceMins,
+		}, true
+	}
+	return nil, false
+}
+
+func (s *SymbolInfo) IcebergPartsFilter() (*IcebergPartsFilter, bool) {
+	if f, ok := s.Filter(SymbolFilterTypeIcebergParts); ok {
+		return &IcebergPartsFilter{Limit: f.Limit}, true
+	}
+	return nil, false
+}
+
+func (s *SymbolInfo) MaxNumOrdersFilter() (*MaxNumOrdersFilter, bool) {
+	if f, ok := s.Filter(SymbolFilterTypeMaxNumOrders); ok {
+		return &MaxNumOrdersFilter{MaxNumOrders: f.MaxNumOrders}, true
+	}
+	return nil, false
+}
+
+func (s *SymbolInfo) MaxNumAlgoOrdersFilter() (*MaxNumAlgoOrdersFilter, bool) {
+	if f, ok := s.Filter(SymbolFilterTypeMaxNumAlgoOrders); ok {
+		return &MaxNumAlgoOrdersFilter{MaxNumAlgoOrders: f.MaxNumAlgoOrders}, true
+	}
+	return nil, false
+}
+
+// HasOrderType
+// check whether the symbol accepts the order type
+func (s *SymbolInfo) HasOrderType(orderType OrderType) bool {
+	for _, v := range s.OrderTypes {
+		if v == orderType {
+			return true
+		}
+	}
+	return false
 }
 
 // OrderBook
@@ -75,8 +228,8 @@ type OrderBook struct {
 }
 
 type OrderBookPrice struct {
-	Price string `json:"price"`
-	Qty   string `json:"qty"`
+	Price Decimal `json:"price"`
+	Qty   Decimal `json:"qty"`
 }
 
 func (r *Parser) ParseOrderBook(b []byte) (*OrderBook, error) {
@@ -96,14 +249,14 @@ func (r *Parser) ParseOrderBook(b []byte) (*OrderBook, error) {
 	parseOrderBook := func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
 		orderBook := new(OrderBookPrice)
 
-		if v, err := jsonparser.GetString(value, "[0]"); err == nil {
+		if v, err := GetDecimal(value, "[0]"); err == nil {
 			orderBook.Price = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "[1]"); err == nil {
+		if v, err := GetDecimal(value, "[1]"); err == nil {
 			orderBook.Qty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
@@ -148,9 +301,9 @@ type RecentTradeParam struct {
 
 type RecentTrade struct {
 	Id           int64     `json:"id"`
-	Price        string    `json:"price"`
-	Qty          string    `json:"qty"`
-	QuoteQty     string    `json:"quoteQty"`
+	Price        Decimal   `json:"price"`
+	Qty          Decimal   `json:"qty"`
+	QuoteQty     Decimal   `json:"quoteQty"`
 	Time         time.Time `json:"time"`
 	IsBuyerMaker bool      `json:"isBuyerMaker"`
 	IsBestMatch  bool      `json:"isBestMatch"`
@@ -169,21 +322,21 @@ func (r *Parser) ParseRecentTrade(b []byte) ([]*RecentTrade, error) {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "price"); err == nil {
+		if v, err := GetDecimal(value, "price"); err == nil {
 			item.Price = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "qty"); err == nil {
+		if v, err := GetDecimal(value, "qty"); err == nil {
 			item.Qty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "quoteQty"); err == nil {
+		if v, err := GetDecimal(value, "quoteQty"); err == nil {
 			item.QuoteQty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
@@ -231,9 +384,9 @@ type OldTradeLookupParam struct {
 
 type OldTradeLookup struct {
 	Id           int64     `json:"id"`
-	Price        string    `json:"price"`
-	Qty          string    `json:"qty"`
-	QuoteQty     string    `json:"quoteQty"`
+	Price        Decimal   `json:"price"`
+	Qty          Decimal   `json:"qty"`
+	QuoteQty     Decimal   `json:"quoteQty"`
 	Time         time.Time `json:"time"`
 	IsBuyerMaker bool      `json:"isBuyerMaker"`
 	IsBestMatch  bool      `json:"isBestMatch"`
@@ -252,21 +405,21 @@ func (r *Parser) ParseOldTradeLookup(b []byte) ([]*OldTradeLookup, error) {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "price"); err == nil {
+		if v, err := GetDecimal(value, "price"); err == nil {
 			item.Price = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "qty"); err == nil {
+		if v, err := GetDecimal(value, "qty"); err == nil {
 			item.Qty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "quoteQty"); err == nil {
+		if v, err := GetDecimal(value, "quoteQty"); err == nil {
 			item.QuoteQty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
@@ -316,8 +469,8 @@ type AggregateTradeParam struct {
 
 type AggregateTrade struct {
 	TradeId      int64     `json:"a"`
-	Price        string    `json:"p"`
-	Quantity     string    `json:"q"`
+	Price        Decimal   `json:"p"`
+	Quantity     Decimal   `json:"q"`
 	FirstTradeId int64     `json:"f"`
 	LastTradeId  int64     `json:"l"`
 	Timestamp    time.Time `json:"T"`
@@ -338,14 +491,14 @@ func (r *Parser) ParseAggregateTrade(b []byte) ([]*AggregateTrade, error) {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(b, "p"); err == nil {
+		if v, err := GetDecimal(b, "p"); err == nil {
 			item.Price = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(b, "q"); err == nil {
+		if v, err := GetDecimal(b, "q"); err == nil {
 			item.Quantity = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
@@ -409,16 +562,16 @@ type KlineParam struct {
 
 type Kline struct {
 	OpenTime                 time.Time `json:"openTime"`
-	Open                     string    `json:"open"`
-	High                     string    `json:"high"`
-	Low                      string    `json:"low"`
-	Close                    string    `json:"close"`
-	Volume                   string    `json:"volume"`
+	Open                     Decimal   `json:"open"`
+	High                     Decimal   `json:"high"`
+	Low                      Decimal   `json:"low"`
+	Close                    Decimal   `json:"close"`
+	Volume                   Decimal   `json:"volume"`
 	CloseTime                time.Time `json:"closeTime"`
-	QuoteAssetVolume         string    `json:"quoteAssetVolume"`
+	QuoteAssetVolume         Decimal   `json:"quoteAssetVolume"`
 	NumberOfTrades           int64     `json:"numberOfTrades"`
-	TakerBuyBaseAssetVolume  string    `json:"takerBuyBaseAssetVolume"`
-	TakerBuyQuoteAssetVolume string    `json:"takerBuyQuoteAssetVolume"`
+	TakerBuyBaseAssetVolume  Decimal   `json:"takerBuyBaseAssetVolume"`
+	TakerBuyQuoteAssetVolume Decimal   `json:"takerBuyQuoteAssetVolume"`
 }
 
 func (r *Parser) ParseKline(b []byte) ([]*Kline, error) {
@@ -434,35 +587,35 @@ func (r *Parser) ParseKline(b []byte) ([]*Kline, error) {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "[1]"); err == nil {
+		if v, err := GetDecimal(value, "[1]"); err == nil {
 			item.Open = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "[2]"); err == nil {
+		if v, err := GetDecimal(value, "[2]"); err == nil {
 			item.High = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "[3]"); err == nil {
+		if v, err := GetDecimal(value, "[3]"); err == nil {
 			item.Low = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "[4]"); err == nil {
+		if v, err := GetDecimal(value, "[4]"); err == nil {
 			item.Close = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "[5]"); err == nil {
+		if v, err := GetDecimal(value, "[5]"); err == nil {
 			item.Volume = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
@@ -476,7 +629,7 @@ func (r *Parser) ParseKline(b []byte) ([]*Kline, error) {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "[7]"); err == nil {
+		if v, err := GetDecimal(value, "[7]"); err == nil {
 			item.QuoteAssetVolume = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
@@ -490,14 +643,14 @@ func (r *Parser) ParseKline(b []byte) ([]*Kline, error) {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "[9]"); err == nil {
+		if v, err := GetDecimal(value, "[9]"); err == nil {
 			item.TakerBuyBaseAssetVolume = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "[10]"); err == nil {
+		if v, err := GetDecimal(value, "[10]"); err == nil {
 			item.TakerBuyQuoteAssetVolume = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
@@ -521,8 +674,8 @@ type AveragePriceParam struct {
 }
 
 type AveragePrice struct {
-	Mins  int64  `json:"mins"`
-	Price string `json:"price"`
+	Mins  int64   `json:"mins"`
+	Price Decimal `json:"price"`
 }
 
 // Ticker24hr
@@ -533,21 +686,21 @@ type Ticker24hrParam struct {
 
 type Ticker24hr struct {
 	Symbol             string    `json:"symbol"`
-	PriceChange        string    `json:"priceChange"`
-	PriceChangePercent string    `json:"priceChangePercent"`
-	WeightedAvgPrice   string    `json:"weightedAvgPrice"`
-	PrevClosePrice     string    `json:"prevClosePrice"`
-	LastPrice          string    `json:"lastPrice"`
-	LastQty            string    `json:"lastQty"`
-	BidPrice           string    `json:"bidPrice"`
-	BidQty             string    `json:"bidQty"`
-	AskPrice           string    `json:"askPrice"`
-	AskQty             string    `json:"askQty"`
-	OpenPrice          string    `json:"openPrice"`
-	HighPrice          string    `json:"highPrice"`
-	LowPrice           string    `json:"lowPrice"`
-	Volume             string    `json:"volume"`
-	QuoteVolume        string    `json:"quoteVolume"`
+	PriceChange        Decimal   `json:"priceChange"`
+	PriceChangePercent Decimal   `json:"priceChangePercent"`
+	WeightedAvgPrice   Decimal   `json:"weightedAvgPrice"`
+	PrevClosePrice     Decimal   `json:"prevClosePrice"`
+	LastPrice          Decimal   `json:"lastPrice"`
+	LastQty            Decimal   `json:"lastQty"`
+	BidPrice           Decimal   `json:"bidPrice"`
+	BidQty             Decimal   `json:"bidQty"`
+	AskPrice           Decimal   `json:"askPrice"`
+	AskQty             Decimal   `json:"askQty"`
+	OpenPrice          Decimal   `json:"openPrice"`
+	HighPrice          Decimal   `json:"highPrice"`
+	LowPrice           Decimal   `json:"lowPrice"`
+	Volume             Decimal   `json:"volume"`
+	QuoteVolume        Decimal   `json:"quoteVolume"`
 	OpenTime           time.Time `json:"openTime"`
 	CloseTime          time.Time `json:"closeTime"`
 	FirstTradeId       int64     `json:"firstId"`
@@ -569,105 +722,105 @@ func (r *Parser) ParseTicker24hr(b []byte) ([]*Ticker24hr, error) {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "priceChange"); err == nil {
+		if v, err := GetDecimal(value, "priceChange"); err == nil {
 			item.PriceChange = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "priceChangePercent"); err == nil {
+		if v, err := GetDecimal(value, "priceChangePercent"); err == nil {
 			item.PriceChangePercent = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "weightedAvgPrice"); err == nil {
+		if v, err := GetDecimal(value, "weightedAvgPrice"); err == nil {
 			item.WeightedAvgPrice = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "prevClosePrice"); err == nil {
+		if v, err := GetDecimal(value, "prevClosePrice"); err == nil {
 			item.PrevClosePrice = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "lastPrice"); err == nil {
+		if v, err := GetDecimal(value, "lastPrice"); err == nil {
 			item.LastPrice = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "lastQty"); err == nil {
+		if v, err := GetDecimal(value, "lastQty"); err == nil {
 			item.LastQty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "bidPrice"); err == nil {
+		if v, err := GetDecimal(value, "bidPrice"); err == nil {
 			item.BidPrice = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "bidQty"); err == nil {
+		if v, err := GetDecimal(value, "bidQty"); err == nil {
 			item.BidQty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "askPrice"); err == nil {
+		if v, err := GetDecimal(value, "askPrice"); err == nil {
 			item.AskPrice = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "askQty"); err == nil {
+		if v, err := GetDecimal(value, "askQty"); err == nil {
 			item.AskQty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "openPrice"); err == nil {
+		if v, err := GetDecimal(value, "openPrice"); err == nil {
 			item.OpenPrice = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "highPrice"); err == nil {
+		if v, err := GetDecimal(value, "highPrice"); err == nil {
 			item.HighPrice = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "lowPrice"); err == nil {
+		if v, err := GetDecimal(value, "lowPrice"); err == nil {
 			item.LowPrice = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "volume"); err == nil {
+		if v, err := GetDecimal(value, "volume"); err == nil {
 			item.Volume = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "quoteVolume"); err == nil {
+		if v, err := GetDecimal(value, "quoteVolume"); err == nil {
 			item.QuoteVolume = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
@@ -726,8 +879,8 @@ type TickerPriceParam struct {
 }
 
 type TickerPrice struct {
-	Symbol string `json:"symbol"`
-	Price  string `json:"price"`
+	Symbol string  `json:"symbol"`
+	Price  Decimal `json:"price"`
 }
 
 func (r *Parser) ParseTickerPrice(b []byte) ([]*TickerPrice, error) {
@@ -744,7 +897,7 @@ func (r *Parser) ParseTickerPrice(b []byte) ([]*TickerPrice, error) {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "price"); err == nil {
+		if v, err := GetDecimal(value, "price"); err == nil {
 			item.Price = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
@@ -768,11 +921,11 @@ type BookTickerParam struct {
 }
 
 type BookTicker struct {
-	Symbol   string `json:"symbol"`
-	BidPrice string `json:"bidPrice"`
-	BidQty   string `json:"bidQty"`
-	AskPrice string `json:"askPrice"`
-	AskQty   string `json:"askQty"`
+	Symbol   string  `json:"symbol"`
+	BidPrice Decimal `json:"bidPrice"`
+	BidQty   Decimal `json:"bidQty"`
+	AskPrice Decimal `json:"askPrice"`
+	AskQty   Decimal `json:"askQty"`
 }
 
 func (r *Parser) ParseBookTicker(b []byte) ([]*BookTicker, error) {
@@ -789,28 +942,28 @@ func (r *Parser) ParseBookTicker(b []byte) ([]*BookTicker, error) {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "bidPrice"); err == nil {
+		if v, err := GetDecimal(value, "bidPrice"); err == nil {
 			item.BidPrice = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "bidQty"); err == nil {
+		if v, err := GetDecimal(value, "bidQty"); err == nil {
 			item.BidQty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "askPrice"); err == nil {
+		if v, err := GetDecimal(value, "askPrice"); err == nil {
 			item.AskPrice = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
 				return
 			}
 		}
-		if v, err := jsonparser.GetString(value, "askQty"); err == nil {
+		if v, err := GetDecimal(value, "askQty"); err == nil {
 			item.AskQty = v
 		} else {
 			if _err = r.errorParser(err); _err != nil {
@@ -1,6 +1,9 @@
 package model
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 // OrderBook
 func TestParser_ParseOrderBook(t *testing.T) {
@@ -119,3 +122,42 @@ func TestParser_ParseBookTicker_ArrayItem(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// ExchangeInformation
+func TestExchangeInformation_SymbolInfo(t *testing.T) {
+	bytes := []byte(`{"timezone":"UTC","serverTime":1565246363776,"rateLimits":[],"exchangeFilters":[],"symbols":[{"symbol":"ETHBTC","status":"TRADING","baseAsset":"ETH","baseAssetPrecision":8,"quoteAsset":"BTC","quotePrecision":8,"quoteAssetPrecision":8,"orderTypes":["LIMIT","LIMIT_MAKER","MARKET"],"icebergAllowed":true,"ocoAllowed":true,"filters":[{"filterType":"PRICE_FILTER","minPrice":"0.00000100","maxPrice":"100000.00000000","tickSize":"0.00000100"},{"filterType":"LOT_SIZE","minQty":"0.00100000","maxQty":"100000.00000000","stepSize":"0.00100000"},{"filterType":"MIN_NOTIONAL","minNotional":"0.00010000","applyToMarket":true,"avgPriceMins":5},{"filterType":"ICEBERG_PARTS","limit":10},{"filterType":"MAX_NUM_ALGO_ORDERS","maxNumAlgoOrders":5}],"permissions":["SPOT"]}]}`)
+
+	info := new(ExchangeInformation)
+	if err := json.Unmarshal(bytes, info); err != nil {
+		t.Fatal(err)
+	}
+
+	symbol, ok := info.SymbolInfo("ETHBTC")
+	if !ok {
+		t.Fatal("symbol ETHBTC not found")
+	}
+	if _, ok := info.SymbolInfo("BNBBTC"); ok {
+		t.Error("symbol BNBBTC should not be found")
+	}
+	if f, ok := symbol.PriceFilter(); !ok || f.TickSize.String() != "0.00000100" {
+		t.Errorf("price filter = %v", f)
+	}
+	if f, ok := symbol.LotSizeFilter(); !ok || f.StepSize.String() != "0.00100000" {
+		t.Errorf("lot size filter = %v", f)
+	}
+	if f, ok := symbol.MinNotionalFilter(); !ok || !f.ApplyToMarket || f.AvgPriceMins != 5 {
+		t.Errorf("min notional filter = %v", f)
+	}
+	if f, ok := symbol.IcebergPartsFilter(); !ok || f.Limit != 10 {
+		t.Errorf("iceberg parts filter = %v", f)
+	}
+	if f, ok := symbol.MaxNumAlgoOrdersFilter(); !ok || f.MaxNumAlgoOrders != 5 {
+		t.Errorf("max num algo orders filter = %v", f)
+	}
+	if _, ok := symbol.MarketLotSizeFilter(); ok {
+		t.Error("market lot size filter should not be found")
+	}
+	if !symbol.HasOrderType(OrderTypeMarket) || symbol.HasOrderType(OrderTypeStopLoss) {
+		t.Error("order types mismatch")
+	}
+}
@@ -45,3 +45,10 @@ func (r *Parser) errorParser(err error) error {
 	}
 	return nil
 }
+
+// ParseError
+// nil for missing key, error for wrong type of value.
+// used by parsers of other packages, e.g. futures
+func (r *Parser) ParseError(err error) error {
+	return r.errorParser(err)
+}
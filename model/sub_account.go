@@ -0,0 +1,715 @@
+package model
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/buger/jsonparser"
+	"time"
+)
+
+// SubAccountList
+
+// SubAccountListParam
+// IsFreeze is "true" or "false", all sub-accounts when it is empty
+type SubAccountListParam struct {
+	Email      string `json:"email" param:"email"`
+	IsFreeze   string `json:"isFreeze" param:"isFreeze" validate:"omitempty,oneof=true false"`
+	Page       int64  `json:"page" param:"page"`
+	Limit      int64  `json:"limit" param:"limit" validate:"max=200"`
+	RecvWindow int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type SubAccount struct {
+	Email                       string    `json:"email"`
+	IsFreeze                    bool      `json:"isFreeze"`
+	CreateTime                  time.Time `json:"createTime"`
+	IsManagedSubAccount         bool      `json:"isManagedSubAccount"`
+	IsAssetManagementSubAccount bool      `json:"isAssetManagementSubAccount"`
+}
+
+func (r *Parser) ParseSubAccountList(b []byte) ([]*SubAccount, error) {
+	results := make([]*SubAccount, 0)
+
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseSubAccount(value); err == nil {
+			results = append(results, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	}, "subAccounts")
+	if err = r.errorParser(err); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *Parser) parseSubAccount(b []byte) (*SubAccount, error) {
+	result := new(SubAccount)
+
+	if v, err := jsonparser.GetString(b, "email"); err == nil {
+		result.Email = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "isFreeze"); err == nil {
+		result.IsFreeze = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "createTime"); err == nil {
+		result.CreateTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "isManagedSubAccount"); err == nil {
+		result.IsManagedSubAccount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "isAssetManagementSubAccount"); err == nil {
+		result.IsAssetManagementSubAccount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// CreateVirtualSubAccount
+
+// VirtualSubAccountParam
+// SubAccountString is a string of less than 20 characters, the email is generated from it
+type VirtualSubAccountParam struct {
+	SubAccountString string `json:"subAccountString" param:"subAccountString" validate:"required"`
+	RecvWindow       int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type VirtualSubAccount struct {
+	Email string `json:"email"`
+}
+
+func (r *Parser) ParseVirtualSubAccount(b []byte) (*VirtualSubAccount, error) {
+	result := new(VirtualSubAccount)
+
+	if v, err := jsonparser.GetString(b, "email"); err == nil {
+		result.Email = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// SubAccountAssets
+
+type SubAccountAssetsParam struct {
+	Email      string `json:"email" param:"email" validate:"required"`
+	RecvWindow int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type SubAccountAssets struct {
+	Balances []*SubAccountBalance `json:"balances"`
+}
+
+type SubAccountBalance struct {
+	Asset  string  `json:"asset"`
+	Free   Decimal `json:"free"`
+	Locked Decimal `json:"locked"`
+}
+
+func (r *Parser) ParseSubAccountAssets(b []byte) (*SubAccountAssets, error) {
+	result := new(SubAccountAssets)
+
+	result.Balances = make([]*SubAccountBalance, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseSubAccountBalance(value); err == nil {
+			result.Balances = append(result.Balances, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	}, "balances"); err != nil {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseSubAccountBalance(b []byte) (*SubAccountBalance, error) {
+	result := new(SubAccountBalance)
+
+	if v, err := jsonparser.GetString(b, "asset"); err == nil {
+		result.Asset = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "free"); err == nil {
+		result.Free = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "locked"); err == nil {
+		result.Locked = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// SubAccountSpotSummary
+
+type SubAccountSpotSummaryParam struct {
+	Email      string `json:"email" param:"email"`
+	Page       int64  `json:"page" param:"page"`
+	Size       int64  `json:"size" param:"size" validate:"max=20"`
+	RecvWindow int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+// SubAccountSpotSummary
+// total asset of each sub-account in BTC
+type SubAccountSpotSummary struct {
+	TotalCount              int64                  `json:"totalCount"`
+	MasterAccountTotalAsset Decimal                `json:"masterAccountTotalAsset"`
+	SubAccounts             []*SubAccountSpotAsset `json:"spotSubUserAssetBtcVoList"`
+}
+
+type SubAccountSpotAsset struct {
+	Email      string  `json:"email"`
+	TotalAsset Decimal `json:"totalAsset"`
+}
+
+func (r *Parser) ParseSubAccountSpotSummary(b []byte) (*SubAccountSpotSummary, error) {
+	result := new(SubAccountSpotSummary)
+
+	if v, err := jsonparser.GetInt(b, "totalCount"); err == nil {
+		result.TotalCount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "masterAccountTotalAsset"); err == nil {
+		result.MasterAccountTotalAsset = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	result.SubAccounts = make([]*SubAccountSpotAsset, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseSubAccountSpotAsset(value); err == nil {
+			result.SubAccounts = append(result.SubAccounts, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	}, "spotSubUserAssetBtcVoList"); err != nil {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseSubAccountSpotAsset(b []byte) (*SubAccountSpotAsset, error) {
+	result := new(SubAccountSpotAsset)
+
+	if v, err := jsonparser.GetString(b, "email"); err == nil {
+		result.Email = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "totalAsset"); err == nil {
+		result.TotalAsset = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// SubAccountUniversalTransfer
+
+// SubAccountUniversalTransferParam
+//   - transfer from or to master account when FromEmail or ToEmail is empty
+//   - Symbol is required by ISOLATED_MARGIN
+type SubAccountUniversalTransferParam struct {
+	FromEmail       string         `json:"fromEmail" param:"fromEmail"`
+	ToEmail         string         `json:"toEmail" param:"toEmail"`
+	FromAccountType SubAccountType `json:"fromAccountType" param:"fromAccountType" validate:"required"`
+	ToAccountType   SubAccountType `json:"toAccountType" param:"toAccountType" validate:"required"`
+	ClientTranId    string         `json:"clientTranId" param:"clientTranId"`
+	Symbol          string         `json:"symbol" param:"symbol"`
+	Asset           string         `json:"asset" param:"asset" validate:"required"`
+	Amount          Decimal        `json:"amount" param:"amount"`
+	RecvWindow      int64          `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type SubAccountUniversalTransfer struct {
+	TranId       int64  `json:"tranId"`
+	ClientTranId string `json:"clientTranId"`
+}
+
+func (r *Parser) ParseSubAccountUniversalTransfer(b []byte) (*SubAccountUniversalTransfer, error) {
+	result := new(SubAccountUniversalTransfer)
+
+	if v, err := jsonparser.GetInt(b, "tranId"); err == nil {
+		result.TranId = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "clientTranId"); err == nil {
+		result.ClientTranId = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// SubAccountUniversalTransferHistory
+
+type SubAccountUniversalTransferHistoryParam struct {
+	FromEmail    string    `json:"fromEmail" param:"fromEmail"`
+	ToEmail      string    `json:"toEmail" param:"toEmail"`
+	ClientTranId string    `json:"clientTranId" param:"clientTranId"`
+	StartTime    time.Time `json:"startTime" param:"startTime"`
+	EndTime      time.Time `json:"endTime" param:"endTime"`
+	Page         int64     `json:"page" param:"page"`
+	Limit        int64     `json:"limit" param:"limit" validate:"max=500"`
+	RecvWindow   int64     `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type SubAccountUniversalTransferHistory struct {
+	TotalCount int64                                `json:"totalCount"`
+	Result     []*SubAccountUniversalTransferRecord `json:"result"`
+}
+
+type SubAccountUniversalTransferRecord struct {
+	TranId          int64     `json:"tranId"`
+	FromEmail       string    `json:"fromEmail"`
+	ToEmail         string    `json:"toEmail"`
+	Asset           string    `json:"asset"`
+	Amount          Decimal   `json:"amount"`
+	CreateTimeStamp time.Time `json:"createTimeStamp"`
+	FromAccountType string    `json:"fromAccountType"`
+	ToAccountType   string    `json:"toAccountType"`
+	Status          string    `json:"status"`
+	ClientTranId    string    `json:"clientTranId"`
+}
+
+func (r *Parser) ParseSubAccountUniversalTransferHistory(b []byte) (*SubAccountUniversalTransferHistory, error) {
+	result := new(SubAccountUniversalTransferHistory)
+
+	if v, err := jsonparser.GetInt(b, "totalCount"); err == nil {
+		result.TotalCount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	result.Result = make([]*SubAccountUniversalTransferRecord, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseSubAccountUniversalTransferRecord(value); err == nil {
+			result.Result = append(result.Result, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	}, "result"); err != nil {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseSubAccountUniversalTransferRecord(b []byte) (*SubAccountUniversalTransferRecord, error) {
+	result := new(SubAccountUniversalTransferRecord)
+
+	if v, err := jsonparser.GetInt(b, "tranId"); err == nil {
+		result.TranId = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "fromEmail"); err == nil {
+		result.FromEmail = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "toEmail"); err == nil {
+		result.ToEmail = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "asset"); err == nil {
+		result.Asset = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "amount"); err == nil {
+		result.Amount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "createTimeStamp"); err == nil {
+		result.CreateTimeStamp = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "fromAccountType"); err == nil {
+		result.FromAccountType = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "toAccountType"); err == nil {
+		result.ToAccountType = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "status"); err == nil {
+		result.Status = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "clientTranId"); err == nil {
+		result.ClientTranId = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// SubAccountFuturesInternalTransfer
+
+// SubAccountFuturesInternalTransferParam
+// transfer between futures accounts of master and sub-accounts
+type SubAccountFuturesInternalTransferParam struct {
+	FromEmail   string      `json:"fromEmail" param:"fromEmail" validate:"required"`
+	ToEmail     string      `json:"toEmail" param:"toEmail" validate:"required"`
+	FuturesType FuturesType `json:"futuresType" param:"futuresType" validate:"required,oneof=1 2"`
+	Asset       string      `json:"asset" param:"asset" validate:"required"`
+	Amount      Decimal     `json:"amount" param:"amount"`
+	RecvWindow  int64       `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type SubAccountFuturesInternalTransfer struct {
+	Success bool   `json:"success"`
+	TxnId   string `json:"txnId"`
+}
+
+func (r *Parser) ParseSubAccountFuturesInternalTransfer(b []byte) (*SubAccountFuturesInternalTransfer, error) {
+	result := new(SubAccountFuturesInternalTransfer)
+
+	if v, err := jsonparser.GetBoolean(b, "success"); err == nil {
+		result.Success = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "txnId"); err == nil {
+		result.TxnId = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// SubAccountTransferHistory
+
+// SubAccountTransferHistoryParam
+// spot asset transfer history of sub-accounts, the recent 100 days by default
+type SubAccountTransferHistoryParam struct {
+	FromEmail  string    `json:"fromEmail" param:"fromEmail"`
+	ToEmail    string    `json:"toEmail" param:"toEmail"`
+	StartTime  time.Time `json:"startTime" param:"startTime"`
+	EndTime    time.Time `json:"endTime" param:"endTime"`
+	Page       int64     `json:"page" param:"page"`
+	Limit      int64     `json:"limit" param:"limit"`
+	RecvWindow int64     `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type SubAccountTransfer struct {
+	From   string    `json:"from"`
+	To     string    `json:"to"`
+	Asset  string    `json:"asset"`
+	Qty    Decimal   `json:"qty"`
+	Status string    `json:"status"`
+	TranId int64     `json:"tranId"`
+	Time   time.Time `json:"time"`
+}
+
+func (r *Parser) ParseSubAccountTransferHistory(b []byte) ([]*SubAccountTransfer, error) {
+	results := make([]*SubAccountTransfer, 0)
+
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseSubAccountTransfer(value); err == nil {
+			results = append(results, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	})
+	if err = r.errorParser(err); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *Parser) parseSubAccountTransfer(b []byte) (*SubAccountTransfer, error) {
+	result := new(SubAccountTransfer)
+
+	if v, err := jsonparser.GetString(b, "from"); err == nil {
+		result.From = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "to"); err == nil {
+		result.To = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "asset"); err == nil {
+		result.Asset = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "qty"); err == nil {
+		result.Qty = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "status"); err == nil {
+		result.Status = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "tranId"); err == nil {
+		result.TranId = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "time"); err == nil {
+		result.Time = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// EnableSubAccountMargin, EnableSubAccountFutures
+
+type SubAccountEmailParam struct {
+	Email      string `json:"email" param:"email" validate:"required"`
+	RecvWindow int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type SubAccountMarginEnabled struct {
+	Email           string `json:"email"`
+	IsMarginEnabled bool   `json:"isMarginEnabled"`
+}
+
+func (r *Parser) ParseSubAccountMarginEnabled(b []byte) (*SubAccountMarginEnabled, error) {
+	result := new(SubAccountMarginEnabled)
+
+	if v, err := jsonparser.GetString(b, "email"); err == nil {
+		result.Email = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "isMarginEnabled"); err == nil {
+		result.IsMarginEnabled = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+type SubAccountFuturesEnabled struct {
+	Email            string `json:"email"`
+	IsFuturesEnabled bool   `json:"isFuturesEnabled"`
+}
+
+func (r *Parser) ParseSubAccountFuturesEnabled(b []byte) (*SubAccountFuturesEnabled, error) {
+	result := new(SubAccountFuturesEnabled)
+
+	if v, err := jsonparser.GetString(b, "email"); err == nil {
+		result.Email = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "isFuturesEnabled"); err == nil {
+		result.IsFuturesEnabled = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// SubAccountApiIpRestriction
+
+type SubAccountApiParam struct {
+	Email            string `json:"email" param:"email" validate:"required"`
+	SubAccountApiKey string `json:"subAccountApiKey" param:"subAccountApiKey" validate:"required"`
+	RecvWindow       int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+// SubAccountApiIpRestrictionParam
+//   - Status IpRestrictionStatusRestricted for access from IpAddress only
+//   - IpAddress is comma separated, can be added or removed by multiple requests
+type SubAccountApiIpRestrictionParam struct {
+	Email            string              `json:"email" param:"email" validate:"required"`
+	SubAccountApiKey string              `json:"subAccountApiKey" param:"subAccountApiKey" validate:"required"`
+	Status           IpRestrictionStatus `json:"status" param:"status" validate:"required"`
+	IpAddress        string              `json:"ipAddress" param:"ipAddress"`
+	RecvWindow       int64               `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type SubAccountApiIpParam struct {
+	Email            string `json:"email" param:"email" validate:"required"`
+	SubAccountApiKey string `json:"subAccountApiKey" param:"subAccountApiKey" validate:"required"`
+	IpAddress        string `json:"ipAddress" param:"ipAddress" validate:"required"`
+	RecvWindow       int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+// SubAccountApiIpRestriction
+// IpRestrict is returned by query, Status is returned by update
+type SubAccountApiIpRestriction struct {
+	IpRestrict bool                `json:"ipRestrict"`
+	Status     IpRestrictionStatus `json:"status"`
+	IpList     []string            `json:"ipList"`
+	UpdateTime time.Time           `json:"updateTime"`
+	ApiKey     string              `json:"apiKey"`
+}
+
+func (r *Parser) ParseSubAccountApiIpRestriction(b []byte) (*SubAccountApiIpRestriction, error) {
+	result := new(SubAccountApiIpRestriction)
+
+	// "true" or "false"
+	if v, err := jsonparser.GetString(b, "ipRestrict"); err == nil {
+		result.IpRestrict = v == "true"
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "status"); err == nil {
+		result.Status = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	result.IpList = make([]string, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		result.IpList = append(result.IpList, string(value))
+	}, "ipList"); err != nil {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "updateTime"); err == nil {
+		result.UpdateTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "apiKey"); err == nil {
+		result.ApiKey = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,59 @@
+package model
+
+import "testing"
+
+// SubAccountList
+func TestParser_ParseSubAccountList(t *testing.T) {
+	bytes := []byte(`{"subAccounts":[{"email":"testsub@gmail.com","isFreeze":false,"createTime":1544433328000,"isManagedSubAccount":false,"isAssetManagementSubAccount":false},{"email":"virtual@test.com","isFreeze":true,"createTime":1544433328000,"isManagedSubAccount":false,"isAssetManagementSubAccount":false}]}`)
+
+	parser := NewParser()
+	result, err := parser.ParseSubAccountList(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 2 || !result[1].IsFreeze || result[0].CreateTime.UnixMilli() != 1544433328000 {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+// SubAccountSpotSummary
+func TestParser_ParseSubAccountSpotSummary(t *testing.T) {
+	bytes := []byte(`{"totalCount":2,"masterAccountTotalAsset":"0.23231201","spotSubUserAssetBtcVoList":[{"email":"sub123@test.com","totalAsset":"9999.00000000"},{"email":"test456@test.com","totalAsset":"0.00000000"}]}`)
+
+	parser := NewParser()
+	result, err := parser.ParseSubAccountSpotSummary(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.TotalCount != 2 || len(result.SubAccounts) != 2 || result.SubAccounts[0].TotalAsset.String() != "9999.00000000" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+// SubAccountUniversalTransferHistory
+func TestParser_ParseSubAccountUniversalTransferHistory(t *testing.T) {
+	bytes := []byte(`{"result":[{"tranId":92275823339,"fromEmail":"abctest@gmail.com","toEmail":"deftest@gmail.com","asset":"BNB","amount":"0.01","createTimeStamp":1640317374000,"fromAccountType":"USDT_FUTURE","toAccountType":"USDT_FUTURE","status":"SUCCESS","clientTranId":"test"}],"totalCount":1}`)
+
+	parser := NewParser()
+	result, err := parser.ParseSubAccountUniversalTransferHistory(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.TotalCount != 1 || len(result.Result) != 1 || result.Result[0].Amount.String() != "0.01" || result.Result[0].ToAccountType != SubAccountTypeUsdtFuture {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+// SubAccountApiIpRestriction
+func TestParser_ParseSubAccountApiIpRestriction(t *testing.T) {
+	bytes := []byte(`{"ipRestrict":"true","ipList":["69.210.67.14"],"updateTime":1636371437000,"apiKey":"k5V49ldtn4tszj6W3hystegdfvmGbqDzjmkCtpTvC0G74WhK7yd4rfCTo4lShf"}`)
+
+	parser := NewParser()
+	result, err := parser.ParseSubAccountApiIpRestriction(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.IpRestrict || len(result.IpList) != 1 || result.IpList[0] != "69.210.67.14" {
+		t.Errorf("result = %+v", result)
+	}
+}
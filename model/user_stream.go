@@ -0,0 +1,27 @@
+package model
+
+import (
+	"github.com/buger/jsonparser"
+)
+
+// ListenKey (User Data Stream)
+
+type ListenKeyParam struct {
+	ListenKey string `json:"listenKey" param:"listenKey" validate:"required"`
+}
+
+func (r *Parser) ParseListenKey(b []byte) (string, error) {
+	v, err := jsonparser.GetString(b, "listenKey")
+	if err != nil {
+		return "", err
+	}
+
+	return v, nil
+}
+
+// IsolatedListenKeyParam
+// ListenKey is empty when a listenKey is created
+type IsolatedListenKeyParam struct {
+	Symbol    string `json:"symbol" param:"symbol" validate:"required"`
+	ListenKey string `json:"listenKey" param:"listenKey"`
+}
@@ -0,0 +1,17 @@
+package model
+
+import "testing"
+
+// ListenKey
+func TestParser_ParseListenKey(t *testing.T) {
+	bytes := []byte(`{"listenKey":"pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1"}`)
+
+	parser := NewParser()
+	listenKey, err := parser.ParseListenKey(bytes)
+	if err != nil {
+		t.Error(err)
+	}
+	if listenKey != "pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1" {
+		t.Errorf("unexpected listenKey %s", listenKey)
+	}
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"github.com/go-playground/validator/v10"
+	"reflect"
+)
+
+// NewValidator
+// validator for parameter struct, Decimal is validated as string and zero Decimal is empty value
+func NewValidator() *validator.Validate {
+	validate := validator.New()
+	validate.RegisterCustomTypeFunc(decimalValue, Decimal{})
+	return validate
+}
+
+func decimalValue(field reflect.Value) interface{} {
+	if d, ok := field.Interface().(Decimal); ok && !d.IsZero() {
+		return d.String()
+	}
+	return ""
+}
@@ -0,0 +1,1293 @@
+package model
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/buger/jsonparser"
+	"time"
+)
+
+// CoinInfo
+
+type CoinInfoParam struct {
+	RecvWindow int64 `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type CoinInfo struct {
+	Coin              string         `json:"coin"`
+	Name              string         `json:"name"`
+	DepositAllEnable  bool           `json:"depositAllEnable"`
+	WithdrawAllEnable bool           `json:"withdrawAllEnable"`
+	Free              Decimal        `json:"free"`
+	Locked            Decimal        `json:"locked"`
+	Freeze            Decimal        `json:"freeze"`
+	Withdrawing       Decimal        `json:"withdrawing"`
+	Ipoing            Decimal        `json:"ipoing"`
+	Ipoable           Decimal        `json:"ipoable"`
+	Storage           Decimal        `json:"storage"`
+	IsLegalMoney      bool           `json:"isLegalMoney"`
+	Trading           bool           `json:"trading"`
+	NetworkList       []*CoinNetwork `json:"networkList"`
+}
+
+type CoinNetwork struct {
+	Network                 string  `json:"network"`
+	Coin                    string  `json:"coin"`
+	Name                    string  `json:"name"`
+	IsDefault               bool    `json:"isDefault"`
+	DepositEnable           bool    `json:"depositEnable"`
+	WithdrawEnable          bool    `json:"withdrawEnable"`
+	DepositDesc             string  `json:"depositDesc"`
+	WithdrawDesc            string  `json:"withdrawDesc"`
+	SpecialTips             string  `json:"specialTips"`
+	AddressRegex            string  `json:"addressRegex"`
+	MemoRegex               string  `json:"memoRegex"`
+	MinConfirm              int64   `json:"minConfirm"`
+	UnLockConfirm           int64   `json:"unLockConfirm"`
+	ResetAddressStatus      bool    `json:"resetAddressStatus"`
+	SameAddress             bool    `json:"sameAddress"`
+	WithdrawFee             Decimal `json:"withdrawFee"`
+	WithdrawMin             Decimal `json:"withdrawMin"`
+	WithdrawMax             Decimal `json:"withdrawMax"`
+	WithdrawIntegerMultiple Decimal `json:"withdrawIntegerMultiple"`
+}
+
+func (r *Parser) ParseCoinInfo(b []byte) ([]*CoinInfo, error) {
+	results := make([]*CoinInfo, 0)
+
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseCoinInfo(value); err == nil {
+			results = append(results, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	})
+	if err = r.errorParser(err); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *Parser) parseCoinInfo(b []byte) (*CoinInfo, error) {
+	result := new(CoinInfo)
+
+	if v, err := jsonparser.GetString(b, "coin"); err == nil {
+		result.Coin = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "name"); err == nil {
+		result.Name = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "depositAllEnable"); err == nil {
+		result.DepositAllEnable = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "withdrawAllEnable"); err == nil {
+		result.WithdrawAllEnable = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "free"); err == nil {
+		result.Free = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "locked"); err == nil {
+		result.Locked = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "freeze"); err == nil {
+		result.Freeze = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "withdrawing"); err == nil {
+		result.Withdrawing = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "ipoing"); err == nil {
+		result.Ipoing = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "ipoable"); err == nil {
+		result.Ipoable = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "storage"); err == nil {
+		result.Storage = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "isLegalMoney"); err == nil {
+		result.IsLegalMoney = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "trading"); err == nil {
+		result.Trading = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	result.NetworkList = make([]*CoinNetwork, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseCoinNetwork(value); err == nil {
+			result.NetworkList = append(result.NetworkList, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	}, "networkList"); err != nil {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseCoinNetwork(b []byte) (*CoinNetwork, error) {
+	result := new(CoinNetwork)
+
+	if v, err := jsonparser.GetString(b, "network"); err == nil {
+		result.Network = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "coin"); err == nil {
+		result.Coin = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "name"); err == nil {
+		result.Name = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "isDefault"); err == nil {
+		result.IsDefault = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "depositEnable"); err == nil {
+		result.DepositEnable = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "withdrawEnable"); err == nil {
+		result.WithdrawEnable = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "depositDesc"); err == nil {
+		result.DepositDesc = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "withdrawDesc"); err == nil {
+		result.WithdrawDesc = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "specialTips"); err == nil {
+		result.SpecialTips = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "addressRegex"); err == nil {
+		result.AddressRegex = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "memoRegex"); err == nil {
+		result.MemoRegex = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "minConfirm"); err == nil {
+		result.MinConfirm = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "unLockConfirm"); err == nil {
+		result.UnLockConfirm = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "resetAddressStatus"); err == nil {
+		result.ResetAddressStatus = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetBoolean(b, "sameAddress"); err == nil {
+		result.SameAddress = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "withdrawFee"); err == nil {
+		result.WithdrawFee = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "withdrawMin"); err == nil {
+		result.WithdrawMin = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "withdrawMax"); err == nil {
+		result.WithdrawMax = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "withdrawIntegerMultiple"); err == nil {
+		result.WithdrawIntegerMultiple = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// DepositAddress
+
+type DepositAddressParam struct {
+	Coin       string `json:"coin" param:"coin" validate:"required"`
+	Network    string `json:"network" param:"network"`
+	RecvWindow int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type DepositAddress struct {
+	Coin    string `json:"coin"`
+	Address string `json:"address"`
+	Tag     string `json:"tag"`
+	Url     string `json:"url"`
+}
+
+func (r *Parser) ParseDepositAddress(b []byte) (*DepositAddress, error) {
+	result := new(DepositAddress)
+
+	if v, err := jsonparser.GetString(b, "coin"); err == nil {
+		result.Coin = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "address"); err == nil {
+		result.Address = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "tag"); err == nil {
+		result.Tag = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "url"); err == nil {
+		result.Url = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// DepositHistory
+
+type DepositHistoryParam struct {
+	Coin       string        `json:"coin" param:"coin"`
+	Status     DepositStatus `json:"status" param:"status"`
+	StartTime  time.Time     `json:"startTime" param:"startTime"`
+	EndTime    time.Time     `json:"endTime" param:"endTime"`
+	Offset     int64         `json:"offset" param:"offset"`
+	Limit      int64         `json:"limit" param:"limit" validate:"max=1000"`
+	TxId       string        `json:"txId" param:"txId"`
+	RecvWindow int64         `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type DepositHistory struct {
+	Id            string    `json:"id"`
+	Amount        Decimal   `json:"amount"`
+	Coin          string    `json:"coin"`
+	Network       string    `json:"network"`
+	Status        int64     `json:"status"`
+	Address       string    `json:"address"`
+	AddressTag    string    `json:"addressTag"`
+	TxId          string    `json:"txId"`
+	InsertTime    time.Time `json:"insertTime"`
+	TransferType  int64     `json:"transferType"`
+	ConfirmTimes  string    `json:"confirmTimes"`
+	UnlockConfirm int64     `json:"unlockConfirm"`
+	WalletType    int64     `json:"walletType"`
+}
+
+func (r *Parser) ParseDepositHistory(b []byte) ([]*DepositHistory, error) {
+	results := make([]*DepositHistory, 0)
+
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseDepositHistory(value); err == nil {
+			results = append(results, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	})
+	if err = r.errorParser(err); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *Parser) parseDepositHistory(b []byte) (*DepositHistory, error) {
+	result := new(DepositHistory)
+
+	if v, err := jsonparser.GetString(b, "id"); err == nil {
+		result.Id = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "amount"); err == nil {
+		result.Amount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "coin"); err == nil {
+		result.Coin = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "network"); err == nil {
+		result.Network = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "status"); err == nil {
+		result.Status = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "address"); err == nil {
+		result.Address = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "addressTag"); err == nil {
+		result.AddressTag = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "txId"); err == nil {
+		result.TxId = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "insertTime"); err == nil {
+		result.InsertTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "transferType"); err == nil {
+		result.TransferType = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "confirmTimes"); err == nil {
+		result.ConfirmTimes = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "unlockConfirm"); err == nil {
+		result.UnlockConfirm = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "walletType"); err == nil {
+		result.WalletType = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// Withdraw
+
+type WithdrawParam struct {
+	Coin            string  `json:"coin" param:"coin" validate:"required"`
+	WithdrawOrderId string  `json:"withdrawOrderId" param:"withdrawOrderId"`
+	Network         string  `json:"network" param:"network"`
+	Address         string  `json:"address" param:"address" validate:"required"`
+	AddressTag      string  `json:"addressTag" param:"addressTag"`
+	Amount          Decimal `json:"amount" param:"amount"`
+	// TransactionFeeFlag (bool): when making internal transfer, true for returning the fee to the destination account
+	TransactionFeeFlag bool   `json:"transactionFeeFlag" param:"transactionFeeFlag"`
+	Name               string `json:"name" param:"name"`
+	// WalletType (int64): 0 spot wallet, 1 funding wallet. By default, 0
+	WalletType int64 `json:"walletType" param:"walletType"`
+	RecvWindow int64 `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type Withdraw struct {
+	Id string `json:"id"`
+}
+
+func (r *Parser) ParseWithdraw(b []byte) (*Withdraw, error) {
+	result := new(Withdraw)
+
+	if v, err := jsonparser.GetString(b, "id"); err == nil {
+		result.Id = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// WithdrawHistory
+
+type WithdrawHistoryParam struct {
+	Coin            string         `json:"coin" param:"coin"`
+	WithdrawOrderId string         `json:"withdrawOrderId" param:"withdrawOrderId"`
+	Status          WithdrawStatus `json:"status" param:"status"`
+	Offset          int64          `json:"offset" param:"offset"`
+	Limit           int64          `json:"limit" param:"limit" validate:"max=1000"`
+	StartTime       time.Time      `json:"startTime" param:"startTime"`
+	EndTime         time.Time      `json:"endTime" param:"endTime"`
+	RecvWindow      int64          `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type WithdrawHistory struct {
+	Id              string    `json:"id"`
+	Amount          Decimal   `json:"amount"`
+	TransactionFee  Decimal   `json:"transactionFee"`
+	Coin            string    `json:"coin"`
+	Status          int64     `json:"status"`
+	Address         string    `json:"address"`
+	AddressTag      string    `json:"addressTag"`
+	TxId            string    `json:"txId"`
+	ApplyTime       time.Time `json:"applyTime"`
+	Network         string    `json:"network"`
+	TransferType    int64     `json:"transferType"`
+	WithdrawOrderId string    `json:"withdrawOrderId"`
+	Info            string    `json:"info"`
+	ConfirmNo       int64     `json:"confirmNo"`
+	WalletType      int64     `json:"walletType"`
+	TxKey           string    `json:"txKey"`
+}
+
+func (r *Parser) ParseWithdrawHistory(b []byte) ([]*WithdrawHistory, error) {
+	results := make([]*WithdrawHistory, 0)
+
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseWithdrawHistory(value); err == nil {
+			results = append(results, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	})
+	if err = r.errorParser(err); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *Parser) parseWithdrawHistory(b []byte) (*WithdrawHistory, error) {
+	result := new(WithdrawHistory)
+
+	if v, err := jsonparser.GetString(b, "id"); err == nil {
+		result.Id = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "amount"); err == nil {
+		result.Amount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "transactionFee"); err == nil {
+		result.TransactionFee = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "coin"); err == nil {
+		result.Coin = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "status"); err == nil {
+		result.Status = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "address"); err == nil {
+		result.Address = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "addressTag"); err == nil {
+		result.AddressTag = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "txId"); err == nil {
+		result.TxId = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "applyTime"); err == nil {
+		result.ApplyTime = parseDateTime(v)
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "network"); err == nil {
+		result.Network = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "transferType"); err == nil {
+		result.TransferType = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "withdrawOrderId"); err == nil {
+		result.WithdrawOrderId = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "info"); err == nil {
+		result.Info = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "confirmNo"); err == nil {
+		result.ConfirmNo = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "walletType"); err == nil {
+		result.WalletType = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "txKey"); err == nil {
+		result.TxKey = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// AssetDividend
+
+type AssetDividendParam struct {
+	Asset      string    `json:"asset" param:"asset"`
+	StartTime  time.Time `json:"startTime" param:"startTime"`
+	EndTime    time.Time `json:"endTime" param:"endTime"`
+	Limit      int64     `json:"limit" param:"limit" validate:"max=500"`
+	RecvWindow int64     `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type AssetDividend struct {
+	Total int64                  `json:"total"`
+	Rows  []*AssetDividendRecord `json:"rows"`
+}
+
+type AssetDividendRecord struct {
+	Id      int64     `json:"id"`
+	Amount  Decimal   `json:"amount"`
+	Asset   string    `json:"asset"`
+	DivTime time.Time `json:"divTime"`
+	EnInfo  string    `json:"enInfo"`
+	TranId  int64     `json:"tranId"`
+}
+
+func (r *Parser) ParseAssetDividend(b []byte) (*AssetDividend, error) {
+	result := new(AssetDividend)
+
+	if v, err := jsonparser.GetInt(b, "total"); err == nil {
+		result.Total = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	result.Rows = make([]*AssetDividendRecord, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseAssetDividendRecord(value); err == nil {
+			result.Rows = append(result.Rows, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	}, "rows"); err != nil {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseAssetDividendRecord(b []byte) (*AssetDividendRecord, error) {
+	result := new(AssetDividendRecord)
+
+	if v, err := jsonparser.GetInt(b, "id"); err == nil {
+		result.Id = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "amount"); err == nil {
+		result.Amount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "asset"); err == nil {
+		result.Asset = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "divTime"); err == nil {
+		result.DivTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "enInfo"); err == nil {
+		result.EnInfo = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "tranId"); err == nil {
+		result.TranId = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// DustLog
+
+type DustLogParam struct {
+	StartTime  time.Time `json:"startTime" param:"startTime"`
+	EndTime    time.Time `json:"endTime" param:"endTime"`
+	RecvWindow int64     `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type DustLog struct {
+	Total              int64       `json:"total"`
+	UserAssetDribblets []*Dribblet `json:"userAssetDribblets"`
+}
+
+type Dribblet struct {
+	OperateTime              time.Time         `json:"operateTime"`
+	TotalTransferedAmount    Decimal           `json:"totalTransferedAmount"`
+	TotalServiceChargeAmount Decimal           `json:"totalServiceChargeAmount"`
+	TransId                  int64             `json:"transId"`
+	UserAssetDribbletDetails []*DribbletDetail `json:"userAssetDribbletDetails"`
+}
+
+type DribbletDetail struct {
+	TransId             int64     `json:"transId"`
+	FromAsset           string    `json:"fromAsset"`
+	Amount              Decimal   `json:"amount"`
+	TransferedAmount    Decimal   `json:"transferedAmount"`
+	ServiceChargeAmount Decimal   `json:"serviceChargeAmount"`
+	OperateTime         time.Time `json:"operateTime"`
+}
+
+func (r *Parser) ParseDustLog(b []byte) (*DustLog, error) {
+	result := new(DustLog)
+
+	if v, err := jsonparser.GetInt(b, "total"); err == nil {
+		result.Total = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	result.UserAssetDribblets = make([]*Dribblet, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseDribblet(value); err == nil {
+			result.UserAssetDribblets = append(result.UserAssetDribblets, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	}, "userAssetDribblets"); err != nil {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseDribblet(b []byte) (*Dribblet, error) {
+	result := new(Dribblet)
+
+	if v, err := jsonparser.GetInt(b, "operateTime"); err == nil {
+		result.OperateTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "totalTransferedAmount"); err == nil {
+		result.TotalTransferedAmount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "totalServiceChargeAmount"); err == nil {
+		result.TotalServiceChargeAmount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "transId"); err == nil {
+		result.TransId = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	result.UserAssetDribbletDetails = make([]*DribbletDetail, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseDribbletDetail(value); err == nil {
+			result.UserAssetDribbletDetails = append(result.UserAssetDribbletDetails, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	}, "userAssetDribbletDetails"); err != nil {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseDribbletDetail(b []byte) (*DribbletDetail, error) {
+	result := new(DribbletDetail)
+
+	if v, err := jsonparser.GetInt(b, "transId"); err == nil {
+		result.TransId = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "fromAsset"); err == nil {
+		result.FromAsset = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "amount"); err == nil {
+		result.Amount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "transferedAmount"); err == nil {
+		result.TransferedAmount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "serviceChargeAmount"); err == nil {
+		result.ServiceChargeAmount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "operateTime"); err == nil {
+		result.OperateTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// DustTransfer
+
+type DustTransferParam struct {
+	// Asset ([]string): assets being converted to BNB, e.g. []string{"BTC", "USDT"}
+	Asset      []string `json:"asset" param:"asset" validate:"required"`
+	RecvWindow int64    `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type DustTransfer struct {
+	TotalServiceCharge Decimal               `json:"totalServiceCharge"`
+	TotalTransfered    Decimal               `json:"totalTransfered"`
+	TransferResult     []*DustTransferResult `json:"transferResult"`
+}
+
+type DustTransferResult struct {
+	TranId              int64     `json:"tranId"`
+	FromAsset           string    `json:"fromAsset"`
+	Amount              Decimal   `json:"amount"`
+	TransferedAmount    Decimal   `json:"transferedAmount"`
+	ServiceChargeAmount Decimal   `json:"serviceChargeAmount"`
+	OperateTime         time.Time `json:"operateTime"`
+}
+
+func (r *Parser) ParseDustTransfer(b []byte) (*DustTransfer, error) {
+	result := new(DustTransfer)
+
+	if v, err := GetDecimal(b, "totalServiceCharge"); err == nil {
+		result.TotalServiceCharge = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "totalTransfered"); err == nil {
+		result.TotalTransfered = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	result.TransferResult = make([]*DustTransferResult, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseDustTransferResult(value); err == nil {
+			result.TransferResult = append(result.TransferResult, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	}, "transferResult"); err != nil {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseDustTransferResult(b []byte) (*DustTransferResult, error) {
+	result := new(DustTransferResult)
+
+	if v, err := jsonparser.GetInt(b, "tranId"); err == nil {
+		result.TranId = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "fromAsset"); err == nil {
+		result.FromAsset = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "amount"); err == nil {
+		result.Amount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "transferedAmount"); err == nil {
+		result.TransferedAmount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "serviceChargeAmount"); err == nil {
+		result.ServiceChargeAmount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "operateTime"); err == nil {
+		result.OperateTime = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// TradeFee
+
+type TradeFeeParam struct {
+	Symbol     string `json:"symbol" param:"symbol"`
+	RecvWindow int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type TradeFee struct {
+	Symbol          string  `json:"symbol"`
+	MakerCommission Decimal `json:"makerCommission"`
+	TakerCommission Decimal `json:"takerCommission"`
+}
+
+func (r *Parser) ParseTradeFee(b []byte) ([]*TradeFee, error) {
+	results := make([]*TradeFee, 0)
+
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseTradeFee(value); err == nil {
+			results = append(results, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	})
+	if err = r.errorParser(err); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *Parser) parseTradeFee(b []byte) (*TradeFee, error) {
+	result := new(TradeFee)
+
+	if v, err := jsonparser.GetString(b, "symbol"); err == nil {
+		result.Symbol = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "makerCommission"); err == nil {
+		result.MakerCommission = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "takerCommission"); err == nil {
+		result.TakerCommission = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// UniversalTransfer
+
+type UniversalTransferParam struct {
+	Type   UniversalTransferType `json:"type" param:"type" validate:"required"`
+	Asset  string                `json:"asset" param:"asset" validate:"required"`
+	Amount Decimal               `json:"amount" param:"amount"`
+	// FromSymbol (string): isolated margin symbol, required when Type is ISOLATEDMARGIN_MARGIN or ISOLATEDMARGIN_ISOLATEDMARGIN
+	FromSymbol string `json:"fromSymbol" param:"fromSymbol"`
+	// ToSymbol (string): isolated margin symbol, required when Type is MARGIN_ISOLATEDMARGIN or ISOLATEDMARGIN_ISOLATEDMARGIN
+	ToSymbol   string `json:"toSymbol" param:"toSymbol"`
+	RecvWindow int64  `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type UniversalTransfer struct {
+	TranId int64 `json:"tranId"`
+}
+
+func (r *Parser) ParseUniversalTransfer(b []byte) (*UniversalTransfer, error) {
+	result := new(UniversalTransfer)
+
+	if v, err := jsonparser.GetInt(b, "tranId"); err == nil {
+		result.TranId = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// UniversalTransferHistory
+
+type UniversalTransferHistoryParam struct {
+	Type       UniversalTransferType `json:"type" param:"type" validate:"required"`
+	StartTime  time.Time             `json:"startTime" param:"startTime"`
+	EndTime    time.Time             `json:"endTime" param:"endTime"`
+	Current    int64                 `json:"current" param:"current"`
+	Size       int64                 `json:"size" param:"size" validate:"max=100"`
+	FromSymbol string                `json:"fromSymbol" param:"fromSymbol"`
+	ToSymbol   string                `json:"toSymbol" param:"toSymbol"`
+	RecvWindow int64                 `json:"recvWindow" param:"recvWindow" validate:"max=60000"`
+}
+
+type UniversalTransferHistory struct {
+	Total int64                      `json:"total"`
+	Rows  []*UniversalTransferRecord `json:"rows"`
+}
+
+type UniversalTransferRecord struct {
+	TranId    int64     `json:"tranId"`
+	Asset     string    `json:"asset"`
+	Amount    Decimal   `json:"amount"`
+	Type      string    `json:"type"`
+	Status    string    `json:"status"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (r *Parser) ParseUniversalTransferHistory(b []byte) (*UniversalTransferHistory, error) {
+	result := new(UniversalTransferHistory)
+
+	if v, err := jsonparser.GetInt(b, "total"); err == nil {
+		result.Total = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	result.Rows = make([]*UniversalTransferRecord, 0)
+	if _, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		if item, err := r.parseUniversalTransferRecord(value); err == nil {
+			result.Rows = append(result.Rows, item)
+		} else {
+			if _err = r.errorParser(err); _err != nil {
+				return
+			}
+		}
+	}, "rows"); err != nil {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Parser) parseUniversalTransferRecord(b []byte) (*UniversalTransferRecord, error) {
+	result := new(UniversalTransferRecord)
+
+	if v, err := jsonparser.GetInt(b, "tranId"); err == nil {
+		result.TranId = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "asset"); err == nil {
+		result.Asset = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := GetDecimal(b, "amount"); err == nil {
+		result.Amount = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "type"); err == nil {
+		result.Type = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetString(b, "status"); err == nil {
+		result.Status = v
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+	if v, err := jsonparser.GetInt(b, "timestamp"); err == nil {
+		result.Timestamp = lib.ConvertIntToTime(v, 0)
+	} else {
+		if err := r.errorParser(err); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// parseDateTime
+// e.g. applyTime of WithdrawHistory, "2019-10-12 11:12:02" in UTC
+func parseDateTime(s string) time.Time {
+	t, err := time.ParseInLocation("2006-01-02 15:04:05", s, time.UTC)
+	if err != nil {
+		return time.Time{}
+	}
+	return t.Local()
+}
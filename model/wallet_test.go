@@ -0,0 +1,151 @@
+package model
+
+import "testing"
+
+// CoinInfo
+func TestParser_ParseCoinInfo(t *testing.T) {
+	bytes := []byte(`[{"coin":"BTC","depositAllEnable":true,"free":"0.08074558","freeze":"0.00000000","ipoable":"0.00000000","ipoing":"0.00000000","isLegalMoney":false,"locked":"0.00000000","name":"Bitcoin","networkList":[{"addressRegex":"^(bnb1)[0-9a-z]{38}$","coin":"BTC","depositDesc":"Wallet Maintenance, Deposit Suspended","depositEnable":false,"isDefault":false,"memoRegex":"^[0-9A-Za-z\\-_]{1,120}$","minConfirm":1,"name":"BEP2","network":"BNB","resetAddressStatus":false,"specialTips":"Both a MEMO and an Address are required to successfully deposit your BEP2-BTCB tokens to Binance.","unLockConfirm":0,"withdrawDesc":"Wallet Maintenance, Withdrawal Suspended","withdrawEnable":false,"withdrawFee":"0.00000220","withdrawIntegerMultiple":"0.00000001","withdrawMin":"0.00000440","withdrawMax":"9999999999.99999999","sameAddress":true},{"addressRegex":"^[13][a-km-zA-HJ-NP-Z1-9]{25,34}$|^(bc1)[0-9A-Za-z]{39,59}$","coin":"BTC","depositEnable":true,"isDefault":true,"memoRegex":"","minConfirm":1,"name":"BTC","network":"BTC","resetAddressStatus":false,"specialTips":"","unLockConfirm":2,"withdrawEnable":true,"withdrawFee":"0.00050000","withdrawIntegerMultiple":"0.00000001","withdrawMin":"0.00100000","withdrawMax":"750","sameAddress":false}],"storage":"0.00000000","trading":true,"withdrawAllEnable":true,"withdrawing":"0.00000000"}]`)
+
+	parser := NewParser()
+	results, err := parser.ParseCoinInfo(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || len(results[0].NetworkList) != 2 {
+		t.Fatalf("results = %+v", results)
+	}
+	if network := results[0].NetworkList[1]; network.Network != "BTC" || !network.IsDefault || network.WithdrawFee.String() != "0.00050000" {
+		t.Errorf("network = %+v", network)
+	}
+}
+
+// DepositAddress
+func TestParser_ParseDepositAddress(t *testing.T) {
+	bytes := []byte(`{"address":"1HPn8Rx2y6nNSfagQBKy27GB99Vbzg89wv","coin":"BTC","tag":"","url":"https://btc.com/1HPn8Rx2y6nNSfagQBKy27GB99Vbzg89wv"}`)
+
+	parser := NewParser()
+	_, err := parser.ParseDepositAddress(bytes)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+// DepositHistory
+func TestParser_ParseDepositHistory(t *testing.T) {
+	bytes := []byte(`[{"id":"769800519366885376","amount":"0.001","coin":"BNB","network":"BNB","status":0,"address":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","addressTag":"101764890","txId":"98A3EA560C6B3336D348B6C83F0F95ECE4F1F5919E94BD006E5BF3BF264FACFC","insertTime":1661493146000,"transferType":0,"confirmTimes":"1/1","unlockConfirm":0,"walletType":0},{"id":"769754833590042625","amount":"0.50000000","coin":"IOTA","network":"IOTA","status":1,"address":"SIZ9VLMHWATXKV99LH99CIGFJFUMLEHGWVZVNNZXRJJVWBPHYWPPBOSDORZ9EQSHCZAMPVAPGFYQAUUV9DROOXJLNW","addressTag":"","txId":"ESBFVQUTPIWQNJSPXFNHNYHSQNTGKRVKPRABQWTAXCDWOAKDKYWPTVG9BGXNVNKTLEJGESAVXIKIZ9999","insertTime":1599620082000,"transferType":0,"confirmTimes":"1/1","unlockConfirm":0,"walletType":0}]`)
+
+	parser := NewParser()
+	results, err := parser.ParseDepositHistory(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[1].Status != 1 || results[0].Amount.String() != "0.001" {
+		t.Errorf("results = %+v", results)
+	}
+}
+
+// Withdraw
+func TestParser_ParseWithdraw(t *testing.T) {
+	bytes := []byte(`{"id":"7213fea8e94b4a5593d507237e5a555b"}`)
+
+	parser := NewParser()
+	result, err := parser.ParseWithdraw(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Id != "7213fea8e94b4a5593d507237e5a555b" {
+		t.Errorf("id = %s", result.Id)
+	}
+}
+
+// WithdrawHistory
+func TestParser_ParseWithdrawHistory(t *testing.T) {
+	bytes := []byte(`[{"id":"b6ae22b3aa844210a7041aee7589627c","amount":"8.91000000","transactionFee":"0.004","coin":"USDT","status":6,"address":"0x94df8b352de7f46f64b01d3666bf6e936e44ce60","txId":"0xb5ef8c13b968a406cc62a93a8bd80f9e9a906ef1b3fcf20a2e48573c17659268","applyTime":"2019-10-12 11:12:02","network":"ETH","transferType":0,"withdrawOrderId":"WITHDRAWtest123","info":"The address is not valid. Please confirm with the recipient","confirmNo":3,"walletType":1,"txKey":""},{"id":"156ec387f49b41df8724fa744fa82719","amount":"0.00150000","transactionFee":"0.004","coin":"BTC","status":6,"address":"1FZdVHtiBqMrWdjPyRPULCUceZPJ2WLCsB","txId":"60fd9007ebfddc753455f95fafa808c4302c836e4d1eebc5a132c36c1d8ac354","applyTime":"2019-09-24 12:43:45","network":"BTC","transferType":0,"info":"","confirmNo":2,"walletType":1,"txKey":""}]`)
+
+	parser := NewParser()
+	results, err := parser.ParseWithdrawHistory(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[0].ApplyTime.UTC().Format("2006-01-02 15:04:05") != "2019-10-12 11:12:02" {
+		t.Errorf("results = %+v", results)
+	}
+}
+
+// AssetDividend
+func TestParser_ParseAssetDividend(t *testing.T) {
+	bytes := []byte(`{"rows":[{"id":1637366104,"amount":"10.00000000","asset":"BHFT","divTime":1563189166000,"enInfo":"BHFT distribution","tranId":2968885920},{"id":1631750237,"amount":"10.00000000","asset":"BHFT","divTime":1563189165000,"enInfo":"BHFT distribution","tranId":2968885920}],"total":2}`)
+
+	parser := NewParser()
+	result, err := parser.ParseAssetDividend(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Total != 2 || len(result.Rows) != 2 {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+// DustLog
+func TestParser_ParseDustLog(t *testing.T) {
+	bytes := []byte(`{"total":8,"userAssetDribblets":[{"operateTime":1615985535000,"totalTransferedAmount":"0.00132256","totalServiceChargeAmount":"0.00002699","transId":45178372831,"userAssetDribbletDetails":[{"transId":4359321,"serviceChargeAmount":"0.000009","amount":"0.0009","operateTime":1615985535000,"transferedAmount":"0.000441","fromAsset":"USDT"},{"transId":4359321,"serviceChargeAmount":"0.00001799","amount":"0.0009","operateTime":1615985535000,"transferedAmount":"0.00088156","fromAsset":"ETH"}]}]}`)
+
+	parser := NewParser()
+	result, err := parser.ParseDustLog(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.UserAssetDribblets) != 1 || len(result.UserAssetDribblets[0].UserAssetDribbletDetails) != 2 {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+// DustTransfer
+func TestParser_ParseDustTransfer(t *testing.T) {
+	bytes := []byte(`{"totalServiceCharge":"0.02102542","totalTransfered":"1.05127099","transferResult":[{"amount":"0.03000000","fromAsset":"ETH","operateTime":1563368549307,"serviceChargeAmount":"0.00500000","tranId":2970932918,"transferedAmount":"0.25000000"},{"amount":"0.09000000","fromAsset":"LTC","operateTime":1563368549404,"serviceChargeAmount":"0.01548000","tranId":2970932918,"transferedAmount":"0.77400000"}]}`)
+
+	parser := NewParser()
+	result, err := parser.ParseDustTransfer(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.TransferResult) != 2 || result.TotalTransfered.String() != "1.05127099" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+// TradeFee
+func TestParser_ParseTradeFee(t *testing.T) {
+	bytes := []byte(`[{"symbol":"ADABNB","makerCommission":"0.001","takerCommission":"0.001"},{"symbol":"BNBBTC","makerCommission":"0.001","takerCommission":"0.001"}]`)
+
+	parser := NewParser()
+	_, err := parser.ParseTradeFee(bytes)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+// UniversalTransfer
+func TestParser_ParseUniversalTransfer(t *testing.T) {
+	bytes := []byte(`{"tranId":13526853623}`)
+
+	parser := NewParser()
+	_, err := parser.ParseUniversalTransfer(bytes)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+// UniversalTransferHistory
+func TestParser_ParseUniversalTransferHistory(t *testing.T) {
+	bytes := []byte(`{"total":2,"rows":[{"asset":"USDT","amount":"1","type":"MAIN_UMFUTURE","status":"CONFIRMED","tranId":11415955596,"timestamp":1544433328000},{"asset":"USDT","amount":"2","type":"MAIN_UMFUTURE","status":"CONFIRMED","tranId":11366865406,"timestamp":1544433328000}]}`)
+
+	parser := NewParser()
+	result, err := parser.ParseUniversalTransferHistory(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Total != 2 || len(result.Rows) != 2 || result.Rows[0].Type != UniversalTransferMainUMFuture {
+		t.Errorf("result = %+v", result)
+	}
+}
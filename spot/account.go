@@ -1,6 +1,8 @@
 package spot
 
 import (
+	"context"
+	"errors"
 	"github.com/NattapornTee22816/binance-connector-golang/model"
 	"net/http"
 )
@@ -11,7 +13,17 @@ import (
 // POST /api/v3/order/test (HMAC SHA256)
 // https://binance-docs.github.io/apidocs/spot/en/#test-new-order-trade
 func (r *API) NewOrderTest(param *model.OrderParam) error {
-	_, err := r.sendRequest(http.MethodPost, "/api/v3/order/test", param, model.EndpointSecurityTypeTrade)
+	return r.NewOrderTestContext(r.ctx, param)
+}
+
+// NewOrderTestContext
+// NewOrderTest with context of the request
+func (r *API) NewOrderTestContext(ctx context.Context, param *model.OrderParam) error {
+	if err := r.validateOrder(param); err != nil {
+		return err
+	}
+
+	_, err := r.sendRequest(ctx, http.MethodPost, "/api/v3/order/test", param, model.EndpointSecurityTypeTrade)
 	return err
 }
 
@@ -20,8 +32,21 @@ func (r *API) NewOrderTest(param *model.OrderParam) error {
 // POST /api/v3/order (HMAC SHA256)
 // https://binance-docs.github.io/apidocs/spot/en/#new-order-trade
 func (r *API) NewOrder(param *model.OrderParam) (*model.Order, error) {
-	bytes, err := r.sendRequest(http.MethodPost, "/api/v3/order", param, model.EndpointSecurityTypeTrade)
+	return r.NewOrderContext(r.ctx, param)
+}
+
+// NewOrderContext
+// NewOrder with context of the request
+func (r *API) NewOrderContext(ctx context.Context, param *model.OrderParam) (*model.Order, error) {
+	if err := r.validateOrder(param); err != nil {
+		return nil, err
+	}
+
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/api/v3/order", param, model.EndpointSecurityTypeTrade)
 	if err != nil {
+		if param != nil && len(param.NewClientOrderId) > 0 && isUnknownExecution(err) {
+			return r.confirmOrder(ctx, param, err)
+		}
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
 		}
@@ -35,7 +60,13 @@ func (r *API) NewOrder(param *model.OrderParam) (*model.Order, error) {
 // Cancel an active order.
 // DELETE /api/v3/order (HMAC SHA256)
 func (r *API) CancelOrder(param *model.CancelOrderParam) (*model.CancelOrder, error) {
-	bytes, err := r.sendRequest(http.MethodDelete, "/api/v3/order", param, model.EndpointSecurityTypeTrade)
+	return r.CancelOrderContext(r.ctx, param)
+}
+
+// CancelOrderContext
+// CancelOrder with context of the request
+func (r *API) CancelOrderContext(ctx context.Context, param *model.CancelOrderParam) (*model.CancelOrder, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodDelete, "/api/v3/order", param, model.EndpointSecurityTypeTrade)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -46,6 +77,44 @@ func (r *API) CancelOrder(param *model.CancelOrderParam) (*model.CancelOrder, er
 	return r.parser.ParseCancelOrder(bytes)
 }
 
+// CancelReplaceOrder
+// Cancel an Existing Order and Send a New Order (TRADE)
+// Cancels an existing order and places a new order on the same symbol.
+// When either of them fails, the error is *CancelReplaceError which holds the result of both.
+// POST /api/v3/order/cancelReplace (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#cancel-an-existing-order-and-send-a-new-order-trade
+func (r *API) CancelReplaceOrder(param *model.CancelReplaceOrderParam) (*model.CancelReplaceOrder, error) {
+	return r.CancelReplaceOrderContext(r.ctx, param)
+}
+
+// CancelReplaceOrderContext
+// CancelReplaceOrder with context of the request
+func (r *API) CancelReplaceOrderContext(ctx context.Context, param *model.CancelReplaceOrderParam) (*model.CancelReplaceOrder, error) {
+	if param != nil {
+		if err := r.validateOrder(&param.OrderParam); err != nil {
+			return nil, err
+		}
+	}
+
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/api/v3/order/cancelReplace", param, model.EndpointSecurityTypeTrade)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		var clientError *ClientError
+		if errors.As(err, &clientError) && (errors.Is(clientError, ErrCancelReplacePartiallyFailed) || errors.Is(clientError, ErrCancelReplaceFailed)) {
+			result, parseErr := r.parser.ParseCancelReplaceOrder(clientError.Body)
+			if parseErr != nil {
+				return nil, err
+			}
+			return result, &CancelReplaceError{ClientError: clientError, Result: result}
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseCancelReplaceOrder(bytes)
+}
+
 // CancelOpenOrder
 // Cancel all Open Orders on a Symbol (TRADE)
 // Cancels all active orders on a symbol.
@@ -53,7 +122,13 @@ func (r *API) CancelOrder(param *model.CancelOrderParam) (*model.CancelOrder, er
 // DELETE /api/v3/openOrders
 // https://binance-docs.github.io/apidocs/spot/en/#cancel-all-open-orders-on-a-symbol-trade
 func (r *API) CancelOpenOrder(param *model.CancelOrderParam) ([]*model.CancelOpenOrder, error) {
-	bytes, err := r.sendRequest(http.MethodDelete, "/api/v3/openOrders", param, model.EndpointSecurityTypeTrade)
+	return r.CancelOpenOrderContext(r.ctx, param)
+}
+
+// CancelOpenOrderContext
+// CancelOpenOrder with context of the request
+func (r *API) CancelOpenOrderContext(ctx context.Context, param *model.CancelOrderParam) ([]*model.CancelOpenOrder, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodDelete, "/api/v3/openOrders", param, model.EndpointSecurityTypeTrade)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -70,7 +145,13 @@ func (r *API) CancelOpenOrder(param *model.CancelOrderParam) ([]*model.CancelOpe
 // GET /api/v3/order (HMAC SHA256)
 // https://binance-docs.github.io/apidocs/spot/en/#query-order-user_data
 func (r *API) GetOrder(param *model.GetOrderParam) (*model.GetOrder, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/order", param, model.EndpointSecurityTypeUserData)
+	return r.GetOrderContext(r.ctx, param)
+}
+
+// GetOrderContext
+// GetOrder with context of the request
+func (r *API) GetOrderContext(ctx context.Context, param *model.GetOrderParam) (*model.GetOrder, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/order", param, model.EndpointSecurityTypeUserData)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -87,7 +168,13 @@ func (r *API) GetOrder(param *model.GetOrderParam) (*model.GetOrder, error) {
 // GET /api/v3/openOrders (HMAC SHA256)
 // https://binance-docs.github.io/apidocs/spot/en/#current-open-orders-user_data
 func (r *API) GetOpenOrders(param *model.GetOpenOrdersParam) ([]*model.GetOrder, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/openOrders", param, model.EndpointSecurityTypeUserData)
+	return r.GetOpenOrdersContext(r.ctx, param)
+}
+
+// GetOpenOrdersContext
+// GetOpenOrders with context of the request
+func (r *API) GetOpenOrdersContext(ctx context.Context, param *model.GetOpenOrdersParam) ([]*model.GetOrder, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/openOrders", param, model.EndpointSecurityTypeUserData)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -104,7 +191,13 @@ func (r *API) GetOpenOrders(param *model.GetOpenOrdersParam) ([]*model.GetOrder,
 // GET /api/v3/allOrders (HMAC SHA256)
 // https://binance-docs.github.io/apidocs/spot/en/#all-orders-user_data
 func (r *API) GetOrders(param *model.GetOrdersParam) ([]*model.GetOrder, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/allOrders", param, model.EndpointSecurityTypeUserData)
+	return r.GetOrdersContext(r.ctx, param)
+}
+
+// GetOrdersContext
+// GetOrders with context of the request
+func (r *API) GetOrdersContext(ctx context.Context, param *model.GetOrdersParam) ([]*model.GetOrder, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/allOrders", param, model.EndpointSecurityTypeUserData)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -121,8 +214,21 @@ func (r *API) GetOrders(param *model.GetOrdersParam) ([]*model.GetOrder, error)
 // POST /api/v3/order/oco (HMAC SHA256)
 // https://binance-docs.github.io/apidocs/spot/en/#new-oco-trade
 func (r *API) NewOcoOrder(param *model.NewOcoOrderParam) (*model.OcoOrder, error) {
-	bytes, err := r.sendRequest(http.MethodPost, "/api/v3/order/oco", param, model.EndpointSecurityTypeTrade)
+	return r.NewOcoOrderContext(r.ctx, param)
+}
+
+// NewOcoOrderContext
+// NewOcoOrder with context of the request
+func (r *API) NewOcoOrderContext(ctx context.Context, param *model.NewOcoOrderParam) (*model.OcoOrder, error) {
+	if err := r.validateOcoOrder(param); err != nil {
+		return nil, err
+	}
+
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/api/v3/order/oco", param, model.EndpointSecurityTypeTrade)
 	if err != nil {
+		if param != nil && len(param.ListClientOrderId) > 0 && isUnknownExecution(err) {
+			return r.confirmOcoOrder(ctx, param, err)
+		}
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
 		}
@@ -138,7 +244,13 @@ func (r *API) NewOcoOrder(param *model.NewOcoOrderParam) (*model.OcoOrder, error
 // DELETE /api/v3/orderList (HMAC SHA256)
 // https://binance-docs.github.io/apidocs/spot/en/#cancel-oco-trade
 func (r *API) CancelOcoOrder(param *model.CancelOcoOrderParam) (*model.CancelOcoOrder, error) {
-	bytes, err := r.sendRequest(http.MethodDelete, "/api/v3/orderList", param, model.EndpointSecurityTypeTrade)
+	return r.CancelOcoOrderContext(r.ctx, param)
+}
+
+// CancelOcoOrderContext
+// CancelOcoOrder with context of the request
+func (r *API) CancelOcoOrderContext(ctx context.Context, param *model.CancelOcoOrderParam) (*model.CancelOcoOrder, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodDelete, "/api/v3/orderList", param, model.EndpointSecurityTypeTrade)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -154,7 +266,13 @@ func (r *API) CancelOcoOrder(param *model.CancelOcoOrderParam) (*model.CancelOco
 // Retrieves a specific OCO based on provided optional parameters
 // GET /api/v3/orderList (HMAC SHA256)
 func (r *API) GetOcoOrder(param *model.GetOcoOrderParam) (*model.GetOcoOrder, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/orderList", param, model.EndpointSecurityTypeUserData)
+	return r.GetOcoOrderContext(r.ctx, param)
+}
+
+// GetOcoOrderContext
+// GetOcoOrder with context of the request
+func (r *API) GetOcoOrderContext(ctx context.Context, param *model.GetOcoOrderParam) (*model.GetOcoOrder, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/orderList", param, model.EndpointSecurityTypeUserData)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -171,7 +289,13 @@ func (r *API) GetOcoOrder(param *model.GetOcoOrderParam) (*model.GetOcoOrder, er
 // GET /api/v3/allOrderList (HMAC SHA256)
 // https://binance-docs.github.io/apidocs/spot/en/#query-all-oco-user_data
 func (r *API) GetOcoOrders(param *model.GetOcoOrdersParam) ([]*model.GetOcoOrder, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/allOrderList", param, model.EndpointSecurityTypeUserData)
+	return r.GetOcoOrdersContext(r.ctx, param)
+}
+
+// GetOcoOrdersContext
+// GetOcoOrders with context of the request
+func (r *API) GetOcoOrdersContext(ctx context.Context, param *model.GetOcoOrdersParam) ([]*model.GetOcoOrder, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/allOrderList", param, model.EndpointSecurityTypeUserData)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -187,7 +311,13 @@ func (r *API) GetOcoOrders(param *model.GetOcoOrdersParam) ([]*model.GetOcoOrder
 // GET /api/v3/openOrderList (HMAC SHA256)
 // https://binance-docs.github.io/apidocs/spot/en/#query-open-oco-user_data
 func (r *API) GetOcoOpenOrders(param *model.GetOcoOpenOrdersParam) ([]*model.GetOcoOrder, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/openOrderList", param, model.EndpointSecurityTypeUserData)
+	return r.GetOcoOpenOrdersContext(r.ctx, param)
+}
+
+// GetOcoOpenOrdersContext
+// GetOcoOpenOrders with context of the request
+func (r *API) GetOcoOpenOrdersContext(ctx context.Context, param *model.GetOcoOpenOrdersParam) ([]*model.GetOcoOrder, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/openOrderList", param, model.EndpointSecurityTypeUserData)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -203,7 +333,13 @@ func (r *API) GetOcoOpenOrders(param *model.GetOcoOpenOrdersParam) ([]*model.Get
 // GET /api/v3/account (HMAC SHA256)
 // https://binance-docs.github.io/apidocs/spot/en/#account-information-user_data
 func (r *API) Account(param *model.AccountParam) (*model.Account, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/account", param, model.EndpointSecurityTypeUserData)
+	return r.AccountContext(r.ctx, param)
+}
+
+// AccountContext
+// Account with context of the request
+func (r *API) AccountContext(ctx context.Context, param *model.AccountParam) (*model.Account, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/account", param, model.EndpointSecurityTypeUserData)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -219,8 +355,14 @@ func (r *API) Account(param *model.AccountParam) (*model.Account, error) {
 // Get trades for a specific account and symbol.
 // GET /api/v3/myTrades (HMAC SHA256)
 // https://binance-docs.github.io/apidocs/spot/en/#account-trade-list-user_data
-func (r *API) MyTrades(param model.MyTradesParam) ([]*model.MyTrade, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/myTrades", param, model.EndpointSecurityTypeUserData)
+func (r *API) MyTrades(param *model.MyTradesParam) ([]*model.MyTrade, error) {
+	return r.MyTradesContext(r.ctx, param)
+}
+
+// MyTradesContext
+// MyTrades with context of the request
+func (r *API) MyTradesContext(ctx context.Context, param *model.MyTradesParam) ([]*model.MyTrade, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/myTrades", param, model.EndpointSecurityTypeUserData)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -237,7 +379,13 @@ func (r *API) MyTrades(param model.MyTradesParam) ([]*model.MyTrade, error) {
 // GET /api/v3/rateLimit/order
 // https://binance-docs.github.io/apidocs/spot/en/#query-current-order-count-usage-trade
 func (r *API) GetOrderRateLimit(param *model.GetOrderRateLimitParam) ([]*model.GetOrderRateLimit, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/rateLimit/order", param, model.EndpointSecurityTypeTrade)
+	return r.GetOrderRateLimitContext(r.ctx, param)
+}
+
+// GetOrderRateLimitContext
+// GetOrderRateLimit with context of the request
+func (r *API) GetOrderRateLimitContext(ctx context.Context, param *model.GetOrderRateLimitParam) ([]*model.GetOrderRateLimit, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/rateLimit/order", param, model.EndpointSecurityTypeTrade)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -247,3 +395,85 @@ func (r *API) GetOrderRateLimit(param *model.GetOrderRateLimitParam) ([]*model.G
 
 	return r.parser.ParseGetOrderRateLimit(bytes)
 }
+
+func (r *API) validateOrder(param *model.OrderParam) error {
+	if r.orderValidator == nil || param == nil {
+		return nil
+	}
+	if err := r.orderValidator.ValidateOrder(param); err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *API) validateOcoOrder(param *model.NewOcoOrderParam) error {
+	if r.orderValidator == nil || param == nil {
+		return nil
+	}
+	if err := r.orderValidator.ValidateOcoOrder(param); err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return err
+	}
+	return nil
+}
+
+// confirmOrder
+// the execution status of NewOrder is unknown (HTTP 5xx or timeout), query the order by client order id.
+// the original error is returned when the order is not found, the order may still be in flight
+// so it is never sent again here, the caller decides whether to resend with the same client order id
+func (r *API) confirmOrder(ctx context.Context, param *model.OrderParam, cause error) (*model.Order, error) {
+	order, err := r.GetOrderContext(ctx, &model.GetOrderParam{
+		Symbol:            param.Symbol,
+		OrigClientOrderId: param.NewClientOrderId,
+	})
+	if err == nil {
+		return &model.Order{
+			Symbol:              order.Symbol,
+			OrderId:             order.OrderId,
+			OrderListId:         order.OrderListId,
+			ClientOrderId:       order.ClientOrderId,
+			TransactTime:        order.UpdateTime,
+			Price:               order.Price,
+			OrigQty:             order.OrigQty,
+			ExecutedQty:         order.ExecutedQty,
+			CummulativeQuoteQty: order.CummulativeQuoteQty,
+			Status:              order.Status,
+			TimeInForce:         order.TimeInForce,
+			Type:                order.Type,
+			Side:                order.Side,
+		}, nil
+	}
+	if r.logger.CanDebug() {
+		r.logger.Error(cause.Error())
+	}
+	return nil, cause
+}
+
+// confirmOcoOrder
+// same as confirmOrder, query the order list by list client order id
+func (r *API) confirmOcoOrder(ctx context.Context, param *model.NewOcoOrderParam, cause error) (*model.OcoOrder, error) {
+	order, err := r.GetOcoOrderContext(ctx, &model.GetOcoOrderParam{
+		OrigClientOrderId: param.ListClientOrderId,
+	})
+	if err == nil {
+		return &model.OcoOrder{
+			OrderListId:       order.OrderListId,
+			ContingencyType:   order.ContingencyType,
+			ListStatusType:    order.ListStatusType,
+			ListOrderStatus:   order.ListOrderStatus,
+			ListClientOrderId: order.ListClientOrderId,
+			TransactionTime:   order.TransactionTime,
+			Symbol:            order.Symbol,
+			Orders:            order.Orders,
+		}, nil
+	}
+	if r.logger.CanDebug() {
+		r.logger.Error(cause.Error())
+	}
+	return nil, cause
+}
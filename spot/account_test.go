@@ -0,0 +1,131 @@
+package spot
+
+import (
+	"errors"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAPI_CancelReplaceOrder(t *testing.T) {
+	var form map[string][]string
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		_ = r.ParseForm()
+		form = r.Form
+		_, _ = w.Write([]byte(`{"cancelResult":"SUCCESS","newOrderResult":"SUCCESS","cancelResponse":{"symbol":"BTCUSDT","orderId":9,"status":"CANCELED"},"newOrderResponse":{"symbol":"BTCUSDT","orderId":10,"status":"NEW"}}`))
+	})
+
+	result, err := api.CancelReplaceOrder(&model.CancelReplaceOrderParam{
+		OrderParam: model.OrderParam{
+			Symbol:      "BTCUSDT",
+			Side:        model.OrderSideBuy,
+			OrderType:   model.OrderTypeLimit,
+			TimeInForce: model.TimeInForceGTG,
+			Quantity:    model.MustDecimal("0.04"),
+			Price:       model.MustDecimal("0.02"),
+		},
+		CancelReplaceMode: model.CancelReplaceModeStopOnFailure,
+		CancelOrderId:     9,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.CancelResponse.OrderId != 9 || result.NewOrderResponse.OrderId != 10 || fake.Calls()[0] != "POST /api/v3/order/cancelReplace" {
+		t.Errorf("result = %+v, calls = %v", result, fake.Calls())
+	}
+	if form["cancelReplaceMode"][0] != "STOP_ON_FAILURE" || form["cancelOrderId"][0] != "9" || form["type"][0] != "LIMIT" || form["price"][0] != "0.02" {
+		t.Errorf("form = %v", form)
+	}
+}
+
+func TestAPI_CancelReplaceOrderPartiallyFailed(t *testing.T) {
+	api, _ := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"code":-2021,"msg":"Order cancel-replace partially failed.","data":{"cancelResult":"FAILURE","newOrderResult":"SUCCESS","cancelResponse":{"code":-2011,"msg":"Unknown order sent."},"newOrderResponse":{"symbol":"BTCUSDT","orderId":11,"orderListId":-1,"clientOrderId":"pfojJMg6IMNDKuJqDxvoxN","transactTime":1648540168818}}}`))
+	})
+
+	result, err := api.CancelReplaceOrder(&model.CancelReplaceOrderParam{
+		OrderParam: model.OrderParam{
+			Symbol:    "BTCUSDT",
+			Side:      model.OrderSideBuy,
+			OrderType: model.OrderTypeMarket,
+			Quantity:  model.MustDecimal("0.04"),
+		},
+		CancelReplaceMode: model.CancelReplaceModeAllowFailure,
+		CancelOrderId:     9,
+	})
+	if !errors.Is(err, ErrCancelReplacePartiallyFailed) {
+		t.Fatalf("err = %v", err)
+	}
+	var cancelReplaceError *CancelReplaceError
+	if !errors.As(err, &cancelReplaceError) || cancelReplaceError.StatusCode != http.StatusConflict {
+		t.Fatalf("err = %v", err)
+	}
+	if result == nil || result.NewOrderResponse == nil || result.NewOrderResponse.OrderId != 11 {
+		t.Errorf("result = %+v", result)
+	}
+	if !errors.Is(cancelReplaceError.CancelError(), ErrUnknownOrder) || cancelReplaceError.NewOrderError() != nil {
+		t.Errorf("cancel error = %v, new order error = %v", cancelReplaceError.CancelError(), cancelReplaceError.NewOrderError())
+	}
+	var clientError *ClientError
+	if !errors.As(err, &clientError) || clientError.ErrorCode != -2021 {
+		t.Errorf("client error = %v", clientError)
+	}
+}
+
+func TestAPI_CancelReplaceOrderAutoRound(t *testing.T) {
+	var form map[string][]string
+	api, _ := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		_ = r.ParseForm()
+		form = r.Form
+		_, _ = w.Write([]byte(`{"cancelResult":"SUCCESS","newOrderResult":"SUCCESS"}`))
+	})
+	api.SetOrderValidator(NewOrderValidator(newTestExchangeInformation(), OrderValidatorConfig{AutoRound: true}))
+
+	param := &model.CancelReplaceOrderParam{
+		OrderParam: model.OrderParam{
+			Symbol:      "ETHBTC",
+			Side:        model.OrderSideBuy,
+			OrderType:   model.OrderTypeLimit,
+			TimeInForce: model.TimeInForceGTG,
+			Quantity:    model.MustDecimal("1.23456"),
+			Price:       model.MustDecimal("0.06500049"),
+		},
+		CancelReplaceMode: model.CancelReplaceModeStopOnFailure,
+		CancelOrderId:     9,
+	}
+	if _, err := api.CancelReplaceOrder(param); err != nil {
+		t.Fatal(err)
+	}
+	if param.Price.Trim().String() != "0.065" || param.Quantity.Trim().String() != "1.234" {
+		t.Errorf("rounded price = %s, quantity = %s", param.Price, param.Quantity)
+	}
+	if len(form["quantity"]) == 0 || form["quantity"][0] != "1.234" {
+		t.Errorf("form = %v", form)
+	}
+}
+
+func TestAPI_MyTrades(t *testing.T) {
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		_, _ = w.Write([]byte(`[{"symbol":"BNBBTC","id":28457,"orderId":100234,"orderListId":-1,"price":"4.00000100","qty":"12.00000000","quoteQty":"48.000012","commission":"10.10000000","commissionAsset":"BNB","time":1499865549590,"isBuyer":true,"isMaker":false,"isBestMatch":true}]`))
+	})
+
+	trades, err := api.MyTrades(&model.MyTradesParam{
+		Symbol:    "BNBBTC",
+		OrderId:   100234,
+		StartTime: time.UnixMilli(1499865549000),
+		Limit:     10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 1 || trades[0].OrderId != 100234 || fake.Calls()[0] != "GET /api/v3/myTrades" {
+		t.Errorf("trades = %v, calls = %v", trades, fake.Calls())
+	}
+	// the filters are sent with the signed request
+	query := fake.Last().URL.Query()
+	if query.Get("symbol") != "BNBBTC" || query.Get("orderId") != "100234" || query.Get("limit") != "10" || query.Get("startTime") == "" || query.Get("signature") == "" {
+		t.Errorf("query = %v", query)
+	}
+}
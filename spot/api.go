@@ -2,19 +2,19 @@ package spot
 
 import (
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/NattapornTee22816/binance-connector-golang/lib"
 	"github.com/NattapornTee22816/binance-connector-golang/model"
-	"github.com/go-playground/validator/v10"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"reflect"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -25,24 +25,55 @@ var (
 type APIConfig struct {
 	// API-key (required)
 	key string `validate:"required"`
-	// API-secret (required)
-	secret string `validate:"required"`
+	// API-secret (required when signer is not set)
+	secret string
+	// signer of SIGNED endpoints (optional).
+	// By default, HMAC SHA256 of API-secret
+	signer lib.Signer
 	// base url (optional): the API base url, useful to switch to testnet, etc.
 	// By default, it's https://api.binance.com
 	baseUrl string
-	// the time waiting for server response, number of seconds.
+	// the time waiting for server response.
 	// By default, no limit
 	timeout time.Duration
 	// Dictionary mapping protocol to the URL of the proxy. e.g. {'https': 'http://1.2.3.4:8080'}
-	proxies map[string]string
+	proxies  map[string]string
+	logLevel lib.LogLevel
+	ctx      context.Context
+	// http client (optional): shared by every request
+	httpClient *http.Client
+	// http transport (optional): used when httpClient is not set
+	transport           http.RoundTripper
+	maxIdleConns        int
+	maxIdleConnsPerHost int
+	idleConnTimeout     time.Duration
+	disableHTTP2        bool
+	tlsConfig           *tls.Config
+	// server time estimation (optional): shared with other clients.
+	// By default, a clock synced by the first SIGNED request and again when it is older than a minute
+	clock *lib.Clock
+	// path of server time endpoint synced by the clock.
+	// By default, /api/v3/time
+	serverTimePath string
 }
 
 type API struct {
 	APIConfig
 	ctx    context.Context
-	offset int64
 	logger *lib.BinanceLogger
 	parser *model.Parser
+	client *http.Client
+	// check orders against symbol filters before sending (optional)
+	orderValidator *OrderValidator
+	// throttle requests before request weight or order count is exceeded (optional)
+	rateLimiter *RateLimiter
+	// resend failed requests (optional)
+	retryPolicy RetryPolicy
+	// clock is created by NewAPI and stopped by Close when it is started
+	ownClock bool
+	banMu    sync.Mutex
+	// bannedTo (time.Time): HTTP 418 ban, requests are refused until then with or without rate limiter
+	bannedTo time.Time
 }
 
 func defaultApiConfig(config *APIConfig) *APIConfig {
@@ -52,88 +83,161 @@ func defaultApiConfig(config *APIConfig) *APIConfig {
 	if config.timeout < 0 {
 		config.timeout = 0
 	}
-	if &config.proxies == nil {
+	if config.proxies == nil {
 		config.proxies = make(map[string]string)
 	}
+	if config.ctx == nil {
+		config.ctx = context.Background()
+	}
+	if len(config.serverTimePath) == 0 {
+		config.serverTimePath = "/api/v3/time"
+	}
 
 	return config
 }
 
-func NewBasicAPI(key string, secret string) (*API, error) {
-	return NewAPI(
-		key,
-		secret,
-		"https://api.binance.com",
-		0,
-		nil,
-		lib.LogLevelDebug,
-		context.Background(),
-	)
+func NewBasicAPI(key string, secret string, opts ...APIOption) (*API, error) {
+	return NewAPI(key, secret, append([]APIOption{WithBaseUrl("https://api.binance.com")}, opts...)...)
 }
 
-func NewTestnetAPI(key, secret string) (*API, error) {
-	return NewAPI(
-		key,
-		secret,
-		"https://testnet.binance.vision",
-		0,
-		nil,
-		lib.LogLevelDebug,
-		context.Background(),
-	)
+func NewTestnetAPI(key, secret string, opts ...APIOption) (*API, error) {
+	return NewAPI(key, secret, append([]APIOption{WithBaseUrl("https://testnet.binance.vision")}, opts...)...)
 }
 
-func NewAPI(
-	key string,
-	secret string,
-	baseUrl string,
-	timeout int,
-	proxies map[string]string,
-	logLevel lib.LogLevel,
-	ctx context.Context,
-) (*API, error) {
+func NewAPI(key string, secret string, opts ...APIOption) (*API, error) {
 	config := &APIConfig{
-		key:     key,
-		secret:  secret,
-		baseUrl: baseUrl,
-		timeout: time.Second * time.Duration(timeout),
-		proxies: proxies,
+		key:      key,
+		secret:   secret,
+		logLevel: lib.LogLevelDebug,
+	}
+	for _, opt := range opts {
+		opt(config)
 	}
 	config = defaultApiConfig(config)
+	if config.signer == nil {
+		if len(config.secret) == 0 {
+			return nil, &ParameterRequiredError{Params: []string{"secret"}}
+		}
+		config.signer = lib.NewHmacSigner(config.secret)
+	}
 
-	validate := validator.New()
+	validate := model.NewValidator()
 	err := validate.Struct(config)
 	if err != nil {
 		return nil, err
 	}
 
-	if ctx == nil {
-		ctx = context.Background()
+	client, err := newHTTPClient(config)
+	if err != nil {
+		return nil, err
 	}
 
 	api := &API{
-		*config,
-		ctx,
-		0,
-		lib.NewLogger("binance-connector", logLevel),
-		model.NewParser(),
+		APIConfig:   *config,
+		ctx:         config.ctx,
+		logger:      lib.NewLogger("binance-connector", config.logLevel),
+		parser:      model.NewParser(),
+		client:      client,
+		rateLimiter: NewRateLimiter(),
+		retryPolicy: NewBackoffRetryPolicy(),
 	}
 
-	serverTime, err := api.CheckServerTime()
-	if err != nil {
-		return nil, err
+	if api.clock == nil {
+		api.clock = lib.NewClock(api.CheckServerTimeContext)
+		api.ownClock = true
 	}
 
-	api.offset = lib.GetTimestamp(0) - serverTime
 	return api, nil
 }
 
+// Context
+// context of the methods without Context suffix
+func (r *API) Context() context.Context {
+	return r.ctx
+}
+
+// Request
+// send a request to any endpoint of the base url through signing, rate limit and retry,
+// e.g. an endpoint not covered by this package. payload is a pointer to struct with param tags or nil
+func (r *API) Request(ctx context.Context, httpMethod string, urlPath string, payload interface{}, securityType model.EndpointSecurityType) ([]byte, error) {
+	return r.sendRequest(ctx, httpMethod, urlPath, payload, securityType)
+}
+
+// Logger
+// logger of the requests, shared with clients built on this API, e.g. futures
+func (r *API) Logger() *lib.BinanceLogger {
+	return r.logger
+}
+
+// Clock
+// server time estimation used to sign requests, can be shared with websocket clients.
+// The clock is synced on demand by SIGNED requests, Clock().Start() syncs it in background instead
+func (r *API) Clock() *lib.Clock {
+	return r.clock
+}
+
+// Close
+// stop the clock created by NewAPI and close idle connections
+func (r *API) Close() {
+	if r.ownClock {
+		r.clock.Close()
+	}
+	r.client.CloseIdleConnections()
+}
+
+// syncClock
+// sync the clock before the first SIGNED request and when it is stale,
+// the previous offset is kept when a later sync fails
+func (r *API) syncClock(ctx context.Context) error {
+	err := r.clock.SyncIfStale(ctx)
+	if err == nil || r.clock.SyncedAt().IsZero() {
+		return err
+	}
+	r.logger.Error(fmt.Sprintf("sync server time error: %s", err.Error()))
+	return nil
+}
+
+// SetOrderValidator
+// validate NewOrder, NewOrderTest and NewOcoOrder locally, nil disables the validation
+func (r *API) SetOrderValidator(validator *OrderValidator) {
+	r.orderValidator = validator
+}
+
+// SetRateLimiter
+// replace the default limiter, nil disables the throttling
+func (r *API) SetRateLimiter(limiter *RateLimiter) {
+	r.rateLimiter = limiter
+}
+
+// RateLimitUsage
+// used request weight and order count of each limit, nil when rate limiter is disabled
+func (r *API) RateLimitUsage() []*RateLimitUsage {
+	if r.rateLimiter == nil {
+		return nil
+	}
+	return r.rateLimiter.Usage()
+}
+
+// SetRetryPolicy
+// replace the default BackoffRetryPolicy, nil disables the retry
+func (r *API) SetRetryPolicy(policy RetryPolicy) {
+	r.retryPolicy = policy
+}
+
 // prepareParameters
-// check zero value and required field
+// check zero value and required field.
+// tag option of []string: "comma" joins into one value, e.g. param:"symbols,comma",
+// "json" encodes as JSON array, e.g. param:"symbols,json".
+// A zero model.Decimal is skipped as not set, an explicit "0" can not be sent
 func (r *API) prepareParameters(params interface{}) url.Values {
 	out := url.Values{}
+	r.appendParameters(out, reflect.ValueOf(params).Elem())
+	return out
+}
 
-	iVal := reflect.ValueOf(params).Elem()
+// appendParameters
+// fields of embedded struct are parameters of the outer struct, e.g. OrderParam of MarginOrderParam
+func (r *API) appendParameters(out url.Values, iVal reflect.Value) {
 	typ := iVal.Type()
 	for i := 0; i < iVal.NumField(); i++ {
 		f := iVal.Field(i)
@@ -142,19 +246,46 @@ func (r *API) prepareParameters(params interface{}) url.Values {
 		}
 
 		field := typ.Field(i)
+		if field.Anonymous && f.Kind() == reflect.Struct {
+			r.appendParameters(out, f)
+			continue
+		}
 		if keyName, ok := field.Tag.Lookup("param"); ok {
+			keyName, option, _ := strings.Cut(keyName, ",")
 			var v string
 			switch f.Interface().(type) {
 			case string:
 				v = f.String()
 			case int, int8, int16, int32, int64:
-				v = strconv.FormatInt(f.Int(), 64)
+				v = strconv.FormatInt(f.Int(), 10)
 			case float32:
 				v = strconv.FormatFloat(f.Float(), 'f', -1, 32)
 			case float64:
 				v = strconv.FormatFloat(f.Float(), 'f', -1, 64)
+			case bool:
+				v = strconv.FormatBool(f.Bool())
+			case []string:
+				items := f.Interface().([]string)
+				if option == "comma" {
+					// one value, e.g. symbols=BTCUSDT,BNBUSDT
+					v = strings.Join(items, ",")
+				} else if option == "json" {
+					// one value, e.g. symbols=["BTCUSDT","BNBUSDT"]
+					if b, err := json.Marshal(items); err == nil {
+						v = string(b)
+					}
+				} else {
+					// repeated key, e.g. asset=BTC&asset=USDT
+					for _, item := range items {
+						out.Add(keyName, item)
+					}
+				}
+			case model.Decimal:
+				if d := f.Interface().(model.Decimal); !d.IsZero() {
+					v = d.Trim().String()
+				}
 			case time.Time:
-				v = strconv.FormatInt(f.Interface().(time.Time).UnixMilli()-r.offset, 10)
+				v = strconv.FormatInt(r.clock.ConvertTimeToInt(f.Interface().(time.Time)), 10)
 			default:
 				r.logger.Warn(fmt.Sprintf("parameter '%s' type not support", keyName))
 			}
@@ -164,8 +295,6 @@ func (r *API) prepareParameters(params interface{}) url.Values {
 			}
 		}
 	}
-
-	return out
 }
 
 func convertEndpointSecurityType(securityType model.EndpointSecurityType) (bool, bool) {
@@ -181,13 +310,72 @@ func convertEndpointSecurityType(securityType model.EndpointSecurityType) (bool,
 	return apiKey, signature
 }
 
-func (r *API) sign(payload string) string {
-	mac := hmac.New(sha256.New, []byte(r.secret))
-	mac.Write([]byte(payload))
-	return hex.EncodeToString(mac.Sum(nil))
+func (r *API) sendRequest(ctx context.Context, httpMethod string, urlPath string, payload interface{}, securityType model.EndpointSecurityType) ([]byte, error) {
+	for attempt := 1; ; attempt++ {
+		bytes, err := r.doRequest(ctx, httpMethod, urlPath, payload, securityType)
+		if err == nil {
+			return bytes, nil
+		}
+
+		var clientError *ClientError
+		if errors.As(err, &clientError) && clientError.StatusCode == http.StatusTeapot {
+			retryAfter, ok := parseRetryAfter(clientError.Header)
+			if !ok {
+				retryAfter = 2 * time.Minute
+			}
+			r.ban(retryAfter)
+		}
+
+		if r.retryPolicy == nil {
+			return nil, err
+		}
+		delay, ok := r.retryPolicy.Retry(&RetryRequest{HttpMethod: httpMethod, UrlPath: urlPath, Attempt: attempt}, err)
+		if !ok {
+			return nil, err
+		}
+
+		if r.logger.CanDebug() {
+			r.logger.Debug(fmt.Sprintf("[retry] %s %s attempt %d after %v: %s", httpMethod, urlPath, attempt+1, delay, err.Error()))
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// ban
+// refuse requests until retryAfter is passed, the rate limiter is banned as well
+func (r *API) ban(retryAfter time.Duration) {
+	r.banMu.Lock()
+	if until := time.Now().Add(retryAfter); until.After(r.bannedTo) {
+		r.bannedTo = until
+	}
+	r.banMu.Unlock()
+
+	if r.rateLimiter != nil {
+		r.rateLimiter.Ban(retryAfter)
+	}
+}
+
+// checkBan
+// *RateLimitError while the IP is banned by server
+func (r *API) checkBan() error {
+	r.banMu.Lock()
+	defer r.banMu.Unlock()
+
+	if now := time.Now(); now.Before(r.bannedTo) {
+		return &RateLimitError{
+			RateLimitType: model.RateLimiterWeight,
+			RetryAfter:    r.bannedTo.Sub(now),
+			Banned:        true,
+		}
+	}
+	return nil
 }
 
-func (r *API) sendRequest(httpMethod string, urlPath string, payload interface{}, securityType model.EndpointSecurityType) ([]byte, error) {
+func (r *API) doRequest(ctx context.Context, httpMethod string, urlPath string, payload interface{}, securityType model.EndpointSecurityType) ([]byte, error) {
 	defer func() {
 		_ = r.logger.Sync()
 	}()
@@ -196,7 +384,7 @@ func (r *API) sendRequest(httpMethod string, urlPath string, payload interface{}
 	queryString := ""
 	params := url.Values{}
 	if payload != nil && (reflect.ValueOf(payload).Kind() == reflect.Ptr && !reflect.ValueOf(payload).IsNil()) {
-		validate := validator.New()
+		validate := model.NewValidator()
 		if err := validate.Struct(payload); err != nil {
 			if r.logger.CanDebug() {
 				r.logger.Error(err.Error())
@@ -207,10 +395,43 @@ func (r *API) sendRequest(httpMethod string, urlPath string, payload interface{}
 		params = r.prepareParameters(payload)
 	}
 
+	if err := r.checkBan(); err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	if signature {
+		if err := r.syncClock(ctx); err != nil {
+			if r.logger.CanDebug() {
+				r.logger.Error(err.Error())
+			}
+			return nil, err
+		}
+	}
+
+	// timestamp is taken after waiting for the limiter, otherwise it may be out of recvWindow
+	if r.rateLimiter != nil {
+		if err := r.rateLimiter.AcquireEndpoint(ctx, httpMethod, urlPath, params); err != nil {
+			if r.logger.CanDebug() {
+				r.logger.Error(err.Error())
+			}
+			return nil, err
+		}
+	}
+
 	if signature {
-		params.Add("timestamp", strconv.FormatInt(lib.GetTimestamp(0), 10))
+		params.Add("timestamp", strconv.FormatInt(r.clock.Timestamp(), 10))
 		queryString = params.Encode()
-		queryString = fmt.Sprintf("%s&signature=%s", params.Encode(), r.sign(queryString))
+		signature, err := r.signer.Sign(queryString)
+		if err != nil {
+			if r.logger.CanDebug() {
+				r.logger.Error(err.Error())
+			}
+			return nil, err
+		}
+		queryString = fmt.Sprintf("%s&signature=%s", queryString, url.QueryEscape(signature))
 	} else if len(params) > 0 {
 		queryString = params.Encode()
 	}
@@ -223,15 +444,21 @@ func (r *API) sendRequest(httpMethod string, urlPath string, payload interface{}
 	}
 
 	endpoint := fmt.Sprintf("%s%s", r.baseUrl, urlPath)
-	response, err := r.dispatchRequest(httpMethod, endpoint, header, queryString)
+	response, err := r.dispatchRequest(ctx, httpMethod, endpoint, header, queryString)
 	if err != nil {
 		return nil, err
 	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
 
 	byteArray, err := ioutil.ReadAll(response.Body)
 	if err != nil {
 		return nil, err
 	}
+	if r.rateLimiter != nil {
+		r.rateLimiter.Update(response.Header)
+	}
 
 	if r.logger.CanDebug() {
 		r.logger.Debug(fmt.Sprintf("[res] status %v", response.Status))
@@ -248,27 +475,12 @@ func (r *API) sendRequest(httpMethod string, urlPath string, payload interface{}
 	return byteArray, nil
 }
 
-func (r *API) dispatchRequest(httpMethod string, endpoint string, header http.Header, payload string) (*http.Response, error) {
-	transport := &http.Transport{}
-	if proxy, ok := r.proxies["https"]; ok {
-		urlProxy, err := url.Parse(proxy)
-		if err != nil {
-			return nil, err
-		}
-		transport.Proxy = http.ProxyURL(urlProxy)
-	}
-
-	client := &http.Client{
-		Transport: transport,
-		Timeout:   r.timeout,
-	}
-
-	req, err := http.NewRequest(httpMethod, endpoint, nil)
+func (r *API) dispatchRequest(ctx context.Context, httpMethod string, endpoint string, header http.Header, payload string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, httpMethod, endpoint, nil)
 	if err != nil {
 		r.logger.Error("unable to request request")
 		return nil, err
 	}
-	req.WithContext(r.ctx)
 
 	if len(header) > 0 {
 		req.Header = header
@@ -284,7 +496,7 @@ func (r *API) dispatchRequest(httpMethod string, endpoint string, header http.He
 		r.logger.Debug(fmt.Sprintf("[req][header] %v", req.Header))
 	}
 
-	return client.Do(req)
+	return r.client.Do(req)
 }
 
 func (r *API) handleException(response *http.Response, body []byte) error {
@@ -300,12 +512,13 @@ func (r *API) handleException(response *http.Response, body []byte) error {
 		}
 
 		binanceError := new(BinanceError)
-		if err := json.Unmarshal(body, &binanceError); err != nil {
+		if err := json.Unmarshal(body, &binanceError); err == nil {
 			return &ClientError{
 				StatusCode:   int64(statusCode),
 				ErrorCode:    binanceError.Code,
 				ErrorMessage: binanceError.Msg,
 				Header:       response.Header,
+				Body:         body,
 			}
 		} else {
 			return &ClientError{
@@ -313,6 +526,7 @@ func (r *API) handleException(response *http.Response, body []byte) error {
 				ErrorCode:    0,
 				ErrorMessage: string(body),
 				Header:       response.Header,
+				Body:         body,
 			}
 		}
 	}
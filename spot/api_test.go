@@ -0,0 +1,137 @@
+package spot
+
+import (
+	"context"
+	"errors"
+	"github.com/NattapornTee22816/binance-connector-golang/internal/apitest"
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func newTestAPI(t *testing.T, handler func(w http.ResponseWriter, r *http.Request, n int), opts ...APIOption) (*API, *apitest.Server) {
+	fake := apitest.NewServer(t, "/api/v3/time", handler)
+	api, err := NewAPI("key", "secret", append([]APIOption{WithBaseUrl(fake.URL), WithLogLevel(lib.LogLevelInfo)}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(api.Close)
+	api.SetRetryPolicy(&BackoffRetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond})
+	return api, fake
+}
+
+func TestAPI_Context(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := api.OrderBookContext(ctx, &model.OrderBookParam{Symbol: "BTCUSDT"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("request is not cancelled, elapsed %v", elapsed)
+	}
+	if calls := fake.Calls(); len(calls) != 1 {
+		t.Errorf("calls = %v", calls)
+	}
+}
+
+func TestAPI_SignedTimestamp(t *testing.T) {
+	var timestamp string
+	api, _ := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		timestamp = r.URL.Query().Get("timestamp")
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	if err := api.NewOrderTest(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if ts, _ := strconv.ParseInt(timestamp, 10, 64); ts < apitest.ServerTime || ts > apitest.ServerTime+int64(time.Minute/time.Millisecond) {
+		t.Errorf("timestamp = %s is not corrected by server time", timestamp)
+	}
+}
+
+func TestAPI_LazyClockSync(t *testing.T) {
+	// server is not reachable, NewAPI does not sync the clock
+	api, err := NewAPI("key", "secret", WithBaseUrl("http://127.0.0.1:1"), WithLogLevel(lib.LogLevelInfo))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer api.Close()
+	if !api.Clock().SyncedAt().IsZero() {
+		t.Error("clock should not be synced")
+	}
+
+	// the first SIGNED request fails when the clock can not be synced
+	if err = api.NewOrderTest(nil); err == nil {
+		t.Error("error is expected")
+	}
+}
+
+func TestAPI_PrepareParametersStrings(t *testing.T) {
+	api, _ := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {})
+
+	params := api.prepareParameters(&model.ExchangeInformationParam{Symbols: []string{"BTCUSDT", "BNBBTC"}})
+	if v := params.Get("symbols"); v != `["BTCUSDT","BNBBTC"]` {
+		t.Errorf("symbols = %s", v)
+	}
+	params = api.prepareParameters(&model.IsolatedMarginAccountParam{Symbols: []string{"BTCUSDT", "BNBBTC"}})
+	if v := params.Get("symbols"); v != "BTCUSDT,BNBBTC" {
+		t.Errorf("symbols = %s", v)
+	}
+	params = api.prepareParameters(&model.DustTransferParam{Asset: []string{"BTC", "USDT"}})
+	if v := params["asset"]; len(v) != 2 {
+		t.Errorf("asset = %v", v)
+	}
+}
+
+type recordSigner struct {
+	signedAt time.Time
+}
+
+func (s *recordSigner) Sign(_ string) (string, error) {
+	s.signedAt = time.Now()
+	return "signature", nil
+}
+
+func TestAPI_SignAfterRateLimit(t *testing.T) {
+	signer := new(recordSigner)
+	api, _ := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		_, _ = w.Write([]byte(`{}`))
+	}, WithSigner(signer))
+
+	now := time.Now()
+	limiter := newTestRateLimiter(RateLimitModeBlock, &now)
+	var wokeAt time.Time
+	limiter.timeSleep = func(_ context.Context, d time.Duration) error {
+		time.Sleep(20 * time.Millisecond)
+		wokeAt = time.Now()
+		now = now.Add(d)
+		return nil
+	}
+	// request weight of the window is used up
+	limiter.Update(http.Header{"X-Mbx-Used-Weight-1m": {"20"}})
+	api.SetRateLimiter(limiter)
+
+	if err := api.NewOrderTest(nil); err != nil {
+		t.Fatal(err)
+	}
+	if wokeAt.IsZero() || signer.signedAt.Before(wokeAt) {
+		t.Errorf("signed at %v before the limiter woke at %v", signer.signedAt, wokeAt)
+	}
+}
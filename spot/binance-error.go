@@ -2,8 +2,10 @@ package spot
 
 import (
 	"fmt"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
 	"net/http"
 	"strings"
+	"time"
 )
 
 type ClientError struct {
@@ -15,12 +17,107 @@ type ClientError struct {
 	ErrorMessage string
 	// the whole response header returned from server
 	Header http.Header
+	// the whole response body returned from server
+	Body []byte
 }
 
 func (e *ClientError) Error() string {
 	return fmt.Sprintf("client error::status code: %d, error code: %d, message: %s", e.StatusCode, e.ErrorCode, e.ErrorMessage)
 }
 
+// Code
+// documented error of the response, nil when it is not documented
+func (e *ClientError) Code() *ErrorCode {
+	return LookupErrorCode(e.ErrorCode, e.ErrorMessage)
+}
+
+// Category
+// By default, ErrorCategoryGeneral
+func (e *ClientError) Category() ErrorCategory {
+	if code := e.Code(); code != nil {
+		return code.Category
+	}
+	if e.StatusCode == http.StatusTooManyRequests || e.StatusCode == http.StatusTeapot {
+		return ErrorCategoryRateLimit
+	}
+	return ErrorCategoryGeneral
+}
+
+// Retryable
+// HTTP 429 or a retryable documented error, HTTP 418 is never retryable
+func (e *ClientError) Retryable() bool {
+	if e.StatusCode == http.StatusTeapot {
+		return false
+	}
+	if e.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if code := e.Code(); code != nil {
+		return code.Retryable
+	}
+	return false
+}
+
+// Is
+// match *ErrorCode by code, or by message when the target has message, and ErrorCategory by Category,
+// e.g. errors.Is(err, ErrNewOrderRejected) and errors.Is(err, ErrInsufficientBalance) are both true
+func (e *ClientError) Is(target error) bool {
+	switch t := target.(type) {
+	case *ErrorCode:
+		return t.MatchCode(e.ErrorCode, e.ErrorMessage)
+	case ErrorCategory:
+		return t == e.Category()
+	}
+	return false
+}
+
+func (e *ClientError) Unwrap() error {
+	if code := e.Code(); code != nil {
+		return code
+	}
+	return nil
+}
+
+// CancelReplaceError
+// cancel-replace order failed partially (-2021, HTTP 409) or completely (-2022, HTTP 400),
+// Result holds the cancel and the new order results with their error payloads
+type CancelReplaceError struct {
+	*ClientError
+	Result *model.CancelReplaceOrder
+}
+
+func (e *CancelReplaceError) Error() string {
+	return fmt.Sprintf("%s, cancel: %s, new order: %s", e.ClientError.Error(), e.Result.CancelResult, e.Result.NewOrderResult)
+}
+
+// CancelError
+// error of the cancel, nil when it succeeded, e.g. errors.Is(e.CancelError(), ErrUnknownOrder)
+func (e *CancelReplaceError) CancelError() *ClientError {
+	return e.responseError(e.Result.CancelError)
+}
+
+// NewOrderError
+// error of the new order, nil when it succeeded or was not attempted
+func (e *CancelReplaceError) NewOrderError() *ClientError {
+	return e.responseError(e.Result.NewOrderError)
+}
+
+func (e *CancelReplaceError) responseError(response *model.ErrorResponse) *ClientError {
+	if response == nil {
+		return nil
+	}
+	return &ClientError{
+		StatusCode:   e.StatusCode,
+		ErrorCode:    response.Code,
+		ErrorMessage: response.Msg,
+		Header:       e.Header,
+	}
+}
+
+func (e *CancelReplaceError) Unwrap() error {
+	return e.ClientError
+}
+
 type ServerError struct {
 	StatusCode int64
 	Message    string
@@ -30,6 +127,12 @@ func (e *ServerError) Error() string {
 	return fmt.Sprintf("server error::status code: %d, message: %s", e.StatusCode, e.Message)
 }
 
+// Is
+// HTTP 5xx is ErrorCategoryServer, e.g. errors.Is(err, ErrorCategoryServer)
+func (e *ServerError) Is(target error) bool {
+	return target == ErrorCategoryServer
+}
+
 type ParameterRequiredError struct {
 	// Params ([]string): key of parameter
 	Params []string
@@ -55,3 +158,40 @@ type ParameterArgumentError struct {
 func (e *ParameterArgumentError) Error() string {
 	return e.ErrorMessage
 }
+
+type FilterError struct {
+	// Symbol of the rejected order
+	Symbol string
+	// FilterType (string): violated filter, e.g. PRICE_FILTER, LOT_SIZE
+	FilterType string
+	// Param (string): key of parameter which violates the filter
+	Param string
+	// Value (string): rejected value
+	Value string
+	// Reason (string): rule of the filter
+	Reason string
+}
+
+func (e *FilterError) Error() string {
+	return fmt.Sprintf("filter failure::symbol: %s, filter: %s, %s %s %s", e.Symbol, e.FilterType, e.Param, e.Value, e.Reason)
+}
+
+type RateLimitError struct {
+	// RateLimitType (string): REQUEST_WEIGHT, ORDERS or RAW_REQUESTS
+	RateLimitType string
+	Interval      string
+	IntervalNum   int64
+	Limit         int64
+	Used          int64
+	// RetryAfter (time.Duration): time until the window is reset
+	RetryAfter time.Duration
+	// Banned (bool): IP is banned by server (HTTP 418)
+	Banned bool
+}
+
+func (e *RateLimitError) Error() string {
+	if e.Banned {
+		return fmt.Sprintf("rate limit error::banned by server, retry after %v", e.RetryAfter)
+	}
+	return fmt.Sprintf("rate limit error::%s %d/%d per %d %s, retry after %v", e.RateLimitType, e.Used, e.Limit, e.IntervalNum, e.Interval, e.RetryAfter)
+}
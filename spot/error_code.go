@@ -0,0 +1,238 @@
+package spot
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// ErrorCategory
+// group of error codes, can be used as target of errors.Is
+type ErrorCategory string
+
+func (c ErrorCategory) Error() string {
+	return string(c)
+}
+
+var (
+	ErrorCategoryGeneral            = ErrorCategory("GENERAL")
+	ErrorCategoryServer             = ErrorCategory("SERVER")
+	ErrorCategoryAuth               = ErrorCategory("AUTH")
+	ErrorCategoryRateLimit          = ErrorCategory("RATE_LIMIT")
+	ErrorCategoryTimestamp          = ErrorCategory("TIMESTAMP")
+	ErrorCategoryRequest            = ErrorCategory("REQUEST")
+	ErrorCategoryFilter             = ErrorCategory("FILTER")
+	ErrorCategoryInsufficientFunds  = ErrorCategory("INSUFFICIENT_FUNDS")
+	ErrorCategoryUnknownOrder       = ErrorCategory("UNKNOWN_ORDER")
+	ErrorCategoryOrderRejected      = ErrorCategory("ORDER_REJECTED")
+	ErrorCategoryUnsupportedRequest = ErrorCategory("UNSUPPORTED")
+)
+
+// ErrorCode
+// documented error of server. Message is set for order reject reasons, which share the same code
+// https://binance-docs.github.io/apidocs/spot/en/#error-codes
+type ErrorCode struct {
+	Code     int64
+	Name     string
+	Message  string
+	Category ErrorCategory
+	// Retryable (bool): the same request may succeed later
+	Retryable bool
+}
+
+func (e *ErrorCode) Error() string {
+	if len(e.Message) > 0 {
+		return fmt.Sprintf("binance error::code: %d, %s", e.Code, e.Message)
+	}
+	return fmt.Sprintf("binance error::code: %d, %s", e.Code, e.Name)
+}
+
+// 10xx - General Server or Network issues
+var (
+	ErrUnknown                 = &ErrorCode{Code: -1000, Name: "UNKNOWN", Category: ErrorCategoryServer}
+	ErrDisconnected            = &ErrorCode{Code: -1001, Name: "DISCONNECTED", Category: ErrorCategoryServer, Retryable: true}
+	ErrUnauthorized            = &ErrorCode{Code: -1002, Name: "UNAUTHORIZED", Category: ErrorCategoryAuth}
+	ErrTooManyRequests         = &ErrorCode{Code: -1003, Name: "TOO_MANY_REQUESTS", Category: ErrorCategoryRateLimit, Retryable: true}
+	ErrUnexpectedResponse      = &ErrorCode{Code: -1006, Name: "UNEXPECTED_RESP", Category: ErrorCategoryServer, Retryable: true}
+	ErrTimeout                 = &ErrorCode{Code: -1007, Name: "TIMEOUT", Category: ErrorCategoryServer, Retryable: true}
+	ErrServerBusy              = &ErrorCode{Code: -1008, Name: "SERVER_BUSY", Category: ErrorCategoryServer, Retryable: true}
+	ErrErrorMsgReceived        = &ErrorCode{Code: -1010, Name: "ERROR_MSG_RECEIVED", Category: ErrorCategoryOrderRejected}
+	ErrFilterFailure           = &ErrorCode{Code: -1013, Name: "FILTER_FAILURE", Category: ErrorCategoryFilter}
+	ErrUnknownOrderComposition = &ErrorCode{Code: -1014, Name: "UNKNOWN_ORDER_COMPOSITION", Category: ErrorCategoryUnsupportedRequest}
+	ErrTooManyOrders           = &ErrorCode{Code: -1015, Name: "TOO_MANY_ORDERS", Category: ErrorCategoryRateLimit, Retryable: true}
+	ErrServiceShuttingDown     = &ErrorCode{Code: -1016, Name: "SERVICE_SHUTTING_DOWN", Category: ErrorCategoryServer, Retryable: true}
+	ErrUnsupportedOperation    = &ErrorCode{Code: -1020, Name: "UNSUPPORTED_OPERATION", Category: ErrorCategoryUnsupportedRequest}
+	ErrInvalidTimestamp        = &ErrorCode{Code: -1021, Name: "INVALID_TIMESTAMP", Category: ErrorCategoryTimestamp, Retryable: true}
+	ErrInvalidSignature        = &ErrorCode{Code: -1022, Name: "INVALID_SIGNATURE", Category: ErrorCategoryAuth}
+)
+
+// 11xx - Request issues
+var (
+	ErrIllegalChars           = &ErrorCode{Code: -1100, Name: "ILLEGAL_CHARS", Category: ErrorCategoryRequest}
+	ErrTooManyParameters      = &ErrorCode{Code: -1101, Name: "TOO_MANY_PARAMETERS", Category: ErrorCategoryRequest}
+	ErrMandatoryParamEmpty    = &ErrorCode{Code: -1102, Name: "MANDATORY_PARAM_EMPTY_OR_MALFORMED", Category: ErrorCategoryRequest}
+	ErrUnknownParam           = &ErrorCode{Code: -1103, Name: "UNKNOWN_PARAM", Category: ErrorCategoryRequest}
+	ErrUnreadParameters       = &ErrorCode{Code: -1104, Name: "UNREAD_PARAMETERS", Category: ErrorCategoryRequest}
+	ErrParamEmpty             = &ErrorCode{Code: -1105, Name: "PARAM_EMPTY", Category: ErrorCategoryRequest}
+	ErrParamNotRequired       = &ErrorCode{Code: -1106, Name: "PARAM_NOT_REQUIRED", Category: ErrorCategoryRequest}
+	ErrBadPrecision           = &ErrorCode{Code: -1111, Name: "BAD_PRECISION", Category: ErrorCategoryFilter}
+	ErrNoDepth                = &ErrorCode{Code: -1112, Name: "NO_DEPTH", Category: ErrorCategoryOrderRejected}
+	ErrTifNotRequired         = &ErrorCode{Code: -1114, Name: "TIF_NOT_REQUIRED", Category: ErrorCategoryRequest}
+	ErrInvalidTif             = &ErrorCode{Code: -1115, Name: "INVALID_TIF", Category: ErrorCategoryRequest}
+	ErrInvalidOrderType       = &ErrorCode{Code: -1116, Name: "INVALID_ORDER_TYPE", Category: ErrorCategoryRequest}
+	ErrInvalidSide            = &ErrorCode{Code: -1117, Name: "INVALID_SIDE", Category: ErrorCategoryRequest}
+	ErrEmptyNewClientOrderId  = &ErrorCode{Code: -1118, Name: "EMPTY_NEW_CL_ORD_ID", Category: ErrorCategoryRequest}
+	ErrEmptyOrigClientOrderId = &ErrorCode{Code: -1119, Name: "EMPTY_ORG_CL_ORD_ID", Category: ErrorCategoryRequest}
+	ErrBadInterval            = &ErrorCode{Code: -1120, Name: "BAD_INTERVAL", Category: ErrorCategoryRequest}
+	ErrBadSymbol              = &ErrorCode{Code: -1121, Name: "BAD_SYMBOL", Category: ErrorCategoryRequest}
+	ErrInvalidListenKey       = &ErrorCode{Code: -1125, Name: "INVALID_LISTEN_KEY", Category: ErrorCategoryAuth}
+	ErrMoreThanXxHours        = &ErrorCode{Code: -1127, Name: "MORE_THAN_XX_HOURS", Category: ErrorCategoryRequest}
+	ErrOptionalParamsBadCombo = &ErrorCode{Code: -1128, Name: "OPTIONAL_PARAMS_BAD_COMBO", Category: ErrorCategoryRequest}
+	ErrInvalidParameter       = &ErrorCode{Code: -1130, Name: "INVALID_PARAMETER", Category: ErrorCategoryRequest}
+	ErrBadRecvWindow          = &ErrorCode{Code: -1131, Name: "BAD_RECV_WINDOW", Category: ErrorCategoryRequest}
+)
+
+// 20xx - Processing Issues
+var (
+	ErrNewOrderRejected = &ErrorCode{Code: -2010, Name: "NEW_ORDER_REJECTED", Category: ErrorCategoryOrderRejected}
+	ErrCancelRejected   = &ErrorCode{Code: -2011, Name: "CANCEL_REJECTED", Category: ErrorCategoryOrderRejected}
+	ErrNoSuchOrder      = &ErrorCode{Code: -2013, Name: "NO_SUCH_ORDER", Category: ErrorCategoryUnknownOrder}
+	ErrBadApiKeyFormat  = &ErrorCode{Code: -2014, Name: "BAD_API_KEY_FMT", Category: ErrorCategoryAuth}
+	ErrRejectedMbxKey   = &ErrorCode{Code: -2015, Name: "REJECTED_MBX_KEY", Category: ErrorCategoryAuth}
+	// ErrCancelReplacePartiallyFailed
+	// either the cancel or the new order of a cancel-replace failed, see CancelReplaceError
+	ErrCancelReplacePartiallyFailed = &ErrorCode{Code: -2021, Name: "ORDER_CANCEL_REPLACE_PARTIALLY_FAILED", Category: ErrorCategoryOrderRejected}
+	// ErrCancelReplaceFailed
+	// both the cancel and the new order of a cancel-replace failed, see CancelReplaceError
+	ErrCancelReplaceFailed = &ErrorCode{Code: -2022, Name: "ORDER_CANCEL_REPLACE_FAILED", Category: ErrorCategoryOrderRejected}
+)
+
+// Messages for -1010 ERROR_MSG_RECEIVED, -2010 NEW_ORDER_REJECTED, and -2011 CANCEL_REJECTED
+// https://binance-docs.github.io/apidocs/spot/en/#messages-for-1010-error_msg_received-2010-new_order_rejected-and-2011-cancel_rejected
+var (
+	ErrUnknownOrder                = &ErrorCode{Code: -2011, Name: "UNKNOWN_ORDER", Message: "Unknown order sent.", Category: ErrorCategoryUnknownOrder}
+	ErrDuplicateOrder              = &ErrorCode{Code: -2010, Name: "DUPLICATE_ORDER", Message: "Duplicate order sent.", Category: ErrorCategoryOrderRejected}
+	ErrMarketClosed                = &ErrorCode{Code: -2010, Name: "MARKET_CLOSED", Message: "Market is closed.", Category: ErrorCategoryOrderRejected}
+	ErrInsufficientBalance         = &ErrorCode{Code: -2010, Name: "INSUFFICIENT_BALANCE", Message: "Account has insufficient balance for requested action.", Category: ErrorCategoryInsufficientFunds}
+	ErrMarketOrderNotSupported     = &ErrorCode{Code: -2010, Name: "MARKET_ORDER_NOT_SUPPORTED", Message: "Market orders are not supported for this symbol.", Category: ErrorCategoryUnsupportedRequest}
+	ErrIcebergNotSupported         = &ErrorCode{Code: -2010, Name: "ICEBERG_NOT_SUPPORTED", Message: "Iceberg orders are not supported for this symbol.", Category: ErrorCategoryUnsupportedRequest}
+	ErrStopLossNotSupported        = &ErrorCode{Code: -2010, Name: "STOP_LOSS_NOT_SUPPORTED", Message: "Stop loss orders are not supported for this symbol.", Category: ErrorCategoryUnsupportedRequest}
+	ErrStopLossLimitNotSupported   = &ErrorCode{Code: -2010, Name: "STOP_LOSS_LIMIT_NOT_SUPPORTED", Message: "Stop loss limit orders are not supported for this symbol.", Category: ErrorCategoryUnsupportedRequest}
+	ErrTakeProfitNotSupported      = &ErrorCode{Code: -2010, Name: "TAKE_PROFIT_NOT_SUPPORTED", Message: "Take profit orders are not supported for this symbol.", Category: ErrorCategoryUnsupportedRequest}
+	ErrTakeProfitLimitNotSupported = &ErrorCode{Code: -2010, Name: "TAKE_PROFIT_LIMIT_NOT_SUPPORTED", Message: "Take profit limit orders are not supported for this symbol.", Category: ErrorCategoryUnsupportedRequest}
+	ErrPriceQtyZero                = &ErrorCode{Code: -2010, Name: "PRICE_QTY_ZERO", Message: "Price * QTY is zero or less.", Category: ErrorCategoryFilter}
+	ErrIcebergQtyExceeds           = &ErrorCode{Code: -2010, Name: "ICEBERG_QTY_EXCEEDS", Message: "IcebergQty exceeds QTY.", Category: ErrorCategoryFilter}
+	ErrActionDisabled              = &ErrorCode{Code: -2010, Name: "ACTION_DISABLED", Message: "This action is disabled on this account.", Category: ErrorCategoryAuth}
+	ErrUnsupportedOrderCombination = &ErrorCode{Code: -2010, Name: "UNSUPPORTED_ORDER_COMBINATION", Message: "Unsupported order combination", Category: ErrorCategoryUnsupportedRequest}
+	ErrOrderImmediatelyTrigger     = &ErrorCode{Code: -2010, Name: "ORDER_IMMEDIATELY_TRIGGER", Message: "Order would trigger immediately.", Category: ErrorCategoryOrderRejected}
+	ErrOrderImmediatelyMatch       = &ErrorCode{Code: -2010, Name: "ORDER_IMMEDIATELY_MATCH", Message: "Order would immediately match and take.", Category: ErrorCategoryOrderRejected}
+	ErrCancelOrderInvalid          = &ErrorCode{Code: -2011, Name: "CANCEL_ORDER_INVALID", Message: "Cancel order is invalid. Check origClOrdId and orderId.", Category: ErrorCategoryRequest}
+	ErrOrderListNotExist           = &ErrorCode{Code: -2011, Name: "ORDER_LIST_NOT_EXIST", Message: "Order list does not exist.", Category: ErrorCategoryUnknownOrder}
+)
+
+// errorMessages
+// order reject reasons, matched before errorCodes
+var errorMessages = []*ErrorCode{
+	ErrUnknownOrder,
+	ErrDuplicateOrder,
+	ErrMarketClosed,
+	ErrInsufficientBalance,
+	ErrMarketOrderNotSupported,
+	ErrIcebergNotSupported,
+	ErrStopLossNotSupported,
+	ErrStopLossLimitNotSupported,
+	ErrTakeProfitNotSupported,
+	ErrTakeProfitLimitNotSupported,
+	ErrPriceQtyZero,
+	ErrIcebergQtyExceeds,
+	ErrActionDisabled,
+	ErrUnsupportedOrderCombination,
+	ErrOrderImmediatelyTrigger,
+	ErrOrderImmediatelyMatch,
+	ErrCancelOrderInvalid,
+	ErrOrderListNotExist,
+}
+
+var errorCodes = map[int64]*ErrorCode{}
+
+func init() {
+	for _, e := range []*ErrorCode{
+		ErrUnknown, ErrDisconnected, ErrUnauthorized, ErrTooManyRequests, ErrUnexpectedResponse, ErrTimeout,
+		ErrServerBusy, ErrErrorMsgReceived, ErrUnknownOrderComposition, ErrTooManyOrders, ErrServiceShuttingDown, ErrUnsupportedOperation,
+		ErrInvalidTimestamp, ErrInvalidSignature, ErrFilterFailure,
+		ErrIllegalChars, ErrTooManyParameters, ErrMandatoryParamEmpty, ErrUnknownParam, ErrUnreadParameters,
+		ErrParamEmpty, ErrParamNotRequired, ErrBadPrecision, ErrNoDepth, ErrTifNotRequired, ErrInvalidTif,
+		ErrInvalidOrderType, ErrInvalidSide, ErrEmptyNewClientOrderId, ErrEmptyOrigClientOrderId, ErrBadInterval,
+		ErrBadSymbol, ErrInvalidListenKey, ErrMoreThanXxHours, ErrOptionalParamsBadCombo, ErrInvalidParameter,
+		ErrBadRecvWindow,
+		ErrNewOrderRejected, ErrCancelRejected, ErrNoSuchOrder, ErrBadApiKeyFormat, ErrRejectedMbxKey,
+		ErrCancelReplacePartiallyFailed, ErrCancelReplaceFailed,
+	} {
+		errorCodes[e.Code] = e
+	}
+}
+
+// LookupErrorCode
+// find the documented error by code and message of server, nil when it is not documented
+func LookupErrorCode(code int64, message string) *ErrorCode {
+	for _, e := range errorMessages {
+		if e.matchMessage(message) {
+			return e
+		}
+	}
+	if e, ok := errorCodes[code]; ok {
+		return e
+	}
+	if strings.HasPrefix(message, "Filter failure") {
+		return ErrFilterFailure
+	}
+	return nil
+}
+
+// MatchCode
+// the error of server is this documented error, by code or by message when it has message
+func (e *ErrorCode) MatchCode(code int64, message string) bool {
+	if len(e.Message) > 0 {
+		return e.matchMessage(message)
+	}
+	return e.Code == code
+}
+
+// MatchCode
+// the documented error of code and message of server is in this category
+func (c ErrorCategory) MatchCode(code int64, message string) bool {
+	if e := LookupErrorCode(code, message); e != nil {
+		return e.Category == c
+	}
+	return false
+}
+
+func (e *ErrorCode) matchMessage(message string) bool {
+	return len(e.Message) > 0 && strings.Contains(strings.ToLower(message), strings.ToLower(strings.TrimSuffix(e.Message, ".")))
+}
+
+// IsRetryable
+// the same request may succeed later, e.g. rate limit, server busy, timeout or invalid timestamp
+func IsRetryable(err error) bool {
+	var clientError *ClientError
+	if errors.As(err, &clientError) {
+		return clientError.Retryable()
+	}
+
+	var serverError *ServerError
+	if errors.As(err, &serverError) {
+		return true
+	}
+
+	var rateLimitError *RateLimitError
+	if errors.As(err, &rateLimitError) {
+		return !rateLimitError.Banned
+	}
+
+	var netError net.Error
+	if errors.As(err, &netError) && netError.Timeout() {
+		return true
+	}
+	return false
+}
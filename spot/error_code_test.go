@@ -0,0 +1,81 @@
+package spot
+
+import (
+	"errors"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"net/http"
+	"testing"
+)
+
+func TestClientError_Is(t *testing.T) {
+	tests := []struct {
+		err      *ClientError
+		target   error
+		category ErrorCategory
+	}{
+		{&ClientError{StatusCode: 400, ErrorCode: -2010, ErrorMessage: "Account has insufficient balance for requested action."}, ErrInsufficientBalance, ErrorCategoryInsufficientFunds},
+		{&ClientError{StatusCode: 400, ErrorCode: -2010, ErrorMessage: "Account has insufficient balance for requested action."}, ErrNewOrderRejected, ErrorCategoryInsufficientFunds},
+		{&ClientError{StatusCode: 400, ErrorCode: -2011, ErrorMessage: "Unknown order sent."}, ErrUnknownOrder, ErrorCategoryUnknownOrder},
+		{&ClientError{StatusCode: 400, ErrorCode: -2013, ErrorMessage: "Order does not exist."}, ErrNoSuchOrder, ErrorCategoryUnknownOrder},
+		{&ClientError{StatusCode: 400, ErrorCode: -1021, ErrorMessage: "Timestamp for this request is outside of the recvWindow."}, ErrInvalidTimestamp, ErrorCategoryTimestamp},
+		{&ClientError{StatusCode: 400, ErrorCode: -1013, ErrorMessage: "Filter failure: LOT_SIZE"}, ErrFilterFailure, ErrorCategoryFilter},
+		{&ClientError{StatusCode: 401, ErrorCode: -2015, ErrorMessage: "Invalid API-key, IP, or permissions for action."}, ErrRejectedMbxKey, ErrorCategoryAuth},
+	}
+	for _, test := range tests {
+		if !errors.Is(test.err, test.target) {
+			t.Errorf("%s is not %s", test.err, test.target)
+		}
+		if !errors.Is(test.err, test.category) {
+			t.Errorf("%s category = %s, expected %s", test.err, test.err.Category(), test.category)
+		}
+	}
+
+	err := &ClientError{StatusCode: 400, ErrorCode: -2010, ErrorMessage: "Order would immediately match and take."}
+	if errors.Is(err, ErrInsufficientBalance) || errors.Is(err, ErrorCategoryInsufficientFunds) {
+		t.Errorf("%s must not be insufficient balance", err)
+	}
+	var code *ErrorCode
+	if !errors.As(err, &code) || code != ErrOrderImmediatelyMatch {
+		t.Errorf("code = %v", code)
+	}
+
+	if !errors.Is(&ServerError{StatusCode: 502}, ErrorCategoryServer) {
+		t.Error("HTTP 5xx must be server error")
+	}
+	if err := (&ClientError{StatusCode: 400, ErrorCode: -1010, ErrorMessage: "Duplicate order sent."}); !errors.Is(err, ErrErrorMsgReceived) || !errors.Is(err, ErrDuplicateOrder) {
+		t.Errorf("%s is not duplicate order", err)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		err       error
+		retryable bool
+	}{
+		{&ClientError{StatusCode: 429, ErrorCode: -1003}, true},
+		{&ClientError{StatusCode: 418, ErrorCode: -1003}, false},
+		{&ClientError{StatusCode: 400, ErrorCode: -1021}, true},
+		{&ClientError{StatusCode: 400, ErrorCode: -1022}, false},
+		{&ClientError{StatusCode: 400, ErrorCode: -2010, ErrorMessage: "Account has insufficient balance for requested action."}, false},
+		{&ServerError{StatusCode: 503}, true},
+		{&RateLimitError{Banned: true}, false},
+		{errors.New("closed"), false},
+	}
+	for _, test := range tests {
+		if retryable := IsRetryable(test.err); retryable != test.retryable {
+			t.Errorf("IsRetryable(%s) = %v, expected %v", test.err, retryable, test.retryable)
+		}
+	}
+}
+
+func TestAPI_ErrorCode(t *testing.T) {
+	api, _ := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":-2013,"msg":"Order does not exist."}`))
+	})
+
+	_, err := api.GetOrder(&model.GetOrderParam{Symbol: "BTCUSDT", OrderId: 1})
+	if !errors.Is(err, ErrNoSuchOrder) || !errors.Is(err, ErrorCategoryUnknownOrder) {
+		t.Errorf("err = %v", err)
+	}
+}
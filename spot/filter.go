@@ -0,0 +1,349 @@
+package spot
+
+import (
+	"errors"
+	"fmt"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+)
+
+var (
+	ErrUnknownSymbol = errors.New("symbol is not found in exchange information")
+)
+
+// SymbolInfoProvider
+// source of the cached trading rules, e.g. *model.ExchangeInformation
+type SymbolInfoProvider interface {
+	SymbolInfo(symbol string) (*model.SymbolInfo, bool)
+}
+
+type OrderValidatorConfig struct {
+	// snap price to tickSize (nearest) and quantity to stepSize (down) before the filters are checked
+	AutoRound bool
+	// weighted average price of the symbol, e.g. price of API.AveragePrice (optional).
+	// PERCENT_PRICE and MIN_NOTIONAL of MARKET orders are skipped when not set
+	ReferencePrice func(symbol string) (model.Decimal, error)
+	// number of open orders and open algo orders on the symbol (optional).
+	// MAX_NUM_ORDERS and MAX_NUM_ALGO_ORDERS are skipped when not set
+	OpenOrderCount func(symbol string) (orders int64, algoOrders int64, err error)
+}
+
+// OrderValidator
+// check an order against the symbol filters before it is sent to server
+// https://binance-docs.github.io/apidocs/spot/en/#filters
+type OrderValidator struct {
+	provider SymbolInfoProvider
+	config   OrderValidatorConfig
+}
+
+func NewOrderValidator(provider SymbolInfoProvider, configs ...OrderValidatorConfig) *OrderValidator {
+	v := &OrderValidator{
+		provider: provider,
+	}
+	if len(configs) > 0 {
+		v.config = configs[0]
+	}
+	return v
+}
+
+// ValidateOrder
+// return *FilterError naming the violated filter.
+// With AutoRound the price and quantity of param are modified in place
+func (v *OrderValidator) ValidateOrder(param *model.OrderParam) error {
+	info, err := v.symbolInfo(param.Symbol)
+	if err != nil {
+		return err
+	}
+	if !info.HasOrderType(param.OrderType) {
+		return &ParameterValueError{Params: []string{"type"}}
+	}
+
+	if v.config.AutoRound {
+		param.Price = v.roundPrice(info, param.Price)
+		param.StopPrice = v.roundPrice(info, param.StopPrice)
+		param.Quantity = v.roundQuantity(info, param.Quantity, param.OrderType == model.OrderTypeMarket)
+		param.IcebergQty = v.roundQuantity(info, param.IcebergQty, false)
+	}
+
+	c := v.newCheck(info)
+	c.price("price", param.Price)
+	c.price("stopPrice", param.StopPrice)
+	c.percentPrice("price", param.Price)
+	c.quantity("quantity", param.Quantity, param.OrderType == model.OrderTypeMarket)
+	c.quantity("icebergQty", param.IcebergQty, false)
+	c.icebergParts(param.Quantity, param.IcebergQty)
+
+	if param.OrderType == model.OrderTypeMarket {
+		c.marketNotional(param.Quantity, param.QuoteOrderQty)
+	} else if !param.Price.IsZero() {
+		c.notional("price", param.Price, param.Quantity)
+	} else {
+		c.notional("stopPrice", param.StopPrice, param.Quantity)
+	}
+
+	if isAlgoOrder(param.OrderType) {
+		c.numOrders(1, 1)
+	} else {
+		c.numOrders(1, 0)
+	}
+
+	return c.err
+}
+
+// ValidateOcoOrder
+// check both legs of an OCO order, see ValidateOrder
+func (v *OrderValidator) ValidateOcoOrder(param *model.NewOcoOrderParam) error {
+	info, err := v.symbolInfo(param.Symbol)
+	if err != nil {
+		return err
+	}
+	if !info.OcoAllowed {
+		return &ParameterArgumentError{ErrorMessage: fmt.Sprintf("OCO is not allowed on %s", param.Symbol)}
+	}
+
+	if v.config.AutoRound {
+		param.Price = v.roundPrice(info, param.Price)
+		param.StopPrice = v.roundPrice(info, param.StopPrice)
+		param.StopLimitPrice = v.roundPrice(info, param.StopLimitPrice)
+		param.Quantity = v.roundQuantity(info, param.Quantity, false)
+		param.LimitIcebergQty = v.roundQuantity(info, param.LimitIcebergQty, false)
+		param.StopIcebergQty = v.roundQuantity(info, param.StopIcebergQty, false)
+	}
+
+	c := v.newCheck(info)
+	c.price("price", param.Price)
+	c.price("stopPrice", param.StopPrice)
+	c.price("stopLimitPrice", param.StopLimitPrice)
+	c.percentPrice("price", param.Price)
+	c.percentPrice("stopLimitPrice", param.StopLimitPrice)
+	c.quantity("quantity", param.Quantity, false)
+	c.quantity("limitIcebergQty", param.LimitIcebergQty, false)
+	c.quantity("stopIcebergQty", param.StopIcebergQty, false)
+	c.icebergParts(param.Quantity, param.LimitIcebergQty)
+	c.icebergParts(param.Quantity, param.StopIcebergQty)
+	c.notional("price", param.Price, param.Quantity)
+	if !param.StopLimitPrice.IsZero() {
+		c.notional("stopLimitPrice", param.StopLimitPrice, param.Quantity)
+	} else {
+		c.notional("stopPrice", param.StopPrice, param.Quantity)
+	}
+	c.numOrders(2, 1)
+
+	return c.err
+}
+
+func (v *OrderValidator) symbolInfo(symbol string) (*model.SymbolInfo, error) {
+	if v.provider == nil {
+		return nil, ErrUnknownSymbol
+	}
+	info, ok := v.provider.SymbolInfo(symbol)
+	if !ok {
+		return nil, ErrUnknownSymbol
+	}
+	return info, nil
+}
+
+func (v *OrderValidator) roundPrice(info *model.SymbolInfo, price model.Decimal) model.Decimal {
+	f, ok := info.PriceFilter()
+	if !ok || price.IsZero() || !f.TickSize.IsPositive() {
+		return price
+	}
+	if rounded, err := price.Sub(f.MinPrice).RoundToStep(f.TickSize); err == nil {
+		return rounded.Add(f.MinPrice)
+	}
+	return price
+}
+
+func (v *OrderValidator) roundQuantity(info *model.SymbolInfo, qty model.Decimal, isMarket bool) model.Decimal {
+	if qty.IsZero() {
+		return qty
+	}
+	filters := make([]*model.LotSizeFilter, 0, 2)
+	if f, ok := info.LotSizeFilter(); ok {
+		filters = append(filters, f)
+	}
+	if f, ok := info.MarketLotSizeFilter(); ok && isMarket {
+		filters = append(filters, f)
+	}
+	for _, f := range filters {
+		if !f.StepSize.IsPositive() {
+			continue
+		}
+		if rounded, err := qty.Sub(f.MinQty).FloorToStep(f.StepSize); err == nil {
+			qty = rounded.Add(f.MinQty)
+		}
+	}
+	return qty
+}
+
+func (v *OrderValidator) newCheck(info *model.SymbolInfo) *filterCheck {
+	return &filterCheck{
+		validator: v,
+		info:      info,
+	}
+}
+
+func isAlgoOrder(orderType model.OrderType) bool {
+	switch orderType {
+	case model.OrderTypeStopLoss, model.OrderTypeStopLossLimit, model.OrderTypeTakeProfit, model.OrderTypeTakeProfitLimit:
+		return true
+	}
+	return false
+}
+
+// filterCheck
+// keep the first violation, every rule after it is a no-op
+type filterCheck struct {
+	validator *OrderValidator
+	info      *model.SymbolInfo
+	err       error
+
+	reference       model.Decimal
+	referenceLoaded bool
+}
+
+func (c *filterCheck) fail(filterType model.SymbolFilterType, param string, value model.Decimal, reason string) {
+	c.err = &FilterError{
+		Symbol:     c.info.Symbol,
+		FilterType: filterType,
+		Param:      param,
+		Value:      value.String(),
+		Reason:     reason,
+	}
+}
+
+func (c *filterCheck) referencePrice() (model.Decimal, bool) {
+	if c.validator.config.ReferencePrice == nil {
+		return model.Decimal{}, false
+	}
+	if !c.referenceLoaded {
+		price, err := c.validator.config.ReferencePrice(c.info.Symbol)
+		if err != nil {
+			c.err = err
+			return model.Decimal{}, false
+		}
+		c.reference = price
+		c.referenceLoaded = true
+	}
+	return c.reference, c.reference.IsPositive()
+}
+
+func (c *filterCheck) price(param string, price model.Decimal) {
+	f, ok := c.info.PriceFilter()
+	if c.err != nil || !ok || price.IsZero() {
+		return
+	}
+	if f.MinPrice.IsPositive() && price.LessThan(f.MinPrice) {
+		c.fail(model.SymbolFilterTypePrice, param, price, fmt.Sprintf("is less than minPrice %s", f.MinPrice))
+	} else if f.MaxPrice.IsPositive() && price.GreaterThan(f.MaxPrice) {
+		c.fail(model.SymbolFilterTypePrice, param, price, fmt.Sprintf("is greater than maxPrice %s", f.MaxPrice))
+	} else if f.TickSize.IsPositive() && !price.Sub(f.MinPrice).IsMultipleOf(f.TickSize) {
+		c.fail(model.SymbolFilterTypePrice, param, price, fmt.Sprintf("is not a multiple of tickSize %s", f.TickSize))
+	}
+}
+
+func (c *filterCheck) percentPrice(param string, price model.Decimal) {
+	f, ok := c.info.PercentPriceFilter()
+	if c.err != nil || !ok || price.IsZero() {
+		return
+	}
+	reference, ok := c.referencePrice()
+	if !ok {
+		return
+	}
+	if up := reference.Mul(f.MultiplierUp); f.MultiplierUp.IsPositive() && price.GreaterThan(up) {
+		c.fail(model.SymbolFilterTypePercentPrice, param, price, fmt.Sprintf("is greater than %s", up))
+	} else if down := reference.Mul(f.MultiplierDown); price.LessThan(down) {
+		c.fail(model.SymbolFilterTypePercentPrice, param, price, fmt.Sprintf("is less than %s", down))
+	}
+}
+
+func (c *filterCheck) quantity(param string, qty model.Decimal, isMarket bool) {
+	if f, ok := c.info.LotSizeFilter(); ok {
+		c.lotSize(model.SymbolFilterTypeLotSize, f, param, qty)
+	}
+	if f, ok := c.info.MarketLotSizeFilter(); ok && isMarket {
+		c.lotSize(model.SymbolFilterTypeMarketLotSize, f, param, qty)
+	}
+}
+
+func (c *filterCheck) lotSize(filterType model.SymbolFilterType, f *model.LotSizeFilter, param string, qty model.Decimal) {
+	if c.err != nil || qty.IsZero() {
+		return
+	}
+	if qty.LessThan(f.MinQty) {
+		c.fail(filterType, param, qty, fmt.Sprintf("is less than minQty %s", f.MinQty))
+	} else if f.MaxQty.IsPositive() && qty.GreaterThan(f.MaxQty) {
+		c.fail(filterType, param, qty, fmt.Sprintf("is greater than maxQty %s", f.MaxQty))
+	} else if f.StepSize.IsPositive() && !qty.Sub(f.MinQty).IsMultipleOf(f.StepSize) {
+		c.fail(filterType, param, qty, fmt.Sprintf("is not a multiple of stepSize %s", f.StepSize))
+	}
+}
+
+func (c *filterCheck) icebergParts(qty model.Decimal, icebergQty model.Decimal) {
+	f, ok := c.info.IcebergPartsFilter()
+	if c.err != nil || icebergQty.IsZero() {
+		return
+	}
+	if !c.info.IcebergAllowed {
+		c.fail(model.SymbolFilterTypeIcebergParts, "icebergQty", icebergQty, "is not allowed")
+		return
+	}
+	if !ok || f.Limit <= 0 {
+		return
+	}
+	parts := qty.Div(icebergQty, 0)
+	if !parts.Mul(icebergQty).Equal(qty) {
+		parts = parts.Add(model.NewDecimalFromInt(1))
+	}
+	if parts.GreaterThan(model.NewDecimalFromInt(f.Limit)) {
+		c.fail(model.SymbolFilterTypeIcebergParts, "icebergQty", icebergQty, fmt.Sprintf("splits the order into more than %d parts", f.Limit))
+	}
+}
+
+func (c *filterCheck) notional(param string, price model.Decimal, qty model.Decimal) {
+	f, ok := c.info.MinNotionalFilter()
+	if c.err != nil || !ok || price.IsZero() || qty.IsZero() {
+		return
+	}
+	if notional := price.Mul(qty); notional.LessThan(f.MinNotional) {
+		c.fail(model.SymbolFilterTypeMinNotional, param, notional, fmt.Sprintf("notional is less than minNotional %s", f.MinNotional))
+	}
+}
+
+func (c *filterCheck) marketNotional(qty model.Decimal, quoteOrderQty model.Decimal) {
+	f, ok := c.info.MinNotionalFilter()
+	if c.err != nil || !ok || !f.ApplyToMarket {
+		return
+	}
+	if !quoteOrderQty.IsZero() {
+		if quoteOrderQty.LessThan(f.MinNotional) {
+			c.fail(model.SymbolFilterTypeMinNotional, "quoteOrderQty", quoteOrderQty, fmt.Sprintf("is less than minNotional %s", f.MinNotional))
+		}
+		return
+	}
+	if reference, ok := c.referencePrice(); ok {
+		c.notional("quantity", reference, qty)
+	}
+}
+
+func (c *filterCheck) numOrders(orders int64, algoOrders int64) {
+	if c.err != nil || c.validator.config.OpenOrderCount == nil {
+		return
+	}
+	maxOrders, hasMaxOrders := c.info.MaxNumOrdersFilter()
+	maxAlgoOrders, hasMaxAlgoOrders := c.info.MaxNumAlgoOrdersFilter()
+	if !hasMaxOrders && !hasMaxAlgoOrders {
+		return
+	}
+
+	openOrders, openAlgoOrders, err := c.validator.config.OpenOrderCount(c.info.Symbol)
+	if err != nil {
+		c.err = err
+		return
+	}
+	if hasMaxOrders && maxOrders.MaxNumOrders > 0 && openOrders+orders > maxOrders.MaxNumOrders {
+		c.fail(model.SymbolFilterTypeMaxNumOrders, "symbol", model.NewDecimalFromInt(openOrders), fmt.Sprintf("open orders reach maxNumOrders %d", maxOrders.MaxNumOrders))
+	} else if hasMaxAlgoOrders && algoOrders > 0 && maxAlgoOrders.MaxNumAlgoOrders > 0 && openAlgoOrders+algoOrders > maxAlgoOrders.MaxNumAlgoOrders {
+		c.fail(model.SymbolFilterTypeMaxNumAlgoOrders, "symbol", model.NewDecimalFromInt(openAlgoOrders), fmt.Sprintf("open algo orders reach maxNumAlgoOrders %d", maxAlgoOrders.MaxNumAlgoOrders))
+	}
+}
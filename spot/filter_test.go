@@ -0,0 +1,121 @@
+package spot
+
+import (
+	"errors"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"testing"
+)
+
+func newTestExchangeInformation() *model.ExchangeInformation {
+	return &model.ExchangeInformation{
+		Symbols: []*model.SymbolInfo{
+			{
+				Symbol:         "ETHBTC",
+				Status:         model.SymbolStatusTrading,
+				OrderTypes:     []model.OrderType{model.OrderTypeLimit, model.OrderTypeMarket, model.OrderTypeStopLossLimit},
+				IcebergAllowed: true,
+				OcoAllowed:     true,
+				Filters: []*model.SymbolFilter{
+					{FilterType: model.SymbolFilterTypePrice, MinPrice: model.MustDecimal("0.00000100"), MaxPrice: model.MustDecimal("100000.00000000"), TickSize: model.MustDecimal("0.00000100")},
+					{FilterType: model.SymbolFilterTypePercentPrice, MultiplierUp: model.MustDecimal("5"), MultiplierDown: model.MustDecimal("0.2"), AvgPriceMins: 5},
+					{FilterType: model.SymbolFilterTypeLotSize, MinQty: model.MustDecimal("0.00100000"), MaxQty: model.MustDecimal("100000.00000000"), StepSize: model.MustDecimal("0.00100000")},
+					{FilterType: model.SymbolFilterTypeMinNotional, MinNotional: model.MustDecimal("0.00010000"), ApplyToMarket: true, AvgPriceMins: 5},
+					{FilterType: model.SymbolFilterTypeIcebergParts, Limit: 10},
+					{FilterType: model.SymbolFilterTypeMaxNumAlgoOrders, MaxNumAlgoOrders: 5},
+				},
+			},
+		},
+	}
+}
+
+func TestOrderValidator_ValidateOrder(t *testing.T) {
+	validator := NewOrderValidator(newTestExchangeInformation())
+
+	cases := []struct {
+		name       string
+		param      *model.OrderParam
+		filterType string
+	}{
+		{"valid", &model.OrderParam{Symbol: "ETHBTC", OrderType: model.OrderTypeLimit, Price: model.MustDecimal("0.065"), Quantity: model.MustDecimal("1.5")}, ""},
+		{"tick size", &model.OrderParam{Symbol: "ETHBTC", OrderType: model.OrderTypeLimit, Price: model.MustDecimal("0.0650001"), Quantity: model.MustDecimal("1.5")}, model.SymbolFilterTypePrice},
+		{"step size", &model.OrderParam{Symbol: "ETHBTC", OrderType: model.OrderTypeLimit, Price: model.MustDecimal("0.065"), Quantity: model.MustDecimal("1.5005")}, model.SymbolFilterTypeLotSize},
+		{"min qty", &model.OrderParam{Symbol: "ETHBTC", OrderType: model.OrderTypeLimit, Price: model.MustDecimal("0.065"), Quantity: model.MustDecimal("0.0001")}, model.SymbolFilterTypeLotSize},
+		{"min notional", &model.OrderParam{Symbol: "ETHBTC", OrderType: model.OrderTypeLimit, Price: model.MustDecimal("0.000001"), Quantity: model.MustDecimal("1")}, model.SymbolFilterTypeMinNotional},
+		{"iceberg parts", &model.OrderParam{Symbol: "ETHBTC", OrderType: model.OrderTypeLimit, Price: model.MustDecimal("0.065"), Quantity: model.MustDecimal("1.1"), IcebergQty: model.MustDecimal("0.1")}, model.SymbolFilterTypeIcebergParts},
+		{"market quote qty", &model.OrderParam{Symbol: "ETHBTC", OrderType: model.OrderTypeMarket, QuoteOrderQty: model.MustDecimal("0.00001")}, model.SymbolFilterTypeMinNotional},
+	}
+	for _, c := range cases {
+		err := validator.ValidateOrder(c.param)
+		if c.filterType == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", c.name, err)
+			}
+			continue
+		}
+
+		var filterError *FilterError
+		if !errors.As(err, &filterError) || filterError.FilterType != c.filterType {
+			t.Errorf("%s: error = %v, want filter %s", c.name, err, c.filterType)
+		}
+	}
+
+	if err := validator.ValidateOrder(&model.OrderParam{Symbol: "BNBBTC", OrderType: model.OrderTypeLimit}); err != ErrUnknownSymbol {
+		t.Errorf("unknown symbol error = %v", err)
+	}
+	if err := validator.ValidateOrder(&model.OrderParam{Symbol: "ETHBTC", OrderType: model.OrderTypeTakeProfit}); err == nil {
+		t.Error("unsupported order type should be rejected")
+	}
+}
+
+func TestOrderValidator_AutoRound(t *testing.T) {
+	validator := NewOrderValidator(newTestExchangeInformation(), OrderValidatorConfig{
+		AutoRound: true,
+		ReferencePrice: func(symbol string) (model.Decimal, error) {
+			return model.MustDecimal("0.065"), nil
+		},
+		OpenOrderCount: func(symbol string) (int64, int64, error) {
+			return 3, 5, nil
+		},
+	})
+
+	param := &model.OrderParam{Symbol: "ETHBTC", OrderType: model.OrderTypeLimit, Price: model.MustDecimal("0.06500049"), Quantity: model.MustDecimal("1.23456")}
+	if err := validator.ValidateOrder(param); err != nil {
+		t.Fatal(err)
+	}
+	if param.Price.Trim().String() != "0.065" || param.Quantity.Trim().String() != "1.234" {
+		t.Errorf("rounded price = %s, quantity = %s", param.Price, param.Quantity)
+	}
+
+	param = &model.OrderParam{Symbol: "ETHBTC", OrderType: model.OrderTypeLimit, Price: model.MustDecimal("0.5"), Quantity: model.MustDecimal("1")}
+	var filterError *FilterError
+	if err := validator.ValidateOrder(param); !errors.As(err, &filterError) || filterError.FilterType != model.SymbolFilterTypePercentPrice {
+		t.Errorf("percent price error = %v", err)
+	}
+
+	param = &model.OrderParam{Symbol: "ETHBTC", OrderType: model.OrderTypeStopLossLimit, Price: model.MustDecimal("0.06"), StopPrice: model.MustDecimal("0.061"), Quantity: model.MustDecimal("1")}
+	if err := validator.ValidateOrder(param); !errors.As(err, &filterError) || filterError.FilterType != model.SymbolFilterTypeMaxNumAlgoOrders {
+		t.Errorf("max num algo orders error = %v", err)
+	}
+}
+
+func TestOrderValidator_ValidateOcoOrder(t *testing.T) {
+	validator := NewOrderValidator(newTestExchangeInformation())
+
+	param := &model.NewOcoOrderParam{
+		Symbol:         "ETHBTC",
+		Side:           model.OrderSideSell,
+		Quantity:       model.MustDecimal("1"),
+		Price:          model.MustDecimal("0.07"),
+		StopPrice:      model.MustDecimal("0.06"),
+		StopLimitPrice: model.MustDecimal("0.0599999"),
+	}
+	var filterError *FilterError
+	if err := validator.ValidateOcoOrder(param); !errors.As(err, &filterError) || filterError.Param != "stopLimitPrice" {
+		t.Errorf("stop limit price error = %v", err)
+	}
+
+	param.StopLimitPrice = model.MustDecimal("0.059999")
+	if err := validator.ValidateOcoOrder(param); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,412 @@
+package spot
+
+import (
+	"context"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"net/http"
+)
+
+// MarginAccount
+// Query Cross Margin Account Details (USER_DATA)
+// GET /sapi/v1/margin/account (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#query-cross-margin-account-details-user_data
+func (r *API) MarginAccount(param *model.MarginAccountParam) (*model.MarginAccount, error) {
+	return r.MarginAccountContext(r.ctx, param)
+}
+
+// MarginAccountContext
+// MarginAccount with context of the request
+func (r *API) MarginAccountContext(ctx context.Context, param *model.MarginAccountParam) (*model.MarginAccount, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/margin/account", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseMarginAccount(bytes)
+}
+
+// MarginBorrow
+// Margin Account Borrow (MARGIN)
+// Apply for a loan. IsIsolated and Symbol for isolated margin account.
+// POST /sapi/v1/margin/loan (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#margin-account-borrow-margin
+func (r *API) MarginBorrow(param *model.MarginLoanParam) (*model.MarginTransaction, error) {
+	return r.MarginBorrowContext(r.ctx, param)
+}
+
+// MarginBorrowContext
+// MarginBorrow with context of the request
+func (r *API) MarginBorrowContext(ctx context.Context, param *model.MarginLoanParam) (*model.MarginTransaction, error) {
+	if param == nil || param.Amount.IsZero() {
+		return nil, &ParameterRequiredError{Params: []string{"amount"}}
+	}
+
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/sapi/v1/margin/loan", param, model.EndpointSecurityTypeMargin)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseMarginTransaction(bytes)
+}
+
+// MarginRepay
+// Margin Account Repay (MARGIN)
+// Repay loan for margin account. IsIsolated and Symbol for isolated margin account.
+// POST /sapi/v1/margin/repay (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#margin-account-repay-margin
+func (r *API) MarginRepay(param *model.MarginLoanParam) (*model.MarginTransaction, error) {
+	return r.MarginRepayContext(r.ctx, param)
+}
+
+// MarginRepayContext
+// MarginRepay with context of the request
+func (r *API) MarginRepayContext(ctx context.Context, param *model.MarginLoanParam) (*model.MarginTransaction, error) {
+	if param == nil || param.Amount.IsZero() {
+		return nil, &ParameterRequiredError{Params: []string{"amount"}}
+	}
+
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/sapi/v1/margin/repay", param, model.EndpointSecurityTypeMargin)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseMarginTransaction(bytes)
+}
+
+// MarginNewOrder
+// Margin Account New Order (TRADE)
+// Post a new order for margin account.
+// POST /sapi/v1/margin/order (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#margin-account-new-order-trade
+func (r *API) MarginNewOrder(param *model.MarginOrderParam) (*model.MarginOrder, error) {
+	return r.MarginNewOrderContext(r.ctx, param)
+}
+
+// MarginNewOrderContext
+// MarginNewOrder with context of the request
+func (r *API) MarginNewOrderContext(ctx context.Context, param *model.MarginOrderParam) (*model.MarginOrder, error) {
+	if param != nil {
+		if err := r.validateOrder(&param.OrderParam); err != nil {
+			return nil, err
+		}
+	}
+
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/sapi/v1/margin/order", param, model.EndpointSecurityTypeTrade)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseMarginOrder(bytes)
+}
+
+// MarginCancelOrder
+// Margin Account Cancel Order (TRADE)
+// Cancel an active order for margin account.
+// DELETE /sapi/v1/margin/order (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#margin-account-cancel-order-trade
+func (r *API) MarginCancelOrder(param *model.MarginCancelOrderParam) (*model.CancelOrder, error) {
+	return r.MarginCancelOrderContext(r.ctx, param)
+}
+
+// MarginCancelOrderContext
+// MarginCancelOrder with context of the request
+func (r *API) MarginCancelOrderContext(ctx context.Context, param *model.MarginCancelOrderParam) (*model.CancelOrder, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodDelete, "/sapi/v1/margin/order", param, model.EndpointSecurityTypeTrade)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseCancelOrder(bytes)
+}
+
+// MarginGetOrder
+// Query Margin Account's Order (USER_DATA)
+// GET /sapi/v1/margin/order (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#query-margin-account-39-s-order-user_data
+func (r *API) MarginGetOrder(param *model.MarginGetOrderParam) (*model.GetOrder, error) {
+	return r.MarginGetOrderContext(r.ctx, param)
+}
+
+// MarginGetOrderContext
+// MarginGetOrder with context of the request
+func (r *API) MarginGetOrderContext(ctx context.Context, param *model.MarginGetOrderParam) (*model.GetOrder, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/margin/order", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseGetOrder(bytes)
+}
+
+// MarginGetOpenOrders
+// Query Margin Account's Open Orders (USER_DATA)
+// GET /sapi/v1/margin/openOrders (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#query-margin-account-39-s-open-orders-user_data
+func (r *API) MarginGetOpenOrders(param *model.MarginGetOpenOrdersParam) ([]*model.GetOrder, error) {
+	return r.MarginGetOpenOrdersContext(r.ctx, param)
+}
+
+// MarginGetOpenOrdersContext
+// MarginGetOpenOrders with context of the request
+func (r *API) MarginGetOpenOrdersContext(ctx context.Context, param *model.MarginGetOpenOrdersParam) ([]*model.GetOrder, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/margin/openOrders", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseGetOpenOrder(bytes)
+}
+
+// MarginGetOrders
+// Query Margin Account's All Orders (USER_DATA)
+// GET /sapi/v1/margin/allOrders (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#query-margin-account-39-s-all-orders-user_data
+func (r *API) MarginGetOrders(param *model.MarginGetOrdersParam) ([]*model.GetOrder, error) {
+	return r.MarginGetOrdersContext(r.ctx, param)
+}
+
+// MarginGetOrdersContext
+// MarginGetOrders with context of the request
+func (r *API) MarginGetOrdersContext(ctx context.Context, param *model.MarginGetOrdersParam) ([]*model.GetOrder, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/margin/allOrders", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseGetOrders(bytes)
+}
+
+// MarginNewOcoOrder
+// Margin Account New OCO (TRADE)
+// Send in a new OCO for a margin account.
+// POST /sapi/v1/margin/order/oco (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#margin-account-new-oco-trade
+func (r *API) MarginNewOcoOrder(param *model.MarginOcoOrderParam) (*model.MarginOcoOrder, error) {
+	return r.MarginNewOcoOrderContext(r.ctx, param)
+}
+
+// MarginNewOcoOrderContext
+// MarginNewOcoOrder with context of the request
+func (r *API) MarginNewOcoOrderContext(ctx context.Context, param *model.MarginOcoOrderParam) (*model.MarginOcoOrder, error) {
+	if param != nil {
+		if err := r.validateOcoOrder(&param.NewOcoOrderParam); err != nil {
+			return nil, err
+		}
+	}
+
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/sapi/v1/margin/order/oco", param, model.EndpointSecurityTypeTrade)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseMarginOcoOrder(bytes)
+}
+
+// MarginCancelOcoOrder
+// Margin Account Cancel OCO (TRADE)
+// Cancel an entire Order List for a margin account.
+// DELETE /sapi/v1/margin/orderList (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#margin-account-cancel-oco-trade
+func (r *API) MarginCancelOcoOrder(param *model.MarginCancelOcoOrderParam) (*model.CancelOcoOrder, error) {
+	return r.MarginCancelOcoOrderContext(r.ctx, param)
+}
+
+// MarginCancelOcoOrderContext
+// MarginCancelOcoOrder with context of the request
+func (r *API) MarginCancelOcoOrderContext(ctx context.Context, param *model.MarginCancelOcoOrderParam) (*model.CancelOcoOrder, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodDelete, "/sapi/v1/margin/orderList", param, model.EndpointSecurityTypeTrade)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseCancelOcoOrder(bytes)
+}
+
+// MarginGetOcoOrder
+// Query Margin Account's OCO (USER_DATA)
+// Retrieves a specific OCO based on provided optional parameters.
+// GET /sapi/v1/margin/orderList (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#query-margin-account-39-s-oco-user_data
+func (r *API) MarginGetOcoOrder(param *model.MarginGetOcoOrderParam) (*model.GetOcoOrder, error) {
+	return r.MarginGetOcoOrderContext(r.ctx, param)
+}
+
+// MarginGetOcoOrderContext
+// MarginGetOcoOrder with context of the request
+func (r *API) MarginGetOcoOrderContext(ctx context.Context, param *model.MarginGetOcoOrderParam) (*model.GetOcoOrder, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/margin/orderList", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseGetOcoOrder(bytes)
+}
+
+// MarginMaxBorrowable
+// Query Max Borrow (USER_DATA)
+// IsolatedSymbol for isolated margin account.
+// GET /sapi/v1/margin/maxBorrowable (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#query-max-borrow-user_data
+func (r *API) MarginMaxBorrowable(param *model.MarginMaxBorrowableParam) (*model.MarginMaxBorrowable, error) {
+	return r.MarginMaxBorrowableContext(r.ctx, param)
+}
+
+// MarginMaxBorrowableContext
+// MarginMaxBorrowable with context of the request
+func (r *API) MarginMaxBorrowableContext(ctx context.Context, param *model.MarginMaxBorrowableParam) (*model.MarginMaxBorrowable, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/margin/maxBorrowable", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseMarginMaxBorrowable(bytes)
+}
+
+// MarginMaxTransferable
+// Query Max Transfer-Out Amount (USER_DATA)
+// IsolatedSymbol for isolated margin account.
+// GET /sapi/v1/margin/maxTransferable (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#query-max-transfer-out-amount-user_data
+func (r *API) MarginMaxTransferable(param *model.MarginMaxTransferableParam) (*model.MarginMaxTransferable, error) {
+	return r.MarginMaxTransferableContext(r.ctx, param)
+}
+
+// MarginMaxTransferableContext
+// MarginMaxTransferable with context of the request
+func (r *API) MarginMaxTransferableContext(ctx context.Context, param *model.MarginMaxTransferableParam) (*model.MarginMaxTransferable, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/margin/maxTransferable", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseMarginMaxTransferable(bytes)
+}
+
+// MarginInterestHistory
+// Get Interest History (USER_DATA)
+// By default, the recent 7 days.
+// GET /sapi/v1/margin/interestHistory (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#get-interest-history-user_data
+func (r *API) MarginInterestHistory(param *model.MarginInterestHistoryParam) (*model.MarginInterestHistory, error) {
+	return r.MarginInterestHistoryContext(r.ctx, param)
+}
+
+// MarginInterestHistoryContext
+// MarginInterestHistory with context of the request
+func (r *API) MarginInterestHistoryContext(ctx context.Context, param *model.MarginInterestHistoryParam) (*model.MarginInterestHistory, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/margin/interestHistory", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseMarginInterestHistory(bytes)
+}
+
+// IsolatedMarginPair
+// Query Isolated Margin Symbol (USER_DATA)
+// GET /sapi/v1/margin/isolated/pair (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#query-isolated-margin-symbol-user_data
+func (r *API) IsolatedMarginPair(param *model.IsolatedMarginPairParam) (*model.IsolatedMarginPair, error) {
+	return r.IsolatedMarginPairContext(r.ctx, param)
+}
+
+// IsolatedMarginPairContext
+// IsolatedMarginPair with context of the request
+func (r *API) IsolatedMarginPairContext(ctx context.Context, param *model.IsolatedMarginPairParam) (*model.IsolatedMarginPair, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/margin/isolated/pair", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseIsolatedMarginPair(bytes)
+}
+
+// IsolatedMarginAllPairs
+// Get All Isolated Margin Symbol (USER_DATA)
+// GET /sapi/v1/margin/isolated/allPairs (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#get-all-isolated-margin-symbol-user_data
+func (r *API) IsolatedMarginAllPairs(param *model.IsolatedMarginAllPairsParam) ([]*model.IsolatedMarginPair, error) {
+	return r.IsolatedMarginAllPairsContext(r.ctx, param)
+}
+
+// IsolatedMarginAllPairsContext
+// IsolatedMarginAllPairs with context of the request
+func (r *API) IsolatedMarginAllPairsContext(ctx context.Context, param *model.IsolatedMarginAllPairsParam) ([]*model.IsolatedMarginPair, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/margin/isolated/allPairs", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseIsolatedMarginAllPairs(bytes)
+}
+
+// IsolatedMarginAccount
+// Query Isolated Margin Account Info (USER_DATA)
+// Symbols for part of isolated symbols, max 5 symbols.
+// GET /sapi/v1/margin/isolated/account (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#query-isolated-margin-account-info-user_data
+func (r *API) IsolatedMarginAccount(param *model.IsolatedMarginAccountParam) (*model.IsolatedMarginAccount, error) {
+	return r.IsolatedMarginAccountContext(r.ctx, param)
+}
+
+// IsolatedMarginAccountContext
+// IsolatedMarginAccount with context of the request
+func (r *API) IsolatedMarginAccountContext(ctx context.Context, param *model.IsolatedMarginAccountParam) (*model.IsolatedMarginAccount, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/margin/isolated/account", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseIsolatedMarginAccount(bytes)
+}
@@ -0,0 +1,101 @@
+package spot
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestAPI_MarginNewOrder(t *testing.T) {
+	var form url.Values
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		_ = r.ParseForm()
+		form = r.Form
+		_, _ = w.Write([]byte(`{"symbol":"BTCUSDT","orderId":28,"clientOrderId":"6gCrw2kRUAF9CvJDGP16IP","transactTime":1507725176595,"isIsolated":true}`))
+	})
+
+	order, err := api.MarginNewOrder(&model.MarginOrderParam{
+		OrderParam: model.OrderParam{
+			Symbol:    "BTCUSDT",
+			Side:      model.OrderSideBuy,
+			OrderType: model.OrderTypeMarket,
+			Quantity:  model.MustDecimal("0.010"),
+		},
+		IsIsolated:     true,
+		SideEffectType: model.SideEffectTypeMarginBuy,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.OrderId != 28 || !order.IsIsolated || fake.Calls()[0] != "POST /sapi/v1/margin/order" {
+		t.Errorf("order = %+v, calls = %v", order, fake.Calls())
+	}
+	for key, expected := range map[string]string{"symbol": "BTCUSDT", "side": "BUY", "type": "MARKET", "quantity": "0.01", "isIsolated": "true", "sideEffectType": "MARGIN_BUY"} {
+		if v := form.Get(key); v != expected {
+			t.Errorf("%s = %s, expected %s", key, v, expected)
+		}
+	}
+}
+
+func TestAPI_IsolatedMarginAccount(t *testing.T) {
+	var symbols []string
+	api, _ := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		_ = r.ParseForm()
+		symbols = r.Form["symbols"]
+		_, _ = w.Write([]byte(`{"assets":[],"totalAssetOfBtc":"0","totalLiabilityOfBtc":"0","totalNetAssetOfBtc":"0"}`))
+	})
+
+	if _, err := api.IsolatedMarginAccount(&model.IsolatedMarginAccountParam{Symbols: []string{"BTCUSDT", "BNBUSDT"}}); err != nil {
+		t.Fatal(err)
+	}
+	if len(symbols) != 1 || symbols[0] != "BTCUSDT,BNBUSDT" {
+		t.Errorf("symbols = %v", symbols)
+	}
+}
+
+func TestMarginListenKeyService(t *testing.T) {
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		_, _ = w.Write([]byte(`{"listenKey":"T3ee22BIYuWqmvne0HNq2A2WsFlEtLhvWCtItw6ffhhdmjifQ2tRbuKkTHhr"}`))
+	})
+
+	service := api.IsolatedMarginListenKeyService("BTCUSDT")
+	listenKey, err := service.CreateListenKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = service.KeepAliveListenKey(listenKey); err != nil {
+		t.Fatal(err)
+	}
+	if err = api.MarginListenKeyService().CloseListenKey(listenKey); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := fake.Calls()
+	expected := []string{"POST /sapi/v1/userDataStream/isolated", "PUT /sapi/v1/userDataStream/isolated", "DELETE /sapi/v1/userDataStream"}
+	if len(calls) != len(expected) {
+		t.Fatalf("calls = %v", calls)
+	}
+	for i := range expected {
+		if calls[i] != expected[i] {
+			t.Errorf("calls[%d] = %s, expected %s", i, calls[i], expected[i])
+		}
+	}
+}
+
+func TestAPI_MarginBorrowIsolatedSymbolRequired(t *testing.T) {
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		_, _ = w.Write([]byte(`{"tranId":100000001}`))
+	})
+
+	if _, err := api.MarginBorrow(&model.MarginLoanParam{Asset: "BTC", IsIsolated: true, Amount: model.MustDecimal("1")}); err == nil {
+		t.Error("symbol is required for isolated margin")
+	}
+	result, err := api.MarginBorrow(&model.MarginLoanParam{Asset: "BTC", Amount: model.MustDecimal("1")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.TranId != 100000001 || len(fake.Calls()) != 1 {
+		t.Errorf("result = %+v, calls = %v", result, fake.Calls())
+	}
+}
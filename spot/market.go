@@ -1,6 +1,7 @@
 package spot
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/NattapornTee22816/binance-connector-golang/model"
 	"github.com/buger/jsonparser"
@@ -12,16 +13,28 @@ import (
 // GET /api/v3/ping
 // https://binance-docs.github.io/apidocs/spot/en/#test-connectivity
 func (r *API) Ping() error {
-	_, err := r.sendRequest(http.MethodGet, "/api/v3/ping", nil, model.EndpointSecurityTypeNone)
+	return r.PingContext(r.ctx)
+}
+
+// PingContext
+// Ping with context of the request
+func (r *API) PingContext(ctx context.Context) error {
+	_, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/ping", nil, model.EndpointSecurityTypeNone)
 	return err
 }
 
 // CheckServerTime
 // Test connectivity to the Rest API and get the current server time.
-// GET /api/v3/time
+// GET /api/v3/time, or the path of WithServerTimePath
 // https://binance-docs.github.io/apidocs/spot/en/#check-server-time
 func (r *API) CheckServerTime() (int64, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/time", nil, model.EndpointSecurityTypeNone)
+	return r.CheckServerTimeContext(r.ctx)
+}
+
+// CheckServerTimeContext
+// CheckServerTime with context of the request
+func (r *API) CheckServerTimeContext(ctx context.Context) (int64, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, r.serverTimePath, nil, model.EndpointSecurityTypeNone)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -36,7 +49,13 @@ func (r *API) CheckServerTime() (int64, error) {
 // GET /api/v3/exchangeInfo
 // https://binance-docs.github.io/apidocs/spot/en/#exchange-information
 func (r *API) ExchangeInformation(param *model.ExchangeInformationParam) (*model.ExchangeInformation, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/exchangeInfo", param, model.EndpointSecurityTypeNone)
+	return r.ExchangeInformationContext(r.ctx, param)
+}
+
+// ExchangeInformationContext
+// ExchangeInformation with context of the request
+func (r *API) ExchangeInformationContext(ctx context.Context, param *model.ExchangeInformationParam) (*model.ExchangeInformation, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/exchangeInfo", param, model.EndpointSecurityTypeNone)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -51,6 +70,9 @@ func (r *API) ExchangeInformation(param *model.ExchangeInformationParam) (*model
 		}
 		return nil, err
 	}
+	if r.rateLimiter != nil && len(body.RateLimits) > 0 {
+		r.rateLimiter.SetRateLimits(body.RateLimits)
+	}
 
 	return body, nil
 }
@@ -59,7 +81,13 @@ func (r *API) ExchangeInformation(param *model.ExchangeInformationParam) (*model
 // GET /api/v3/depth
 // https://binance-docs.github.io/apidocs/spot/en/#order-book
 func (r *API) OrderBook(param *model.OrderBookParam) (*model.OrderBook, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/depth", param, model.EndpointSecurityTypeNone)
+	return r.OrderBookContext(r.ctx, param)
+}
+
+// OrderBookContext
+// OrderBook with context of the request
+func (r *API) OrderBookContext(ctx context.Context, param *model.OrderBookParam) (*model.OrderBook, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/depth", param, model.EndpointSecurityTypeNone)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -75,7 +103,13 @@ func (r *API) OrderBook(param *model.OrderBookParam) (*model.OrderBook, error) {
 // GET /api/v3/trades
 // https://binance-docs.github.io/apidocs/spot/en/#recent-trades-list
 func (r *API) RecentTradesList(param *model.RecentTradeParam) ([]*model.RecentTrade, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/trades", param, model.EndpointSecurityTypeNone)
+	return r.RecentTradesListContext(r.ctx, param)
+}
+
+// RecentTradesListContext
+// RecentTradesList with context of the request
+func (r *API) RecentTradesListContext(ctx context.Context, param *model.RecentTradeParam) ([]*model.RecentTrade, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/trades", param, model.EndpointSecurityTypeNone)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Debug(err.Error())
@@ -91,7 +125,13 @@ func (r *API) RecentTradesList(param *model.RecentTradeParam) ([]*model.RecentTr
 // GET /api/v3/historicalTrades
 // https://binance-docs.github.io/apidocs/spot/en/#old-trade-lookup-market_data
 func (r *API) HistoricalTrades(param *model.OldTradeLookupParam) ([]*model.OldTradeLookup, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/historicalTrades", param, model.EndpointSecurityTypeMarketData)
+	return r.HistoricalTradesContext(r.ctx, param)
+}
+
+// HistoricalTradesContext
+// HistoricalTrades with context of the request
+func (r *API) HistoricalTradesContext(ctx context.Context, param *model.OldTradeLookupParam) ([]*model.OldTradeLookup, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/historicalTrades", param, model.EndpointSecurityTypeMarketData)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -108,7 +148,13 @@ func (r *API) HistoricalTrades(param *model.OldTradeLookupParam) ([]*model.OldTr
 // GET /api/v3/aggTrades
 // https://binance-docs.github.io/apidocs/spot/en/#compressed-aggregate-trades-list
 func (r *API) AggTrades(param *model.AggregateTradeParam) ([]*model.AggregateTrade, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/aggTrades", param, model.EndpointSecurityTypeNone)
+	return r.AggTradesContext(r.ctx, param)
+}
+
+// AggTradesContext
+// AggTrades with context of the request
+func (r *API) AggTradesContext(ctx context.Context, param *model.AggregateTradeParam) ([]*model.AggregateTrade, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/aggTrades", param, model.EndpointSecurityTypeNone)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -125,7 +171,13 @@ func (r *API) AggTrades(param *model.AggregateTradeParam) ([]*model.AggregateTra
 // GET /api/v3/klines
 // https://binance-docs.github.io/apidocs/spot/en/#kline-candlestick-data
 func (r *API) Klines(param *model.KlineParam) ([]*model.Kline, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/klines", param, model.EndpointSecurityTypeNone)
+	return r.KlinesContext(r.ctx, param)
+}
+
+// KlinesContext
+// Klines with context of the request
+func (r *API) KlinesContext(ctx context.Context, param *model.KlineParam) ([]*model.Kline, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/klines", param, model.EndpointSecurityTypeNone)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -141,7 +193,13 @@ func (r *API) Klines(param *model.KlineParam) ([]*model.Kline, error) {
 // GET /api/v3/avgPrice
 // https://binance-docs.github.io/apidocs/spot/en/#current-average-price
 func (r *API) AveragePrice(param *model.AveragePriceParam) (*model.AveragePrice, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/avgPrice", param, model.EndpointSecurityTypeNone)
+	return r.AveragePriceContext(r.ctx, param)
+}
+
+// AveragePriceContext
+// AveragePrice with context of the request
+func (r *API) AveragePriceContext(ctx context.Context, param *model.AveragePriceParam) (*model.AveragePrice, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/avgPrice", param, model.EndpointSecurityTypeNone)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -165,7 +223,13 @@ func (r *API) AveragePrice(param *model.AveragePriceParam) (*model.AveragePrice,
 // GET /api/v3/ticker/24hr
 // https://binance-docs.github.io/apidocs/spot/en/#24hr-ticker-price-change-statistics
 func (r *API) Ticker24hr(param *model.Ticker24hrParam) ([]*model.Ticker24hr, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/ticker/24hr", param, model.EndpointSecurityTypeNone)
+	return r.Ticker24hrContext(r.ctx, param)
+}
+
+// Ticker24hrContext
+// Ticker24hr with context of the request
+func (r *API) Ticker24hrContext(ctx context.Context, param *model.Ticker24hrParam) ([]*model.Ticker24hr, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/ticker/24hr", param, model.EndpointSecurityTypeNone)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -181,7 +245,13 @@ func (r *API) Ticker24hr(param *model.Ticker24hrParam) ([]*model.Ticker24hr, err
 // GET /api/v3/ticker/price
 // https://binance-docs.github.io/apidocs/spot/en/#symbol-price-ticker
 func (r *API) TickerPrice(param *model.TickerPriceParam) ([]*model.TickerPrice, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/ticker/price", param, model.EndpointSecurityTypeNone)
+	return r.TickerPriceContext(r.ctx, param)
+}
+
+// TickerPriceContext
+// TickerPrice with context of the request
+func (r *API) TickerPriceContext(ctx context.Context, param *model.TickerPriceParam) ([]*model.TickerPrice, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/ticker/price", param, model.EndpointSecurityTypeNone)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
@@ -197,7 +267,13 @@ func (r *API) TickerPrice(param *model.TickerPriceParam) ([]*model.TickerPrice,
 // GET /api/v3/ticker/bookTicker
 // https://binance-docs.github.io/apidocs/spot/en/#symbol-order-book-ticker
 func (r *API) BookTicker(param *model.BookTickerParam) ([]*model.BookTicker, error) {
-	bytes, err := r.sendRequest(http.MethodGet, "/api/v3/ticker/bookTicker", param, model.EndpointSecurityTypeNone)
+	return r.BookTickerContext(r.ctx, param)
+}
+
+// BookTickerContext
+// BookTicker with context of the request
+func (r *API) BookTickerContext(ctx context.Context, param *model.BookTickerParam) ([]*model.BookTicker, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/api/v3/ticker/bookTicker", param, model.EndpointSecurityTypeNone)
 	if err != nil {
 		if r.logger.CanDebug() {
 			r.logger.Error(err.Error())
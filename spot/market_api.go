@@ -0,0 +1,183 @@
+package spot
+
+import (
+	"context"
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"net/http"
+)
+
+// EndpointWeight
+// request weight and order count of an endpoint, see RateLimiter.SetEndpointWeight and SetEndpointOrders
+type EndpointWeight struct {
+	HttpMethod string
+	UrlPath    string
+	Weight     int64
+	Orders     int64
+}
+
+// MarketConfig
+// endpoints and limits of a market built on API, e.g. USDⓈ-M and COIN-M futures
+type MarketConfig struct {
+	// ServerTimePath (string): server time endpoint synced by the clock, e.g. /fapi/v1/time
+	ServerTimePath string
+	// ListenKeyPath (string): listenKey endpoint bound to the API-key, e.g. /fapi/v1/listenKey
+	ListenKeyPath string
+	// RateLimits: limits of the market, replaced by ExchangeInformation.RateLimits once it is loaded
+	RateLimits      []*model.RateLimit
+	EndpointWeights []*EndpointWeight
+}
+
+// NewRateLimiter
+// rate limiter with limits and endpoint weights of the market, /sapi is not limited
+func (c *MarketConfig) NewRateLimiter(configs ...RateLimiterConfig) *RateLimiter {
+	config := RateLimiterConfig{}
+	if len(configs) > 0 {
+		config = configs[0]
+	}
+	if len(config.RateLimits) == 0 {
+		config.RateLimits = c.RateLimits
+	}
+	if config.SapiRateLimits == nil {
+		config.SapiRateLimits = []*model.RateLimit{}
+	}
+
+	limiter := NewRateLimiter(config)
+	for _, w := range c.EndpointWeights {
+		limiter.SetEndpointWeight(w.HttpMethod, w.UrlPath, w.Weight)
+		limiter.SetEndpointOrders(w.HttpMethod, w.UrlPath, w.Orders)
+	}
+	return limiter
+}
+
+// MarketAPI
+// base of the clients of other markets, e.g. futures.API.
+// Signing, clock, rate limit, retry and error handling are shared with API
+type MarketAPI struct {
+	api         *API
+	config      *MarketConfig
+	rateLimiter *RateLimiter
+}
+
+// NewMarketAPI
+// options of API, the base url of the market is passed by WithBaseUrl
+func NewMarketAPI(key string, secret string, config *MarketConfig, opts ...APIOption) (*MarketAPI, error) {
+	api, err := NewAPI(key, secret, append([]APIOption{WithServerTimePath(config.ServerTimePath)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &MarketAPI{
+		api:    api,
+		config: config,
+	}
+	r.SetRateLimiter(config.NewRateLimiter())
+
+	return r, nil
+}
+
+// API
+// the underlying API, e.g. Request of an endpoint not covered by the market client
+func (r *MarketAPI) API() *API {
+	return r.api
+}
+
+// Clock
+// server time estimation used to sign requests
+func (r *MarketAPI) Clock() *lib.Clock {
+	return r.api.Clock()
+}
+
+// Close
+// stop the clock created by NewAPI and close idle connections
+func (r *MarketAPI) Close() {
+	r.api.Close()
+}
+
+// RateLimiter
+// limiter of the requests, nil when it is disabled
+func (r *MarketAPI) RateLimiter() *RateLimiter {
+	return r.rateLimiter
+}
+
+// SetRateLimiter
+// replace the default limiter of MarketConfig.NewRateLimiter, nil disables the throttling
+func (r *MarketAPI) SetRateLimiter(limiter *RateLimiter) {
+	r.rateLimiter = limiter
+	r.api.SetRateLimiter(limiter)
+}
+
+// RateLimitUsage
+// used request weight and order count of each limit, nil when rate limiter is disabled
+func (r *MarketAPI) RateLimitUsage() []*RateLimitUsage {
+	return r.api.RateLimitUsage()
+}
+
+// SetRetryPolicy
+// replace the default BackoffRetryPolicy, nil disables the retry
+func (r *MarketAPI) SetRetryPolicy(policy RetryPolicy) {
+	r.api.SetRetryPolicy(policy)
+}
+
+// CreateListenKey
+// Start User Data Stream (USER_STREAM)
+// Start a new user data stream. The stream will close after 60 minutes unless a keepalive is sent.
+// If the account has an active listenKey, that listenKey will be returned and its validity will be extended for 60 minutes.
+// POST ListenKeyPath, e.g. /fapi/v1/listenKey
+func (r *MarketAPI) CreateListenKey() (string, error) {
+	return r.CreateListenKeyContext(r.api.Context())
+}
+
+// CreateListenKeyContext
+// CreateListenKey with context of the request
+func (r *MarketAPI) CreateListenKeyContext(ctx context.Context) (string, error) {
+	bytes, err := r.api.Request(ctx, http.MethodPost, r.config.ListenKeyPath, nil, model.EndpointSecurityTypeUserStream)
+	if err != nil {
+		if r.api.logger.CanDebug() {
+			r.api.logger.Error(err.Error())
+		}
+		return "", err
+	}
+
+	return r.api.parser.ParseListenKey(bytes)
+}
+
+// KeepAliveListenKey
+// Keepalive User Data Stream (USER_STREAM)
+// Keepalive a user data stream to prevent a time out. User data streams will close after 60 minutes.
+// listenKey of the market is bound to the API-key, the argument is kept for websocket.ListenKeyService
+// PUT ListenKeyPath
+func (r *MarketAPI) KeepAliveListenKey(listenKey string) error {
+	return r.KeepAliveListenKeyContext(r.api.Context(), listenKey)
+}
+
+// KeepAliveListenKeyContext
+// KeepAliveListenKey with context of the request
+func (r *MarketAPI) KeepAliveListenKeyContext(ctx context.Context, listenKey string) error {
+	_, err := r.api.Request(ctx, http.MethodPut, r.config.ListenKeyPath, nil, model.EndpointSecurityTypeUserStream)
+	if err != nil {
+		if r.api.logger.CanDebug() {
+			r.api.logger.Error(err.Error())
+		}
+	}
+	return err
+}
+
+// CloseListenKey
+// Close User Data Stream (USER_STREAM)
+// DELETE ListenKeyPath
+func (r *MarketAPI) CloseListenKey(listenKey string) error {
+	return r.CloseListenKeyContext(r.api.Context(), listenKey)
+}
+
+// CloseListenKeyContext
+// CloseListenKey with context of the request
+func (r *MarketAPI) CloseListenKeyContext(ctx context.Context, listenKey string) error {
+	_, err := r.api.Request(ctx, http.MethodDelete, r.config.ListenKeyPath, nil, model.EndpointSecurityTypeUserStream)
+	if err != nil {
+		if r.api.logger.CanDebug() {
+			r.api.logger.Error(err.Error())
+		}
+	}
+	return err
+}
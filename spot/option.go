@@ -0,0 +1,173 @@
+package spot
+
+import (
+	"context"
+	"crypto/tls"
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type APIOption func(config *APIConfig)
+
+// WithBaseUrl
+// By default, https://api.binance.com
+func WithBaseUrl(baseUrl string) APIOption {
+	return func(config *APIConfig) {
+		config.baseUrl = baseUrl
+	}
+}
+
+// WithTimeout
+// the time waiting for server response, ignored when WithHTTPClient is used.
+// By default, no limit
+func WithTimeout(timeout time.Duration) APIOption {
+	return func(config *APIConfig) {
+		config.timeout = timeout
+	}
+}
+
+// WithProxies
+// Dictionary mapping protocol to the URL of the proxy. e.g. {"http": "http://1.2.3.4:8080", "https": "http://1.2.3.4:8443"}
+func WithProxies(proxies map[string]string) APIOption {
+	return func(config *APIConfig) {
+		config.proxies = proxies
+	}
+}
+
+// WithLogLevel
+// By default, lib.LogLevelDebug
+func WithLogLevel(logLevel lib.LogLevel) APIOption {
+	return func(config *APIConfig) {
+		config.logLevel = logLevel
+	}
+}
+
+// WithContext
+// context of the methods without Context suffix.
+// By default, context.Background()
+func WithContext(ctx context.Context) APIOption {
+	return func(config *APIConfig) {
+		config.ctx = ctx
+	}
+}
+
+// WithSigner
+// sign SIGNED endpoints by RSA or Ed25519 key instead of API-secret,
+// e.g. signer of lib.NewEd25519SignerFromFile, secret of NewAPI can be empty
+func WithSigner(signer lib.Signer) APIOption {
+	return func(config *APIConfig) {
+		config.signer = signer
+	}
+}
+
+// WithClock
+// share one clock between clients, the clock is synced by SIGNED requests when it is stale
+// and the caller is responsible for Start and Close
+func WithClock(clock *lib.Clock) APIOption {
+	return func(config *APIConfig) {
+		config.clock = clock
+	}
+}
+
+// WithServerTimePath
+// server time endpoint of the base url, e.g. /fapi/v1/time.
+// By default, /api/v3/time
+func WithServerTimePath(path string) APIOption {
+	return func(config *APIConfig) {
+		config.serverTimePath = path
+	}
+}
+
+// WithHTTPClient
+// use the client as is, transport options are ignored
+func WithHTTPClient(client *http.Client) APIOption {
+	return func(config *APIConfig) {
+		config.httpClient = client
+	}
+}
+
+// WithTransport
+// use the round tripper as is, proxies, pool, HTTP/2 and TLS options are ignored
+func WithTransport(transport http.RoundTripper) APIOption {
+	return func(config *APIConfig) {
+		config.transport = transport
+	}
+}
+
+// WithConnectionPool
+// idle connections kept for keep-alive, 0 keeps the default of http.DefaultTransport
+func WithConnectionPool(maxIdleConns int, maxIdleConnsPerHost int, idleConnTimeout time.Duration) APIOption {
+	return func(config *APIConfig) {
+		config.maxIdleConns = maxIdleConns
+		config.maxIdleConnsPerHost = maxIdleConnsPerHost
+		config.idleConnTimeout = idleConnTimeout
+	}
+}
+
+// WithHTTP2
+// By default, HTTP/2 is enabled
+func WithHTTP2(enabled bool) APIOption {
+	return func(config *APIConfig) {
+		config.disableHTTP2 = !enabled
+	}
+}
+
+// WithTLSConfig
+// e.g. client certificate or custom root CAs
+func WithTLSConfig(tlsConfig *tls.Config) APIOption {
+	return func(config *APIConfig) {
+		config.tlsConfig = tlsConfig
+	}
+}
+
+// newHTTPClient
+// the client is created once and shared by every request
+func newHTTPClient(config *APIConfig) (*http.Client, error) {
+	if config.httpClient != nil {
+		return config.httpClient, nil
+	}
+	if config.transport != nil {
+		return &http.Client{
+			Transport: config.transport,
+			Timeout:   config.timeout,
+		}, nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(config.proxies) > 0 {
+		proxies := make(map[string]*url.URL, len(config.proxies))
+		for scheme, proxy := range config.proxies {
+			urlProxy, err := url.Parse(proxy)
+			if err != nil {
+				return nil, err
+			}
+			proxies[scheme] = urlProxy
+		}
+		transport.Proxy = func(req *http.Request) (*url.URL, error) {
+			return proxies[req.URL.Scheme], nil
+		}
+	}
+	if config.maxIdleConns > 0 {
+		transport.MaxIdleConns = config.maxIdleConns
+	}
+	if config.maxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = config.maxIdleConnsPerHost
+	}
+	if config.idleConnTimeout > 0 {
+		transport.IdleConnTimeout = config.idleConnTimeout
+	}
+	if config.tlsConfig != nil {
+		transport.TLSClientConfig = config.tlsConfig
+	}
+	if config.disableHTTP2 {
+		transport.ForceAttemptHTTP2 = false
+		transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   config.timeout,
+	}, nil
+}
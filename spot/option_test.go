@@ -0,0 +1,101 @@
+package spot
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+)
+
+func TestNewHTTPClient_Proxies(t *testing.T) {
+	client, err := newHTTPClient(&APIConfig{
+		proxies: map[string]string{
+			"http":  "http://1.2.3.4:8080",
+			"https": "http://1.2.3.4:8443",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transport := client.Transport.(*http.Transport)
+	for target, want := range map[string]string{
+		"http://api.binance.com/api/v3/ping":  "http://1.2.3.4:8080",
+		"https://api.binance.com/api/v3/ping": "http://1.2.3.4:8443",
+	} {
+		req, _ := http.NewRequest(http.MethodGet, target, nil)
+		proxy, err := transport.Proxy(req)
+		if err != nil || proxy == nil || proxy.String() != want {
+			t.Errorf("proxy of %s = %v, %v", target, proxy, err)
+		}
+	}
+
+	if _, err := newHTTPClient(&APIConfig{proxies: map[string]string{"https": "://invalid"}}); err == nil {
+		t.Error("invalid proxy should be rejected")
+	}
+}
+
+func TestAPI_KeepAlive(t *testing.T) {
+	var connections int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"serverTime":1499827319559}`))
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&connections, 1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	api, err := NewAPI("key", "secret", WithBaseUrl(server.URL), WithConnectionPool(10, 10, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer api.Close()
+	for i := 0; i < 5; i++ {
+		if err := api.Ping(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := atomic.LoadInt32(&connections); n != 1 {
+		t.Errorf("connections = %d, want 1", n)
+	}
+}
+
+func TestAPI_WithSigner(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := lib.NewEd25519Signer(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var query url.Values
+	api, _ := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{}`))
+	}, WithSigner(signer))
+
+	if err := api.NewOrderTest(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	payload := url.Values{"timestamp": {query.Get("timestamp")}}.Encode()
+	want, _ := signer.Sign(payload)
+	if query.Get("signature") != want {
+		t.Errorf("signature = %s, want %s", query.Get("signature"), want)
+	}
+
+	if _, err := NewAPI("key", ""); err == nil {
+		t.Error("secret should be required without signer")
+	}
+}
@@ -0,0 +1,462 @@
+package spot
+
+import (
+	"context"
+	"fmt"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type RateLimitMode = string
+
+var (
+	// RateLimitModeBlock
+	// wait until the window of the exceeded limit is reset
+	RateLimitModeBlock = RateLimitMode("BLOCK")
+	// RateLimitModeFailFast
+	// return *RateLimitError without sending the request
+	RateLimitModeFailFast = RateLimitMode("FAIL_FAST")
+)
+
+// RateLimiterSapiWeight
+// IP weight of /sapi endpoints, counted apart from REQUEST_WEIGHT of /api and synced by X-SAPI-USED-IP-WEIGHT-*
+var RateLimiterSapiWeight = model.RateLimiter("SAPI_IP_WEIGHT")
+
+// DefaultRateLimits
+// limits of api.binance.com, replaced by ExchangeInformation.RateLimits once it is loaded
+var DefaultRateLimits = []*model.RateLimit{
+	{RateLimitType: model.RateLimiterWeight, Interval: model.RateLimitIntervalMinute, IntervalNum: 1, Limit: 1200},
+	{RateLimitType: model.RateLimiterOrders, Interval: model.RateLimitIntervalSecond, IntervalNum: 10, Limit: 50},
+	{RateLimitType: model.RateLimiterOrders, Interval: model.RateLimitIntervalDay, IntervalNum: 1, Limit: 160000},
+	{RateLimitType: model.RateLimiterRawRequests, Interval: model.RateLimitIntervalMinute, IntervalNum: 5, Limit: 6100},
+}
+
+// DefaultSapiRateLimits
+// IP weight limit of /sapi endpoints of api.binance.com
+var DefaultSapiRateLimits = []*model.RateLimit{
+	{RateLimitType: RateLimiterSapiWeight, Interval: model.RateLimitIntervalMinute, IntervalNum: 1, Limit: 12000},
+}
+
+type RateLimiterConfig struct {
+	// By default, RateLimitModeBlock
+	Mode RateLimitMode
+	// By default, DefaultRateLimits
+	RateLimits []*model.RateLimit
+	// By default (nil), DefaultSapiRateLimits. Empty for a market without /sapi, e.g. futures
+	SapiRateLimits []*model.RateLimit
+}
+
+type RateLimitUsage struct {
+	RateLimitType model.RateLimiter
+	Interval      model.RateLimitInterval
+	IntervalNum   int64
+	Limit         int64
+	Used          int64
+	ResetAt       time.Time
+}
+
+type rateLimitWindow struct {
+	limit    *model.RateLimit
+	duration time.Duration
+	start    time.Time
+	used     int64
+}
+
+func (w *rateLimitWindow) advance(now time.Time) {
+	if start := now.Truncate(w.duration); start.After(w.start) {
+		w.start = start
+		w.used = 0
+	}
+}
+
+// endpointWeight
+// weightFunc is used when the weight depends on parameters
+type endpointWeight struct {
+	weight     int64
+	weightFunc func(params url.Values) int64
+	orders     int64
+}
+
+// https://binance-docs.github.io/apidocs/spot/en/#limits
+var endpointWeights = map[string]endpointWeight{
+	"GET /api/v3/exchangeInfo":         {weight: 10},
+	"GET /api/v3/depth":                {weightFunc: depthWeight},
+	"GET /api/v3/historicalTrades":     {weight: 5},
+	"GET /api/v3/ticker/24hr":          {weightFunc: symbolWeight(1, 40)},
+	"GET /api/v3/ticker/price":         {weightFunc: symbolWeight(1, 2)},
+	"GET /api/v3/ticker/bookTicker":    {weightFunc: symbolWeight(1, 2)},
+	"POST /api/v3/order":               {weight: 1, orders: 1},
+	"POST /api/v3/order/oco":           {weight: 1, orders: 2},
+	"POST /api/v3/order/cancelReplace": {weight: 1, orders: 1},
+	"GET /api/v3/order":                {weight: 2},
+	"GET /api/v3/openOrders":           {weightFunc: symbolWeight(3, 40)},
+	"GET /api/v3/allOrders":            {weight: 10},
+	"GET /api/v3/orderList":            {weight: 2},
+	"GET /api/v3/allOrderList":         {weight: 10},
+	"GET /api/v3/openOrderList":        {weight: 3},
+	"GET /api/v3/account":              {weight: 10},
+	"GET /api/v3/myTrades":             {weight: 10},
+	"GET /api/v3/rateLimit/order":      {weight: 20},
+}
+
+func depthWeight(params url.Values) int64 {
+	limit, _ := strconv.ParseInt(params.Get("limit"), 10, 64)
+	switch {
+	case limit > 1000:
+		return 50
+	case limit > 500:
+		return 10
+	case limit > 100:
+		return 5
+	}
+	return 1
+}
+
+func symbolWeight(withSymbol int64, withoutSymbol int64) func(url.Values) int64 {
+	return func(params url.Values) int64 {
+		if params.Get("symbol") != "" {
+			return withSymbol
+		}
+		return withoutSymbol
+	}
+}
+
+// RateLimiter
+// count request weight and orders locally, corrected by X-MBX-USED-WEIGHT-* and X-MBX-ORDER-COUNT-* of each response.
+// Requests of /sapi are counted by the windows of SapiRateLimits only
+type RateLimiter struct {
+	mu          sync.Mutex
+	mode        RateLimitMode
+	windows     []*rateLimitWindow
+	sapiWindows []*rateLimitWindow
+	weights     map[string]endpointWeight
+	bannedTo    time.Time
+	timeNow     func() time.Time
+	timeSleep   func(context.Context, time.Duration) error
+}
+
+func NewRateLimiter(configs ...RateLimiterConfig) *RateLimiter {
+	config := RateLimiterConfig{}
+	if len(configs) > 0 {
+		config = configs[0]
+	}
+	if len(config.Mode) == 0 {
+		config.Mode = RateLimitModeBlock
+	}
+	if len(config.RateLimits) == 0 {
+		config.RateLimits = DefaultRateLimits
+	}
+	if config.SapiRateLimits == nil {
+		config.SapiRateLimits = DefaultSapiRateLimits
+	}
+
+	l := &RateLimiter{
+		mode:      config.Mode,
+		weights:   make(map[string]endpointWeight),
+		timeNow:   time.Now,
+		timeSleep: sleepContext,
+	}
+	l.SetRateLimits(config.RateLimits)
+	l.sapiWindows = newRateLimitWindows(config.SapiRateLimits, nil)
+	return l
+}
+
+// SetRateLimits
+// replace the limits, used counters of the same limit are kept
+func (l *RateLimiter) SetRateLimits(rateLimits []*model.RateLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.windows = newRateLimitWindows(rateLimits, l.windows)
+}
+
+// newRateLimitWindows
+// windows of rateLimits, used counters are copied from the window of the same limit in previous
+func newRateLimitWindows(rateLimits []*model.RateLimit, previous []*rateLimitWindow) []*rateLimitWindow {
+	windows := make([]*rateLimitWindow, 0, len(rateLimits))
+	for _, limit := range rateLimits {
+		duration := intervalDuration(limit.Interval, limit.IntervalNum)
+		if duration <= 0 || limit.Limit <= 0 {
+			continue
+		}
+
+		window := &rateLimitWindow{limit: limit, duration: duration}
+		if old := findWindow(previous, limit.RateLimitType, duration); old != nil {
+			window.start = old.start
+			window.used = old.used
+		}
+		windows = append(windows, window)
+	}
+	return windows
+}
+
+// SetEndpointWeight
+// override weight of an endpoint, e.g. SetEndpointWeight(http.MethodGet, "/sapi/v1/capital/config/getall", 10)
+func (l *RateLimiter) SetEndpointWeight(httpMethod string, urlPath string, weight int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	w := l.endpoint(httpMethod, urlPath)
+	w.weight = weight
+	w.weightFunc = nil
+	l.weights[httpMethod+" "+urlPath] = w
+}
+
+// SetEndpointOrders
+// override number of orders counted by an endpoint, e.g. SetEndpointOrders(http.MethodPost, "/fapi/v1/order", 1)
+func (l *RateLimiter) SetEndpointOrders(httpMethod string, urlPath string, orders int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	w := l.endpoint(httpMethod, urlPath)
+	w.orders = orders
+	l.weights[httpMethod+" "+urlPath] = w
+}
+
+// Weight
+// request weight and number of orders of an endpoint, weight is 1 when the endpoint is unknown
+func (l *RateLimiter) Weight(httpMethod string, urlPath string, params url.Values) (int64, int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	w := l.endpoint(httpMethod, urlPath)
+	if w.weightFunc != nil {
+		return w.weightFunc(params), w.orders
+	}
+	if w.weight <= 0 {
+		return 1, w.orders
+	}
+	return w.weight, w.orders
+}
+
+// Acquire
+// reserve weight and orders of /api before sending a request, waiting is stopped when ctx is done
+func (l *RateLimiter) Acquire(ctx context.Context, weight int64, orders int64) error {
+	return l.acquire(ctx, false, weight, orders)
+}
+
+// AcquireEndpoint
+// reserve Weight of the endpoint, the weight of /sapi is counted by the windows of SapiRateLimits
+func (l *RateLimiter) AcquireEndpoint(ctx context.Context, httpMethod string, urlPath string, params url.Values) error {
+	weight, orders := l.Weight(httpMethod, urlPath, params)
+	return l.acquire(ctx, isSapiPath(urlPath), weight, orders)
+}
+
+func isSapiPath(urlPath string) bool {
+	return strings.HasPrefix(urlPath, "/sapi/")
+}
+
+func (l *RateLimiter) acquire(ctx context.Context, sapi bool, weight int64, orders int64) error {
+	for {
+		wait, err := l.tryAcquire(sapi, weight, orders)
+		if err != nil || wait <= 0 {
+			return err
+		}
+		if err = l.timeSleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+func (l *RateLimiter) tryAcquire(sapi bool, weight int64, orders int64) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	windows := l.windows
+	if sapi {
+		windows = l.sapiWindows
+	}
+
+	now := l.timeNow()
+	if now.Before(l.bannedTo) {
+		return 0, &RateLimitError{
+			RateLimitType: model.RateLimiterWeight,
+			RetryAfter:    l.bannedTo.Sub(now),
+			Banned:        true,
+		}
+	}
+
+	for _, w := range windows {
+		w.advance(now)
+		if n := w.cost(weight, orders); n > 0 && w.used > 0 && w.used+n > w.limit.Limit {
+			resetAt := w.start.Add(w.duration)
+			if l.mode == RateLimitModeFailFast {
+				return 0, &RateLimitError{
+					RateLimitType: w.limit.RateLimitType,
+					Interval:      w.limit.Interval,
+					IntervalNum:   w.limit.IntervalNum,
+					Limit:         w.limit.Limit,
+					Used:          w.used,
+					RetryAfter:    resetAt.Sub(now),
+				}
+			}
+			return resetAt.Sub(now), nil
+		}
+	}
+
+	for _, w := range windows {
+		w.used += w.cost(weight, orders)
+	}
+	return 0, nil
+}
+
+// Update
+// sync used counters with the response headers of server
+func (l *RateLimiter) Update(header http.Header) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.timeNow()
+	for key, values := range header {
+		if len(values) == 0 {
+			continue
+		}
+
+		var rateLimitType model.RateLimiter
+		windows := l.windows
+		lowerKey := strings.ToLower(key)
+		switch {
+		case strings.HasPrefix(lowerKey, "x-mbx-used-weight-"):
+			rateLimitType = model.RateLimiterWeight
+		case strings.HasPrefix(lowerKey, "x-mbx-order-count-"):
+			rateLimitType = model.RateLimiterOrders
+		case strings.HasPrefix(lowerKey, "x-sapi-used-ip-weight-"):
+			rateLimitType = RateLimiterSapiWeight
+			windows = l.sapiWindows
+		default:
+			continue
+		}
+
+		duration := headerIntervalDuration(lowerKey[strings.LastIndex(lowerKey, "-")+1:])
+		used, err := strconv.ParseInt(values[0], 10, 64)
+		if duration <= 0 || err != nil {
+			continue
+		}
+		if w := findWindow(windows, rateLimitType, duration); w != nil {
+			w.advance(now)
+			w.used = used
+		}
+	}
+}
+
+// Ban
+// reject every request until the time without waiting, e.g. after HTTP 418
+func (l *RateLimiter) Ban(retryAfter time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until := l.timeNow().Add(retryAfter); until.After(l.bannedTo) {
+		l.bannedTo = until
+	}
+}
+
+// Usage
+// current used counters of each limit, including the limits of /sapi
+func (l *RateLimiter) Usage() []*RateLimitUsage {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.timeNow()
+	usages := make([]*RateLimitUsage, 0, len(l.windows)+len(l.sapiWindows))
+	for _, w := range append(append([]*rateLimitWindow(nil), l.windows...), l.sapiWindows...) {
+		w.advance(now)
+		usages = append(usages, &RateLimitUsage{
+			RateLimitType: w.limit.RateLimitType,
+			Interval:      w.limit.Interval,
+			IntervalNum:   w.limit.IntervalNum,
+			Limit:         w.limit.Limit,
+			Used:          w.used,
+			ResetAt:       w.start.Add(w.duration),
+		})
+	}
+	return usages
+}
+
+func (l *RateLimiter) endpoint(httpMethod string, urlPath string) endpointWeight {
+	key := httpMethod + " " + urlPath
+	if w, ok := l.weights[key]; ok {
+		return w
+	}
+	return endpointWeights[key]
+}
+
+func findWindow(windows []*rateLimitWindow, rateLimitType model.RateLimiter, duration time.Duration) *rateLimitWindow {
+	for _, w := range windows {
+		if w.limit.RateLimitType == rateLimitType && w.duration == duration {
+			return w
+		}
+	}
+	return nil
+}
+
+func (w *rateLimitWindow) cost(weight int64, orders int64) int64 {
+	switch w.limit.RateLimitType {
+	case model.RateLimiterWeight, RateLimiterSapiWeight:
+		return weight
+	case model.RateLimiterOrders:
+		return orders
+	case model.RateLimiterRawRequests:
+		return 1
+	}
+	return 0
+}
+
+func intervalDuration(interval model.RateLimitInterval, intervalNum int64) time.Duration {
+	var unit time.Duration
+	switch interval {
+	case model.RateLimitIntervalSecond:
+		unit = time.Second
+	case model.RateLimitIntervalMinute:
+		unit = time.Minute
+	case model.RateLimitIntervalHour:
+		unit = time.Hour
+	case model.RateLimitIntervalDay:
+		unit = 24 * time.Hour
+	}
+	return unit * time.Duration(intervalNum)
+}
+
+// headerIntervalDuration
+// convert suffix of header e.g. 1m, 10s, 1d
+func headerIntervalDuration(suffix string) time.Duration {
+	if len(suffix) < 2 {
+		return 0
+	}
+	num, err := strconv.ParseInt(suffix[:len(suffix)-1], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	switch suffix[len(suffix)-1] {
+	case 's':
+		return intervalDuration(model.RateLimitIntervalSecond, num)
+	case 'm':
+		return intervalDuration(model.RateLimitIntervalMinute, num)
+	case 'h':
+		return intervalDuration(model.RateLimitIntervalHour, num)
+	case 'd':
+		return intervalDuration(model.RateLimitIntervalDay, num)
+	}
+	return 0
+}
+
+func (u *RateLimitUsage) String() string {
+	return fmt.Sprintf("%s %d%s %d/%d", u.RateLimitType, u.IntervalNum, u.Interval, u.Used, u.Limit)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
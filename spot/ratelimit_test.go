@@ -0,0 +1,157 @@
+package spot
+
+import (
+	"context"
+	"errors"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func newTestRateLimiter(mode RateLimitMode, now *time.Time) *RateLimiter {
+	l := NewRateLimiter(RateLimiterConfig{
+		Mode: mode,
+		RateLimits: []*model.RateLimit{
+			{RateLimitType: model.RateLimiterWeight, Interval: model.RateLimitIntervalMinute, IntervalNum: 1, Limit: 20},
+			{RateLimitType: model.RateLimiterOrders, Interval: model.RateLimitIntervalSecond, IntervalNum: 10, Limit: 2},
+		},
+	})
+	l.timeNow = func() time.Time { return *now }
+	l.timeSleep = func(_ context.Context, d time.Duration) error {
+		*now = now.Add(d)
+		return nil
+	}
+	return l
+}
+
+func TestRateLimiter_Weight(t *testing.T) {
+	l := NewRateLimiter()
+
+	cases := []struct {
+		method string
+		path   string
+		params url.Values
+		weight int64
+		orders int64
+	}{
+		{http.MethodGet, "/api/v3/ping", nil, 1, 0},
+		{http.MethodGet, "/api/v3/depth", url.Values{"limit": {"5000"}}, 50, 0},
+		{http.MethodGet, "/api/v3/depth", url.Values{"limit": {"100"}}, 1, 0},
+		{http.MethodGet, "/api/v3/openOrders", nil, 40, 0},
+		{http.MethodGet, "/api/v3/openOrders", url.Values{"symbol": {"BTCUSDT"}}, 3, 0},
+		{http.MethodPost, "/api/v3/order/oco", nil, 1, 2},
+	}
+	for _, c := range cases {
+		weight, orders := l.Weight(c.method, c.path, c.params)
+		if weight != c.weight || orders != c.orders {
+			t.Errorf("%s %s weight = %d, orders = %d", c.method, c.path, weight, orders)
+		}
+	}
+
+	l.SetEndpointWeight(http.MethodGet, "/api/v3/depth", 7)
+	if weight, _ := l.Weight(http.MethodGet, "/api/v3/depth", nil); weight != 7 {
+		t.Errorf("override weight = %d", weight)
+	}
+
+	l.SetEndpointOrders(http.MethodPost, "/fapi/v1/order", 1)
+	if weight, orders := l.Weight(http.MethodPost, "/fapi/v1/order", nil); weight != 1 || orders != 1 {
+		t.Errorf("override orders = %d, %d", weight, orders)
+	}
+}
+
+func TestRateLimiter_FailFast(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 30, 0, time.UTC)
+	l := newTestRateLimiter(RateLimitModeFailFast, &now)
+
+	if err := l.Acquire(context.Background(), 10, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Acquire(context.Background(), 1, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	var rateLimitError *RateLimitError
+	if err := l.Acquire(context.Background(), 1, 1); !errors.As(err, &rateLimitError) || rateLimitError.RateLimitType != model.RateLimiterOrders {
+		t.Fatalf("orders error = %v", err)
+	}
+	if rateLimitError.RetryAfter != 10*time.Second {
+		t.Errorf("retry after = %v", rateLimitError.RetryAfter)
+	}
+
+	header := http.Header{}
+	header.Set("X-MBX-USED-WEIGHT-1M", "19")
+	header.Set("X-MBX-ORDER-COUNT-10S", "0")
+	l.Update(header)
+
+	if err := l.Acquire(context.Background(), 2, 0); !errors.As(err, &rateLimitError) || rateLimitError.RateLimitType != model.RateLimiterWeight {
+		t.Fatalf("weight error = %v", err)
+	}
+	if err := l.Acquire(context.Background(), 1, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, usage := range l.Usage() {
+		if usage.RateLimitType == model.RateLimiterWeight && usage.Used != 20 {
+			t.Errorf("weight usage = %s", usage)
+		}
+	}
+}
+
+func TestRateLimiter_Block(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 30, 0, time.UTC)
+	l := newTestRateLimiter(RateLimitModeBlock, &now)
+
+	if err := l.Acquire(context.Background(), 20, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Acquire(context.Background(), 5, 0); err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2022, 1, 1, 0, 1, 0, 0, time.UTC); !now.Equal(want) {
+		t.Errorf("blocked until %v, want %v", now, want)
+	}
+	if usage := l.Usage()[0]; usage.Used != 5 {
+		t.Errorf("weight usage = %s", usage)
+	}
+}
+
+func TestRateLimiter_Sapi(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := NewRateLimiter(RateLimiterConfig{
+		Mode: RateLimitModeFailFast,
+		RateLimits: []*model.RateLimit{
+			{RateLimitType: model.RateLimiterWeight, Interval: model.RateLimitIntervalMinute, IntervalNum: 1, Limit: 20},
+		},
+		SapiRateLimits: []*model.RateLimit{
+			{RateLimitType: RateLimiterSapiWeight, Interval: model.RateLimitIntervalMinute, IntervalNum: 1, Limit: 10},
+		},
+	})
+	l.timeNow = func() time.Time { return now }
+	l.SetEndpointWeight(http.MethodGet, "/sapi/v1/capital/config/getall", 10)
+
+	ctx := context.Background()
+	if err := l.AcquireEndpoint(ctx, http.MethodGet, "/sapi/v1/capital/config/getall", nil); err != nil {
+		t.Fatal(err)
+	}
+	usages := l.Usage()
+	if len(usages) != 2 || usages[0].Used != 0 || usages[1].RateLimitType != RateLimiterSapiWeight || usages[1].Used != 10 {
+		t.Errorf("usages = %v", usages)
+	}
+
+	// window of /sapi is full, /api is not affected
+	var rateLimitError *RateLimitError
+	if err := l.AcquireEndpoint(ctx, http.MethodGet, "/sapi/v1/asset/assetDetail", nil); !errors.As(err, &rateLimitError) || rateLimitError.RateLimitType != RateLimiterSapiWeight {
+		t.Errorf("err = %v", err)
+	}
+	if err := l.AcquireEndpoint(ctx, http.MethodGet, "/api/v3/ping", nil); err != nil {
+		t.Errorf("err = %v", err)
+	}
+
+	l.Update(http.Header{"X-Sapi-Used-Ip-Weight-1m": {"3"}, "X-Mbx-Used-Weight-1m": {"5"}})
+	usages = l.Usage()
+	if usages[0].Used != 5 || usages[1].Used != 3 {
+		t.Errorf("usages = %v", usages)
+	}
+}
@@ -0,0 +1,218 @@
+package spot
+
+import (
+	"fmt"
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ExchangeInformationService
+// source of the registry, implemented by *API
+type ExchangeInformationService interface {
+	ExchangeInformation(param *model.ExchangeInformationParam) (*model.ExchangeInformation, error)
+}
+
+// SymbolStatusChange
+// OldStatus is empty for a new listed symbol and NewStatus is empty for a removed symbol
+type SymbolStatusChange struct {
+	Symbol    string
+	OldStatus model.SymbolStatus
+	NewStatus model.SymbolStatus
+	Info      *model.SymbolInfo
+}
+
+type SymbolStatusHandler = func(*SymbolStatusChange)
+
+type SymbolRegistryConfig struct {
+	// reload exchange information periodically, 0 is refresh on demand only
+	RefreshInterval time.Duration
+}
+
+// SymbolRegistry
+// cache of exchange information shared between API (as SymbolInfoProvider of OrderValidator)
+// and websocket.Stream (as SymbolChecker)
+type SymbolRegistry struct {
+	mu        sync.RWMutex
+	service   ExchangeInformationService
+	config    SymbolRegistryConfig
+	logger    *lib.BinanceLogger
+	info      *model.ExchangeInformation
+	symbols   map[string]*model.SymbolInfo
+	byBase    map[string][]*model.SymbolInfo
+	byQuote   map[string][]*model.SymbolInfo
+	updatedAt time.Time
+	handlers  []SymbolStatusHandler
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewSymbolRegistry
+// load exchange information once, then keep it refreshed when RefreshInterval is set
+func NewSymbolRegistry(service ExchangeInformationService, configs ...SymbolRegistryConfig) (*SymbolRegistry, error) {
+	r := &SymbolRegistry{
+		service:  service,
+		logger:   lib.NewLogger("symbol-registry", lib.LogLevelDebug),
+		symbols:  make(map[string]*model.SymbolInfo),
+		byBase:   make(map[string][]*model.SymbolInfo),
+		byQuote:  make(map[string][]*model.SymbolInfo),
+		handlers: make([]SymbolStatusHandler, 0),
+		done:     make(chan struct{}),
+	}
+	if len(configs) > 0 {
+		r.config = configs[0]
+	}
+
+	if err := r.Refresh(); err != nil {
+		return nil, err
+	}
+	if r.config.RefreshInterval > 0 {
+		go r.refreshLoop()
+	}
+
+	return r, nil
+}
+
+// OnStatusChange
+// handlers are called after each refresh for every symbol whose status changed
+func (r *SymbolRegistry) OnStatusChange(handler ...SymbolStatusHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.handlers = append(r.handlers, handler...)
+}
+
+// Refresh
+// reload exchange information now
+func (r *SymbolRegistry) Refresh() error {
+	info, err := r.service.ExchangeInformation(nil)
+	if err != nil {
+		return err
+	}
+
+	symbols := make(map[string]*model.SymbolInfo, len(info.Symbols))
+	byBase := make(map[string][]*model.SymbolInfo)
+	byQuote := make(map[string][]*model.SymbolInfo)
+	for _, s := range info.Symbols {
+		symbols[s.Symbol] = s
+		byBase[s.BaseAsset] = append(byBase[s.BaseAsset], s)
+		byQuote[s.QuoteAsset] = append(byQuote[s.QuoteAsset], s)
+	}
+
+	r.mu.Lock()
+	changes := make([]*SymbolStatusChange, 0)
+	if r.info != nil {
+		for name, s := range symbols {
+			if old, ok := r.symbols[name]; !ok || old.Status != s.Status {
+				change := &SymbolStatusChange{Symbol: name, NewStatus: s.Status, Info: s}
+				if ok {
+					change.OldStatus = old.Status
+				}
+				changes = append(changes, change)
+			}
+		}
+		for name, old := range r.symbols {
+			if _, ok := symbols[name]; !ok {
+				changes = append(changes, &SymbolStatusChange{Symbol: name, OldStatus: old.Status, Info: old})
+			}
+		}
+	}
+	r.info = info
+	r.symbols = symbols
+	r.byBase = byBase
+	r.byQuote = byQuote
+	r.updatedAt = time.Now()
+	handlers := r.handlers
+	r.mu.Unlock()
+
+	for _, change := range changes {
+		for _, handler := range handlers {
+			handler(change)
+		}
+	}
+
+	return nil
+}
+
+// ExchangeInformation
+// last loaded exchange information
+func (r *SymbolRegistry) ExchangeInformation() *model.ExchangeInformation {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.info
+}
+
+func (r *SymbolRegistry) UpdatedAt() time.Time {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.updatedAt
+}
+
+// SymbolInfo
+// symbol is case-insensitive, so the lower case symbol of stream name can be used
+func (r *SymbolRegistry) SymbolInfo(symbol string) (*model.SymbolInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	info, ok := r.symbols[strings.ToUpper(symbol)]
+	return info, ok
+}
+
+func (r *SymbolRegistry) HasSymbol(symbol string) bool {
+	_, ok := r.SymbolInfo(symbol)
+	return ok
+}
+
+// Symbols
+// all symbols, include symbols which are not trading
+func (r *SymbolRegistry) Symbols() []*model.SymbolInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.info == nil {
+		return nil
+	}
+	return append([]*model.SymbolInfo(nil), r.info.Symbols...)
+}
+
+func (r *SymbolRegistry) SymbolsByBaseAsset(asset string) []*model.SymbolInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]*model.SymbolInfo(nil), r.byBase[strings.ToUpper(asset)]...)
+}
+
+func (r *SymbolRegistry) SymbolsByQuoteAsset(asset string) []*model.SymbolInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]*model.SymbolInfo(nil), r.byQuote[strings.ToUpper(asset)]...)
+}
+
+// Close
+// stop the refresh loop
+func (r *SymbolRegistry) Close() {
+	r.closeOnce.Do(func() {
+		close(r.done)
+	})
+}
+
+func (r *SymbolRegistry) refreshLoop() {
+	ticker := time.NewTicker(r.config.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+			if err := r.Refresh(); err != nil {
+				r.logger.Error(fmt.Sprintf("refresh exchange information error: %s", err.Error()))
+			}
+		}
+	}
+}
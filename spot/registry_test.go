@@ -0,0 +1,81 @@
+package spot
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"sync"
+	"testing"
+)
+
+type fakeExchangeInformationService struct {
+	mu   sync.Mutex
+	info *model.ExchangeInformation
+}
+
+func (f *fakeExchangeInformationService) ExchangeInformation(*model.ExchangeInformationParam) (*model.ExchangeInformation, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.info, nil
+}
+
+func (f *fakeExchangeInformationService) set(symbols ...*model.SymbolInfo) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.info = &model.ExchangeInformation{Symbols: symbols}
+}
+
+func TestSymbolRegistry(t *testing.T) {
+	service := new(fakeExchangeInformationService)
+	service.set(
+		&model.SymbolInfo{Symbol: "ETHBTC", Status: model.SymbolStatusTrading, BaseAsset: "ETH", QuoteAsset: "BTC"},
+		&model.SymbolInfo{Symbol: "BNBBTC", Status: model.SymbolStatusTrading, BaseAsset: "BNB", QuoteAsset: "BTC"},
+		&model.SymbolInfo{Symbol: "LTCBTC", Status: model.SymbolStatusTrading, BaseAsset: "LTC", QuoteAsset: "BTC"},
+	)
+
+	registry, err := NewSymbolRegistry(service)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer registry.Close()
+
+	if !registry.HasSymbol("ethbtc") || registry.HasSymbol("ETHUSDT") {
+		t.Error("has symbol mismatch")
+	}
+	if symbols := registry.SymbolsByQuoteAsset("btc"); len(symbols) != 3 {
+		t.Errorf("symbols by quote asset = %d", len(symbols))
+	}
+	if symbols := registry.SymbolsByBaseAsset("BNB"); len(symbols) != 1 || symbols[0].Symbol != "BNBBTC" {
+		t.Errorf("symbols by base asset = %v", symbols)
+	}
+
+	changes := make(map[string]*SymbolStatusChange)
+	registry.OnStatusChange(func(change *SymbolStatusChange) {
+		changes[change.Symbol] = change
+	})
+
+	service.set(
+		&model.SymbolInfo{Symbol: "ETHBTC", Status: model.SymbolStatusBreak, BaseAsset: "ETH", QuoteAsset: "BTC"},
+		&model.SymbolInfo{Symbol: "BNBBTC", Status: model.SymbolStatusTrading, BaseAsset: "BNB", QuoteAsset: "BTC"},
+		&model.SymbolInfo{Symbol: "ETHUSDT", Status: model.SymbolStatusPreTrading, BaseAsset: "ETH", QuoteAsset: "USDT"},
+	)
+	if err := registry.Refresh(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(changes) != 3 {
+		t.Fatalf("changes = %d, want 3", len(changes))
+	}
+	if c := changes["ETHBTC"]; c.OldStatus != model.SymbolStatusTrading || c.NewStatus != model.SymbolStatusBreak {
+		t.Errorf("ETHBTC change = %v", c)
+	}
+	if c := changes["ETHUSDT"]; c.OldStatus != "" || c.NewStatus != model.SymbolStatusPreTrading {
+		t.Errorf("ETHUSDT change = %v", c)
+	}
+	if c := changes["LTCBTC"]; c.OldStatus != model.SymbolStatusTrading || c.NewStatus != "" {
+		t.Errorf("LTCBTC change = %v", c)
+	}
+	if registry.HasSymbol("LTCBTC") || len(registry.SymbolsByBaseAsset("ETH")) != 2 {
+		t.Error("index is not refreshed")
+	}
+}
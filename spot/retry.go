@@ -0,0 +1,114 @@
+package spot
+
+import (
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryRequest
+// the failed request passed to RetryPolicy
+type RetryRequest struct {
+	HttpMethod string
+	UrlPath    string
+	// Attempt (int): number of the failed attempt, start from 1
+	Attempt int
+}
+
+// RetryPolicy
+// decide whether a failed request is sent again and how long to wait before it
+type RetryPolicy interface {
+	Retry(request *RetryRequest, err error) (time.Duration, bool)
+}
+
+// BackoffRetryPolicy
+// retry the errors of IsRetryable which are safe to send again
+//   - HTTP 429 and other rate limit or timestamp errors: retry every method, the request was rejected before execution.
+//     HTTP 429 waits for Retry-After
+//   - HTTP 418: never retry, the IP is banned until Retry-After
+//   - HTTP 5xx, timeout and other retryable errors: retry GET only, the execution status of other methods is unknown
+//   - RateLimitError of RateLimiter: never retry, the request was not sent
+type BackoffRetryPolicy struct {
+	// By default, 3
+	MaxRetries int
+	// By default, 500ms. Doubled for each attempt
+	BaseDelay time.Duration
+	// By default, 10s
+	MaxDelay time.Duration
+}
+
+func NewBackoffRetryPolicy() *BackoffRetryPolicy {
+	return &BackoffRetryPolicy{
+		MaxRetries: 3,
+		BaseDelay:  500 * time.Millisecond,
+		MaxDelay:   10 * time.Second,
+	}
+}
+
+func (p *BackoffRetryPolicy) Retry(request *RetryRequest, err error) (time.Duration, bool) {
+	if request.Attempt > p.MaxRetries || !IsRetryable(err) {
+		return 0, false
+	}
+	var rateLimitError *RateLimitError
+	if errors.As(err, &rateLimitError) {
+		return 0, false
+	}
+
+	delay := p.backoff(request.Attempt)
+	var clientError *ClientError
+	if errors.As(err, &clientError) {
+		if clientError.StatusCode == http.StatusTooManyRequests {
+			if retryAfter, ok := parseRetryAfter(clientError.Header); ok {
+				delay = retryAfter
+			}
+		}
+		if category := clientError.Category(); category == ErrorCategoryRateLimit || category == ErrorCategoryTimestamp {
+			return delay, true
+		}
+	}
+
+	if request.HttpMethod != http.MethodGet {
+		return 0, false
+	}
+	return delay, true
+}
+
+func (p *BackoffRetryPolicy) backoff(attempt int) time.Duration {
+	delay := time.Duration(float64(p.BaseDelay) * math.Pow(2, float64(attempt-1)))
+	if p.MaxDelay > 0 && (delay > p.MaxDelay || delay <= 0) {
+		delay = p.MaxDelay
+	}
+	return delay
+}
+
+// isUnknownExecution
+// the request may have been executed by server, HTTP 5xx or no response
+func isUnknownExecution(err error) bool {
+	var serverError *ServerError
+	if errors.As(err, &serverError) {
+		return true
+	}
+
+	var netError net.Error
+	if errors.As(err, &netError) && netError.Timeout() {
+		return true
+	}
+	return false
+}
+
+func parseRetryAfter(header http.Header) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if len(value) == 0 {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return time.Until(t), true
+	}
+	return 0, false
+}
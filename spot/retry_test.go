@@ -0,0 +1,192 @@
+package spot
+
+import (
+	"errors"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAPI_RetryGetOnServerError(t *testing.T) {
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		if n < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"mins":5,"price":"9.35751834"}`))
+	})
+
+	price, err := api.AveragePrice(&model.AveragePriceParam{Symbol: "BTCUSDT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if price.Price.String() != "9.35751834" || len(fake.Calls()) != 3 {
+		t.Errorf("price = %s, calls = %v", price.Price, fake.Calls())
+	}
+}
+
+func TestAPI_RetryTooManyRequests(t *testing.T) {
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		if n == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"code":-1003,"msg":"Too many requests."}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	if err := api.NewOrderTest(&model.OrderParam{Symbol: "BTCUSDT", Side: model.OrderSideBuy, OrderType: model.OrderTypeMarket, Quantity: model.MustDecimal("1")}); err != nil {
+		t.Fatal(err)
+	}
+	if calls := fake.Calls(); len(calls) != 2 {
+		t.Errorf("calls = %v", calls)
+	}
+}
+
+func TestAPI_RetryInvalidTimestamp(t *testing.T) {
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		if n == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	if err := api.NewOrderTest(&model.OrderParam{Symbol: "BTCUSDT", Side: model.OrderSideBuy, OrderType: model.OrderTypeMarket, Quantity: model.MustDecimal("1")}); err != nil {
+		t.Fatal(err)
+	}
+	if calls := fake.Calls(); len(calls) != 2 {
+		t.Errorf("calls = %v", calls)
+	}
+}
+
+func TestBackoffRetryPolicy(t *testing.T) {
+	policy := NewBackoffRetryPolicy()
+	tests := []struct {
+		method string
+		err    error
+		retry  bool
+	}{
+		{http.MethodPost, &ClientError{StatusCode: 400, ErrorCode: -1015, ErrorMessage: "Too many new orders."}, true},
+		{http.MethodPost, &ClientError{StatusCode: 400, ErrorCode: -1006}, false},
+		{http.MethodGet, &ClientError{StatusCode: 400, ErrorCode: -1006}, true},
+		{http.MethodGet, &ClientError{StatusCode: 400, ErrorCode: -2013}, false},
+		{http.MethodGet, &RateLimitError{RetryAfter: time.Second}, false},
+		{http.MethodGet, errors.New("closed"), false},
+	}
+	for _, test := range tests {
+		if _, retry := policy.Retry(&RetryRequest{HttpMethod: test.method, Attempt: 1}, test.err); retry != test.retry {
+			t.Errorf("%s %s retry = %v, expected %v", test.method, test.err, retry, test.retry)
+		}
+	}
+}
+
+func TestAPI_NoBlindRetryNewOrder(t *testing.T) {
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := api.NewOrder(&model.OrderParam{Symbol: "BTCUSDT", Side: model.OrderSideBuy, OrderType: model.OrderTypeMarket, Quantity: model.MustDecimal("1")})
+	var serverError *ServerError
+	if !errors.As(err, &serverError) {
+		t.Errorf("error = %v", err)
+	}
+	if calls := fake.Calls(); len(calls) != 1 {
+		t.Errorf("calls = %v", calls)
+	}
+}
+
+func TestAPI_ConfirmNewOrder(t *testing.T) {
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		switch n {
+		case 1, 3:
+			w.WriteHeader(http.StatusBadGateway)
+		case 2:
+			if r.URL.Query().Get("origClientOrderId") != "my-order" {
+				t.Errorf("query = %s", r.URL.RawQuery)
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":-2013,"msg":"Order does not exist."}`))
+		default:
+			_, _ = w.Write([]byte(`{"symbol":"BTCUSDT","orderId":28,"orderListId":-1,"clientOrderId":"my-order","price":"0.1","origQty":"1.0","executedQty":"0.0","cummulativeQuoteQty":"0.0","status":"NEW","timeInForce":"GTC","type":"LIMIT","side":"BUY","updateTime":1507725176595}`))
+		}
+	})
+	param := &model.OrderParam{Symbol: "BTCUSDT", Side: model.OrderSideBuy, OrderType: model.OrderTypeMarket, Quantity: model.MustDecimal("1"), NewClientOrderId: "my-order"}
+
+	// the order is not found, it is not sent again
+	_, err := api.NewOrder(param)
+	var serverError *ServerError
+	if !errors.As(err, &serverError) {
+		t.Errorf("error = %v", err)
+	}
+	want := []string{"POST /api/v3/order", "GET /api/v3/order"}
+	if calls := fake.Calls(); len(calls) != len(want) || calls[0] != want[0] || calls[1] != want[1] {
+		t.Errorf("calls = %v", calls)
+	}
+
+	// the order is found
+	order, err := api.NewOrder(param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.OrderId != 28 || order.ClientOrderId != "my-order" {
+		t.Errorf("order = %+v", order)
+	}
+}
+
+func TestAPI_NewOrderNil(t *testing.T) {
+	api, _ := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	if _, err := api.NewOrder(nil); err == nil {
+		t.Error("error is expected")
+	}
+	if _, err := api.NewOcoOrder(nil); err == nil {
+		t.Error("error is expected")
+	}
+}
+
+func TestAPI_BannedByServer(t *testing.T) {
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTeapot)
+		_, _ = w.Write([]byte(`{"code":-1003,"msg":"Way too many requests; IP banned."}`))
+	})
+
+	var clientError *ClientError
+	if err := api.Ping(); !errors.As(err, &clientError) || clientError.ErrorCode != -1003 {
+		t.Fatalf("error = %v", err)
+	}
+
+	var rateLimitError *RateLimitError
+	if err := api.Ping(); !errors.As(err, &rateLimitError) || !rateLimitError.Banned {
+		t.Errorf("error = %v", err)
+	}
+	if calls := fake.Calls(); len(calls) != 1 {
+		t.Errorf("calls = %v", calls)
+	}
+}
+
+func TestAPI_BannedByServerWithoutRateLimiter(t *testing.T) {
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTeapot)
+		_, _ = w.Write([]byte(`{"code":-1003,"msg":"Way too many requests; IP banned."}`))
+	})
+	api.SetRateLimiter(nil)
+
+	if err := api.Ping(); err == nil {
+		t.Fatal("error is expected")
+	}
+	var rateLimitError *RateLimitError
+	if err := api.Ping(); !errors.As(err, &rateLimitError) || !rateLimitError.Banned || rateLimitError.RetryAfter <= time.Minute {
+		t.Errorf("error = %v", err)
+	}
+	if calls := fake.Calls(); len(calls) != 1 {
+		t.Errorf("calls = %v", calls)
+	}
+}
@@ -0,0 +1,307 @@
+package spot
+
+import (
+	"context"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"net/http"
+)
+
+// SubAccountList
+// Query Sub-account List (For Master Account)
+// GET /sapi/v1/sub-account/list (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#query-sub-account-list-for-master-account
+func (r *API) SubAccountList(param *model.SubAccountListParam) ([]*model.SubAccount, error) {
+	return r.SubAccountListContext(r.ctx, param)
+}
+
+// SubAccountListContext
+// SubAccountList with context of the request
+func (r *API) SubAccountListContext(ctx context.Context, param *model.SubAccountListParam) ([]*model.SubAccount, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/sub-account/list", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseSubAccountList(bytes)
+}
+
+// CreateVirtualSubAccount
+// Create a Virtual Sub-account (For Master Account)
+// This request will generate a virtual sub account under your master account.
+// POST /sapi/v1/sub-account/virtualSubAccount (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#create-a-virtual-sub-account-for-master-account
+func (r *API) CreateVirtualSubAccount(param *model.VirtualSubAccountParam) (*model.VirtualSubAccount, error) {
+	return r.CreateVirtualSubAccountContext(r.ctx, param)
+}
+
+// CreateVirtualSubAccountContext
+// CreateVirtualSubAccount with context of the request
+func (r *API) CreateVirtualSubAccountContext(ctx context.Context, param *model.VirtualSubAccountParam) (*model.VirtualSubAccount, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/sapi/v1/sub-account/virtualSubAccount", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseVirtualSubAccount(bytes)
+}
+
+// SubAccountAssets
+// Query Sub-account Assets (For Master Account)
+// GET /sapi/v3/sub-account/assets (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#query-sub-account-assets-for-master-account
+func (r *API) SubAccountAssets(param *model.SubAccountAssetsParam) (*model.SubAccountAssets, error) {
+	return r.SubAccountAssetsContext(r.ctx, param)
+}
+
+// SubAccountAssetsContext
+// SubAccountAssets with context of the request
+func (r *API) SubAccountAssetsContext(ctx context.Context, param *model.SubAccountAssetsParam) (*model.SubAccountAssets, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v3/sub-account/assets", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseSubAccountAssets(bytes)
+}
+
+// SubAccountSpotSummary
+// Query Sub-account Spot Assets Summary (For Master Account)
+// Get BTC valued asset summary of subaccounts.
+// GET /sapi/v1/sub-account/spotSummary (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#query-sub-account-spot-assets-summary-for-master-account
+func (r *API) SubAccountSpotSummary(param *model.SubAccountSpotSummaryParam) (*model.SubAccountSpotSummary, error) {
+	return r.SubAccountSpotSummaryContext(r.ctx, param)
+}
+
+// SubAccountSpotSummaryContext
+// SubAccountSpotSummary with context of the request
+func (r *API) SubAccountSpotSummaryContext(ctx context.Context, param *model.SubAccountSpotSummaryParam) (*model.SubAccountSpotSummary, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/sub-account/spotSummary", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseSubAccountSpotSummary(bytes)
+}
+
+// SubAccountUniversalTransfer
+// Universal Transfer (For Master Account)
+// Transfer between master and sub-accounts, or between sub-accounts, of any account type.
+// POST /sapi/v1/sub-account/universalTransfer (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#universal-transfer-for-master-account
+func (r *API) SubAccountUniversalTransfer(param *model.SubAccountUniversalTransferParam) (*model.SubAccountUniversalTransfer, error) {
+	return r.SubAccountUniversalTransferContext(r.ctx, param)
+}
+
+// SubAccountUniversalTransferContext
+// SubAccountUniversalTransfer with context of the request
+func (r *API) SubAccountUniversalTransferContext(ctx context.Context, param *model.SubAccountUniversalTransferParam) (*model.SubAccountUniversalTransfer, error) {
+	if param == nil || param.Amount.IsZero() {
+		return nil, &ParameterRequiredError{Params: []string{"amount"}}
+	}
+
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/sapi/v1/sub-account/universalTransfer", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseSubAccountUniversalTransfer(bytes)
+}
+
+// SubAccountUniversalTransferHistory
+// Query Universal Transfer History (For Master Account)
+// By default, the recent 30 days.
+// GET /sapi/v1/sub-account/universalTransfer (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#query-universal-transfer-history-for-master-account
+func (r *API) SubAccountUniversalTransferHistory(param *model.SubAccountUniversalTransferHistoryParam) (*model.SubAccountUniversalTransferHistory, error) {
+	return r.SubAccountUniversalTransferHistoryContext(r.ctx, param)
+}
+
+// SubAccountUniversalTransferHistoryContext
+// SubAccountUniversalTransferHistory with context of the request
+func (r *API) SubAccountUniversalTransferHistoryContext(ctx context.Context, param *model.SubAccountUniversalTransferHistoryParam) (*model.SubAccountUniversalTransferHistory, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/sub-account/universalTransfer", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseSubAccountUniversalTransferHistory(bytes)
+}
+
+// SubAccountFuturesInternalTransfer
+// Sub-account Futures Asset Transfer (For Master Account)
+// Transfer between futures accounts of master and sub-accounts.
+// POST /sapi/v1/sub-account/futures/internalTransfer (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#sub-account-futures-asset-transfer-for-master-account
+func (r *API) SubAccountFuturesInternalTransfer(param *model.SubAccountFuturesInternalTransferParam) (*model.SubAccountFuturesInternalTransfer, error) {
+	return r.SubAccountFuturesInternalTransferContext(r.ctx, param)
+}
+
+// SubAccountFuturesInternalTransferContext
+// SubAccountFuturesInternalTransfer with context of the request
+func (r *API) SubAccountFuturesInternalTransferContext(ctx context.Context, param *model.SubAccountFuturesInternalTransferParam) (*model.SubAccountFuturesInternalTransfer, error) {
+	if param == nil || param.Amount.IsZero() {
+		return nil, &ParameterRequiredError{Params: []string{"amount"}}
+	}
+
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/sapi/v1/sub-account/futures/internalTransfer", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseSubAccountFuturesInternalTransfer(bytes)
+}
+
+// SubAccountTransferHistory
+// Query Sub-account Spot Asset Transfer History (For Master Account)
+// GET /sapi/v1/sub-account/sub/transfer/history (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#query-sub-account-spot-asset-transfer-history-for-master-account
+func (r *API) SubAccountTransferHistory(param *model.SubAccountTransferHistoryParam) ([]*model.SubAccountTransfer, error) {
+	return r.SubAccountTransferHistoryContext(r.ctx, param)
+}
+
+// SubAccountTransferHistoryContext
+// SubAccountTransferHistory with context of the request
+func (r *API) SubAccountTransferHistoryContext(ctx context.Context, param *model.SubAccountTransferHistoryParam) ([]*model.SubAccountTransfer, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/sub-account/sub/transfer/history", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseSubAccountTransferHistory(bytes)
+}
+
+// EnableSubAccountMargin
+// Enable Margin for Sub-account (For Master Account)
+// POST /sapi/v1/sub-account/margin/enable (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#enable-margin-for-sub-account-for-master-account
+func (r *API) EnableSubAccountMargin(param *model.SubAccountEmailParam) (*model.SubAccountMarginEnabled, error) {
+	return r.EnableSubAccountMarginContext(r.ctx, param)
+}
+
+// EnableSubAccountMarginContext
+// EnableSubAccountMargin with context of the request
+func (r *API) EnableSubAccountMarginContext(ctx context.Context, param *model.SubAccountEmailParam) (*model.SubAccountMarginEnabled, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/sapi/v1/sub-account/margin/enable", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseSubAccountMarginEnabled(bytes)
+}
+
+// EnableSubAccountFutures
+// Enable Futures for Sub-account (For Master Account)
+// POST /sapi/v1/sub-account/futures/enable (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#enable-futures-for-sub-account-for-master-account
+func (r *API) EnableSubAccountFutures(param *model.SubAccountEmailParam) (*model.SubAccountFuturesEnabled, error) {
+	return r.EnableSubAccountFuturesContext(r.ctx, param)
+}
+
+// EnableSubAccountFuturesContext
+// EnableSubAccountFutures with context of the request
+func (r *API) EnableSubAccountFuturesContext(ctx context.Context, param *model.SubAccountEmailParam) (*model.SubAccountFuturesEnabled, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/sapi/v1/sub-account/futures/enable", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseSubAccountFuturesEnabled(bytes)
+}
+
+// SubAccountApiIpRestriction
+// Get IP Restriction for a Sub-account API Key (For Master Account)
+// GET /sapi/v1/sub-account/subAccountApi/ipRestriction (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#get-ip-restriction-for-a-sub-account-api-key-for-master-account
+func (r *API) SubAccountApiIpRestriction(param *model.SubAccountApiParam) (*model.SubAccountApiIpRestriction, error) {
+	return r.SubAccountApiIpRestrictionContext(r.ctx, param)
+}
+
+// SubAccountApiIpRestrictionContext
+// SubAccountApiIpRestriction with context of the request
+func (r *API) SubAccountApiIpRestrictionContext(ctx context.Context, param *model.SubAccountApiParam) (*model.SubAccountApiIpRestriction, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/sub-account/subAccountApi/ipRestriction", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseSubAccountApiIpRestriction(bytes)
+}
+
+// UpdateSubAccountApiIpRestriction
+// Add IP Restriction for Sub-Account API key (For Master Account)
+// Restrict access of the API key to IpAddress, or remove the restriction.
+// POST /sapi/v2/sub-account/subAccountApi/ipRestriction (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#add-ip-restriction-for-sub-account-api-key-for-master-account
+func (r *API) UpdateSubAccountApiIpRestriction(param *model.SubAccountApiIpRestrictionParam) (*model.SubAccountApiIpRestriction, error) {
+	return r.UpdateSubAccountApiIpRestrictionContext(r.ctx, param)
+}
+
+// UpdateSubAccountApiIpRestrictionContext
+// UpdateSubAccountApiIpRestriction with context of the request
+func (r *API) UpdateSubAccountApiIpRestrictionContext(ctx context.Context, param *model.SubAccountApiIpRestrictionParam) (*model.SubAccountApiIpRestriction, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/sapi/v2/sub-account/subAccountApi/ipRestriction", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseSubAccountApiIpRestriction(bytes)
+}
+
+// DeleteSubAccountApiIp
+// Delete IP List For a Sub-account API Key (For Master Account)
+// DELETE /sapi/v1/sub-account/subAccountApi/ipRestriction/ipList (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#delete-ip-list-for-a-sub-account-api-key-for-master-account
+func (r *API) DeleteSubAccountApiIp(param *model.SubAccountApiIpParam) (*model.SubAccountApiIpRestriction, error) {
+	return r.DeleteSubAccountApiIpContext(r.ctx, param)
+}
+
+// DeleteSubAccountApiIpContext
+// DeleteSubAccountApiIp with context of the request
+func (r *API) DeleteSubAccountApiIpContext(ctx context.Context, param *model.SubAccountApiIpParam) (*model.SubAccountApiIpRestriction, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodDelete, "/sapi/v1/sub-account/subAccountApi/ipRestriction/ipList", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseSubAccountApiIpRestriction(bytes)
+}
@@ -0,0 +1,77 @@
+package spot
+
+import (
+	"errors"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"net/http"
+	"testing"
+)
+
+func TestAPI_SubAccountUniversalTransfer(t *testing.T) {
+	var form map[string][]string
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		_ = r.ParseForm()
+		form = r.Form
+		_, _ = w.Write([]byte(`{"tranId":11945860693,"clientTranId":"test"}`))
+	})
+
+	result, err := api.SubAccountUniversalTransfer(&model.SubAccountUniversalTransferParam{
+		ToEmail:         "strategy-a@test.com",
+		FromAccountType: model.SubAccountTypeSpot,
+		ToAccountType:   model.SubAccountTypeUsdtFuture,
+		ClientTranId:    "test",
+		Asset:           "USDT",
+		Amount:          model.MustDecimal("100"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.TranId != 11945860693 || fake.Calls()[0] != "POST /sapi/v1/sub-account/universalTransfer" {
+		t.Errorf("result = %+v, calls = %v", result, fake.Calls())
+	}
+	if _, ok := form["fromEmail"]; ok || form["toAccountType"][0] != "USDT_FUTURE" || form["amount"][0] != "100" {
+		t.Errorf("form = %v", form)
+	}
+}
+
+func TestAPI_SubAccountFuturesInternalTransferAmountRequired(t *testing.T) {
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		_, _ = w.Write([]byte(`{"success":true,"txnId":"2934662589"}`))
+	})
+
+	_, err := api.SubAccountFuturesInternalTransfer(&model.SubAccountFuturesInternalTransferParam{
+		FromEmail:   "strategy-a@test.com",
+		ToEmail:     "strategy-b@test.com",
+		FuturesType: model.FuturesTypeUsdM,
+		Asset:       "USDT",
+	})
+	var requiredError *ParameterRequiredError
+	if !errors.As(err, &requiredError) || len(fake.Calls()) != 0 {
+		t.Errorf("err = %v, calls = %v", err, fake.Calls())
+	}
+}
+
+func TestAPI_UpdateSubAccountApiIpRestriction(t *testing.T) {
+	var form map[string][]string
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		_ = r.ParseForm()
+		form = r.Form
+		_, _ = w.Write([]byte(`{"status":"2","ipList":["69.210.67.14","8.34.21.10"],"updateTime":1636371437000,"apiKey":"k5V49ldtn4tszj6W3hystegdfvmGbqDzjmkCtpTvC0G74WhK7yd4rfCTo4lShf"}`))
+	})
+
+	result, err := api.UpdateSubAccountApiIpRestriction(&model.SubAccountApiIpRestrictionParam{
+		Email:            "strategy-a@test.com",
+		SubAccountApiKey: "k5V49ldtn4tszj6W3hystegdfvmGbqDzjmkCtpTvC0G74WhK7yd4rfCTo4lShf",
+		Status:           model.IpRestrictionStatusRestricted,
+		IpAddress:        "69.210.67.14,8.34.21.10",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Status != model.IpRestrictionStatusRestricted || len(result.IpList) != 2 || fake.Calls()[0] != "POST /sapi/v2/sub-account/subAccountApi/ipRestriction" {
+		t.Errorf("result = %+v, calls = %v", result, fake.Calls())
+	}
+	if form["status"][0] != "2" || form["ipAddress"][0] != "69.210.67.14,8.34.21.10" {
+		t.Errorf("form = %v", form)
+	}
+}
@@ -0,0 +1,267 @@
+package spot
+
+import (
+	"context"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"net/http"
+)
+
+// CreateListenKey
+// Create a ListenKey (USER_STREAM)
+// Start a new user data stream. The stream will close after 60 minutes unless a keepalive is sent.
+// If the account has an active listenKey, that listenKey will be returned and its validity will be extended for 60 minutes.
+// POST /api/v3/userDataStream
+// https://binance-docs.github.io/apidocs/spot/en/#listen-key-spot
+func (r *API) CreateListenKey() (string, error) {
+	return r.CreateListenKeyContext(r.ctx)
+}
+
+// CreateListenKeyContext
+// CreateListenKey with context of the request
+func (r *API) CreateListenKeyContext(ctx context.Context) (string, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/api/v3/userDataStream", nil, model.EndpointSecurityTypeUserStream)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return "", err
+	}
+
+	return r.parser.ParseListenKey(bytes)
+}
+
+// KeepAliveListenKey
+// Ping/Keep-alive a ListenKey (USER_STREAM)
+// Keepalive a user data stream to prevent a time out. User data streams will close after 60 minutes.
+// It's recommended to send a ping about every 30 minutes.
+// PUT /api/v3/userDataStream
+// https://binance-docs.github.io/apidocs/spot/en/#listen-key-spot
+func (r *API) KeepAliveListenKey(listenKey string) error {
+	return r.KeepAliveListenKeyContext(r.ctx, listenKey)
+}
+
+// KeepAliveListenKeyContext
+// KeepAliveListenKey with context of the request
+func (r *API) KeepAliveListenKeyContext(ctx context.Context, listenKey string) error {
+	param := &model.ListenKeyParam{
+		ListenKey: listenKey,
+	}
+	_, err := r.sendRequest(ctx, http.MethodPut, "/api/v3/userDataStream", param, model.EndpointSecurityTypeUserStream)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+	}
+	return err
+}
+
+// CloseListenKey
+// Close a ListenKey (USER_STREAM)
+// DELETE /api/v3/userDataStream
+// https://binance-docs.github.io/apidocs/spot/en/#listen-key-spot
+func (r *API) CloseListenKey(listenKey string) error {
+	return r.CloseListenKeyContext(r.ctx, listenKey)
+}
+
+// CloseListenKeyContext
+// CloseListenKey with context of the request
+func (r *API) CloseListenKeyContext(ctx context.Context, listenKey string) error {
+	param := &model.ListenKeyParam{
+		ListenKey: listenKey,
+	}
+	_, err := r.sendRequest(ctx, http.MethodDelete, "/api/v3/userDataStream", param, model.EndpointSecurityTypeUserStream)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+	}
+	return err
+}
+
+// CreateMarginListenKey
+// Create a ListenKey of cross margin account (USER_STREAM)
+// POST /sapi/v1/userDataStream
+// https://binance-docs.github.io/apidocs/spot/en/#listen-key-margin
+func (r *API) CreateMarginListenKey() (string, error) {
+	return r.CreateMarginListenKeyContext(r.ctx)
+}
+
+// CreateMarginListenKeyContext
+// CreateMarginListenKey with context of the request
+func (r *API) CreateMarginListenKeyContext(ctx context.Context) (string, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/sapi/v1/userDataStream", nil, model.EndpointSecurityTypeUserStream)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return "", err
+	}
+
+	return r.parser.ParseListenKey(bytes)
+}
+
+// KeepAliveMarginListenKey
+// Ping/Keep-alive a ListenKey of cross margin account (USER_STREAM)
+// PUT /sapi/v1/userDataStream
+// https://binance-docs.github.io/apidocs/spot/en/#listen-key-margin
+func (r *API) KeepAliveMarginListenKey(listenKey string) error {
+	return r.KeepAliveMarginListenKeyContext(r.ctx, listenKey)
+}
+
+// KeepAliveMarginListenKeyContext
+// KeepAliveMarginListenKey with context of the request
+func (r *API) KeepAliveMarginListenKeyContext(ctx context.Context, listenKey string) error {
+	param := &model.ListenKeyParam{
+		ListenKey: listenKey,
+	}
+	_, err := r.sendRequest(ctx, http.MethodPut, "/sapi/v1/userDataStream", param, model.EndpointSecurityTypeUserStream)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+	}
+	return err
+}
+
+// CloseMarginListenKey
+// Close a ListenKey of cross margin account (USER_STREAM)
+// DELETE /sapi/v1/userDataStream
+// https://binance-docs.github.io/apidocs/spot/en/#listen-key-margin
+func (r *API) CloseMarginListenKey(listenKey string) error {
+	return r.CloseMarginListenKeyContext(r.ctx, listenKey)
+}
+
+// CloseMarginListenKeyContext
+// CloseMarginListenKey with context of the request
+func (r *API) CloseMarginListenKeyContext(ctx context.Context, listenKey string) error {
+	param := &model.ListenKeyParam{
+		ListenKey: listenKey,
+	}
+	_, err := r.sendRequest(ctx, http.MethodDelete, "/sapi/v1/userDataStream", param, model.EndpointSecurityTypeUserStream)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+	}
+	return err
+}
+
+// CreateIsolatedMarginListenKey
+// Create a ListenKey of isolated margin account (USER_STREAM)
+// POST /sapi/v1/userDataStream/isolated
+// https://binance-docs.github.io/apidocs/spot/en/#listen-key-isolated-margin
+func (r *API) CreateIsolatedMarginListenKey(symbol string) (string, error) {
+	return r.CreateIsolatedMarginListenKeyContext(r.ctx, symbol)
+}
+
+// CreateIsolatedMarginListenKeyContext
+// CreateIsolatedMarginListenKey with context of the request
+func (r *API) CreateIsolatedMarginListenKeyContext(ctx context.Context, symbol string) (string, error) {
+	param := &model.IsolatedListenKeyParam{
+		Symbol: symbol,
+	}
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/sapi/v1/userDataStream/isolated", param, model.EndpointSecurityTypeUserStream)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return "", err
+	}
+
+	return r.parser.ParseListenKey(bytes)
+}
+
+// KeepAliveIsolatedMarginListenKey
+// Ping/Keep-alive a ListenKey of isolated margin account (USER_STREAM)
+// PUT /sapi/v1/userDataStream/isolated
+// https://binance-docs.github.io/apidocs/spot/en/#listen-key-isolated-margin
+func (r *API) KeepAliveIsolatedMarginListenKey(symbol string, listenKey string) error {
+	return r.KeepAliveIsolatedMarginListenKeyContext(r.ctx, symbol, listenKey)
+}
+
+// KeepAliveIsolatedMarginListenKeyContext
+// KeepAliveIsolatedMarginListenKey with context of the request
+func (r *API) KeepAliveIsolatedMarginListenKeyContext(ctx context.Context, symbol string, listenKey string) error {
+	if len(listenKey) == 0 {
+		return &ParameterRequiredError{Params: []string{"listenKey"}}
+	}
+	param := &model.IsolatedListenKeyParam{
+		Symbol:    symbol,
+		ListenKey: listenKey,
+	}
+	_, err := r.sendRequest(ctx, http.MethodPut, "/sapi/v1/userDataStream/isolated", param, model.EndpointSecurityTypeUserStream)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+	}
+	return err
+}
+
+// CloseIsolatedMarginListenKey
+// Close a ListenKey of isolated margin account (USER_STREAM)
+// DELETE /sapi/v1/userDataStream/isolated
+// https://binance-docs.github.io/apidocs/spot/en/#listen-key-isolated-margin
+func (r *API) CloseIsolatedMarginListenKey(symbol string, listenKey string) error {
+	return r.CloseIsolatedMarginListenKeyContext(r.ctx, symbol, listenKey)
+}
+
+// CloseIsolatedMarginListenKeyContext
+// CloseIsolatedMarginListenKey with context of the request
+func (r *API) CloseIsolatedMarginListenKeyContext(ctx context.Context, symbol string, listenKey string) error {
+	if len(listenKey) == 0 {
+		return &ParameterRequiredError{Params: []string{"listenKey"}}
+	}
+	param := &model.IsolatedListenKeyParam{
+		Symbol:    symbol,
+		ListenKey: listenKey,
+	}
+	_, err := r.sendRequest(ctx, http.MethodDelete, "/sapi/v1/userDataStream/isolated", param, model.EndpointSecurityTypeUserStream)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+	}
+	return err
+}
+
+// MarginListenKeyService
+// listenKey of cross margin account, or isolated margin account when Symbol is set,
+// e.g. websocket.NewUserDataStream(api.MarginListenKeyService())
+type MarginListenKeyService struct {
+	api    *API
+	Symbol string
+}
+
+// MarginListenKeyService
+// listenKey service of cross margin account
+func (r *API) MarginListenKeyService() *MarginListenKeyService {
+	return &MarginListenKeyService{api: r}
+}
+
+// IsolatedMarginListenKeyService
+// listenKey service of isolated margin account of the symbol
+func (r *API) IsolatedMarginListenKeyService(symbol string) *MarginListenKeyService {
+	return &MarginListenKeyService{api: r, Symbol: symbol}
+}
+
+func (s *MarginListenKeyService) CreateListenKey() (string, error) {
+	if len(s.Symbol) > 0 {
+		return s.api.CreateIsolatedMarginListenKey(s.Symbol)
+	}
+	return s.api.CreateMarginListenKey()
+}
+
+func (s *MarginListenKeyService) KeepAliveListenKey(listenKey string) error {
+	if len(s.Symbol) > 0 {
+		return s.api.KeepAliveIsolatedMarginListenKey(s.Symbol, listenKey)
+	}
+	return s.api.KeepAliveMarginListenKey(listenKey)
+}
+
+func (s *MarginListenKeyService) CloseListenKey(listenKey string) error {
+	if len(s.Symbol) > 0 {
+		return s.api.CloseIsolatedMarginListenKey(s.Symbol, listenKey)
+	}
+	return s.api.CloseMarginListenKey(listenKey)
+}
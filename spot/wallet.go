@@ -0,0 +1,268 @@
+package spot
+
+import (
+	"context"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"net/http"
+)
+
+// CoinInfo
+// All Coins' Information (USER_DATA)
+// Get information of coins (available for deposit and withdraw) for user.
+// GET /sapi/v1/capital/config/getall (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#all-coins-39-information-user_data
+func (r *API) CoinInfo(param *model.CoinInfoParam) ([]*model.CoinInfo, error) {
+	return r.CoinInfoContext(r.ctx, param)
+}
+
+// CoinInfoContext
+// CoinInfo with context of the request
+func (r *API) CoinInfoContext(ctx context.Context, param *model.CoinInfoParam) ([]*model.CoinInfo, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/capital/config/getall", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseCoinInfo(bytes)
+}
+
+// DepositAddress
+// Deposit Address (supporting network) (USER_DATA)
+// Fetch deposit address with network.
+// GET /sapi/v1/capital/deposit/address (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#deposit-address-supporting-network-user_data
+func (r *API) DepositAddress(param *model.DepositAddressParam) (*model.DepositAddress, error) {
+	return r.DepositAddressContext(r.ctx, param)
+}
+
+// DepositAddressContext
+// DepositAddress with context of the request
+func (r *API) DepositAddressContext(ctx context.Context, param *model.DepositAddressParam) (*model.DepositAddress, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/capital/deposit/address", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseDepositAddress(bytes)
+}
+
+// DepositHistory
+// Deposit History (supporting network) (USER_DATA)
+// Fetch deposit history. By default, the recent 90 days.
+// GET /sapi/v1/capital/deposit/hisrec (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#deposit-history-supporting-network-user_data
+func (r *API) DepositHistory(param *model.DepositHistoryParam) ([]*model.DepositHistory, error) {
+	return r.DepositHistoryContext(r.ctx, param)
+}
+
+// DepositHistoryContext
+// DepositHistory with context of the request
+func (r *API) DepositHistoryContext(ctx context.Context, param *model.DepositHistoryParam) ([]*model.DepositHistory, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/capital/deposit/hisrec", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseDepositHistory(bytes)
+}
+
+// Withdraw
+// Withdraw (USER_DATA)
+// Submit a withdraw request.
+// POST /sapi/v1/capital/withdraw/apply (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#withdraw-user_data
+func (r *API) Withdraw(param *model.WithdrawParam) (*model.Withdraw, error) {
+	return r.WithdrawContext(r.ctx, param)
+}
+
+// WithdrawContext
+// Withdraw with context of the request
+func (r *API) WithdrawContext(ctx context.Context, param *model.WithdrawParam) (*model.Withdraw, error) {
+	if param == nil || param.Amount.IsZero() {
+		return nil, &ParameterRequiredError{Params: []string{"amount"}}
+	}
+
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/sapi/v1/capital/withdraw/apply", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseWithdraw(bytes)
+}
+
+// WithdrawHistory
+// Withdraw History (supporting network) (USER_DATA)
+// Fetch withdraw history. By default, the recent 90 days.
+// GET /sapi/v1/capital/withdraw/history (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#withdraw-history-supporting-network-user_data
+func (r *API) WithdrawHistory(param *model.WithdrawHistoryParam) ([]*model.WithdrawHistory, error) {
+	return r.WithdrawHistoryContext(r.ctx, param)
+}
+
+// WithdrawHistoryContext
+// WithdrawHistory with context of the request
+func (r *API) WithdrawHistoryContext(ctx context.Context, param *model.WithdrawHistoryParam) ([]*model.WithdrawHistory, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/capital/withdraw/history", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseWithdrawHistory(bytes)
+}
+
+// AssetDividend
+// Asset Dividend Record (USER_DATA)
+// Query asset dividend record.
+// GET /sapi/v1/asset/assetDividend (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#asset-dividend-record-user_data
+func (r *API) AssetDividend(param *model.AssetDividendParam) (*model.AssetDividend, error) {
+	return r.AssetDividendContext(r.ctx, param)
+}
+
+// AssetDividendContext
+// AssetDividend with context of the request
+func (r *API) AssetDividendContext(ctx context.Context, param *model.AssetDividendParam) (*model.AssetDividend, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/asset/assetDividend", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseAssetDividend(bytes)
+}
+
+// DustLog
+// DustLog (USER_DATA)
+// Dustlog of small assets converted to BNB. Only the latest 100 records are returned.
+// GET /sapi/v1/asset/dribblet (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#dustlog-user_data
+func (r *API) DustLog(param *model.DustLogParam) (*model.DustLog, error) {
+	return r.DustLogContext(r.ctx, param)
+}
+
+// DustLogContext
+// DustLog with context of the request
+func (r *API) DustLogContext(ctx context.Context, param *model.DustLogParam) (*model.DustLog, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/asset/dribblet", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseDustLog(bytes)
+}
+
+// DustTransfer
+// Dust Transfer (USER_DATA)
+// Convert dust assets to BNB.
+// POST /sapi/v1/asset/dust (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#dust-transfer-user_data
+func (r *API) DustTransfer(param *model.DustTransferParam) (*model.DustTransfer, error) {
+	return r.DustTransferContext(r.ctx, param)
+}
+
+// DustTransferContext
+// DustTransfer with context of the request
+func (r *API) DustTransferContext(ctx context.Context, param *model.DustTransferParam) (*model.DustTransfer, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/sapi/v1/asset/dust", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseDustTransfer(bytes)
+}
+
+// TradeFee
+// Trade Fee (USER_DATA)
+// Fetch trade fee.
+// GET /sapi/v1/asset/tradeFee (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#trade-fee-user_data
+func (r *API) TradeFee(param *model.TradeFeeParam) ([]*model.TradeFee, error) {
+	return r.TradeFeeContext(r.ctx, param)
+}
+
+// TradeFeeContext
+// TradeFee with context of the request
+func (r *API) TradeFeeContext(ctx context.Context, param *model.TradeFeeParam) ([]*model.TradeFee, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/asset/tradeFee", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseTradeFee(bytes)
+}
+
+// UniversalTransfer
+// User Universal Transfer (USER_DATA)
+// Transfer asset between wallets, e.g. spot to USDⓈ-M futures.
+// POST /sapi/v1/asset/transfer (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#user-universal-transfer-user_data
+func (r *API) UniversalTransfer(param *model.UniversalTransferParam) (*model.UniversalTransfer, error) {
+	return r.UniversalTransferContext(r.ctx, param)
+}
+
+// UniversalTransferContext
+// UniversalTransfer with context of the request
+func (r *API) UniversalTransferContext(ctx context.Context, param *model.UniversalTransferParam) (*model.UniversalTransfer, error) {
+	if param == nil || param.Amount.IsZero() {
+		return nil, &ParameterRequiredError{Params: []string{"amount"}}
+	}
+
+	bytes, err := r.sendRequest(ctx, http.MethodPost, "/sapi/v1/asset/transfer", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseUniversalTransfer(bytes)
+}
+
+// UniversalTransferHistory
+// Query User Universal Transfer History (USER_DATA)
+// By default, the recent 7 days.
+// GET /sapi/v1/asset/transfer (HMAC SHA256)
+// https://binance-docs.github.io/apidocs/spot/en/#query-user-universal-transfer-history-user_data
+func (r *API) UniversalTransferHistory(param *model.UniversalTransferHistoryParam) (*model.UniversalTransferHistory, error) {
+	return r.UniversalTransferHistoryContext(r.ctx, param)
+}
+
+// UniversalTransferHistoryContext
+// UniversalTransferHistory with context of the request
+func (r *API) UniversalTransferHistoryContext(ctx context.Context, param *model.UniversalTransferHistoryParam) (*model.UniversalTransferHistory, error) {
+	bytes, err := r.sendRequest(ctx, http.MethodGet, "/sapi/v1/asset/transfer", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		if r.logger.CanDebug() {
+			r.logger.Error(err.Error())
+		}
+		return nil, err
+	}
+
+	return r.parser.ParseUniversalTransferHistory(bytes)
+}
@@ -0,0 +1,40 @@
+package spot
+
+import (
+	"errors"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"net/http"
+	"testing"
+)
+
+func TestAPI_DustTransfer(t *testing.T) {
+	var assets []string
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		_ = r.ParseForm()
+		assets = r.Form["asset"]
+		_, _ = w.Write([]byte(`{"totalServiceCharge":"0.02102542","totalTransfered":"1.05127099","transferResult":[]}`))
+	})
+
+	result, err := api.DustTransfer(&model.DustTransferParam{Asset: []string{"BTC", "USDT"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assets) != 2 || assets[0] != "BTC" || assets[1] != "USDT" {
+		t.Errorf("asset = %v", assets)
+	}
+	if result.TotalTransfered.String() != "1.05127099" || fake.Calls()[0] != "POST /sapi/v1/asset/dust" {
+		t.Errorf("result = %+v, calls = %v", result, fake.Calls())
+	}
+}
+
+func TestAPI_WithdrawAmountRequired(t *testing.T) {
+	api, fake := newTestAPI(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		_, _ = w.Write([]byte(`{"id":"7213fea8e94b4a5593d507237e5a555b"}`))
+	})
+
+	_, err := api.Withdraw(&model.WithdrawParam{Coin: "USDT", Address: "0x94df8b352de7f46f64b01d3666bf6e936e44ce60"})
+	var requiredError *ParameterRequiredError
+	if !errors.As(err, &requiredError) || len(fake.Calls()) != 0 {
+		t.Errorf("err = %v, calls = %v", err, fake.Calls())
+	}
+}
@@ -0,0 +1,229 @@
+package websocket
+
+import (
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+)
+
+type SlowConsumerPolicy = string
+
+var (
+	// SlowConsumerPolicyBlock
+	// the reader waits until the queue has space, no message is lost but the connection may lag
+	SlowConsumerPolicyBlock = SlowConsumerPolicy("BLOCK")
+	// SlowConsumerPolicyDropNewest
+	// the received message is dropped when the queue is full
+	SlowConsumerPolicyDropNewest = SlowConsumerPolicy("DROP_NEWEST")
+	// SlowConsumerPolicyDropOldest
+	// the oldest queued message is dropped when the queue is full
+	SlowConsumerPolicyDropOldest = SlowConsumerPolicy("DROP_OLDEST")
+	// SlowConsumerPolicyConflate
+	// a queued message of tickers, book tickers and partial book depth is replaced by the latest one of the same stream,
+	// the reader waits when the queue is full of other messages, e.g. trades and diff depth which are never conflated
+	SlowConsumerPolicyConflate = SlowConsumerPolicy("CONFLATE")
+)
+
+// DispatchStats
+// counters of the messages passed to the handlers since the stream was created
+type DispatchStats struct {
+	// Dispatched (uint64): queued for the handlers
+	Dispatched uint64
+	// Dropped (uint64): lost by SlowConsumerPolicyDropNewest or SlowConsumerPolicyDropOldest
+	Dropped uint64
+	// Conflated (uint64): replaced by a later message of the same stream
+	Conflated uint64
+	// Queued (int): waiting for the handlers now
+	Queued int
+}
+
+// dispatcher
+// messages of the same key (stream) are handled in order by one worker,
+// keys are spread over the workers and handled in parallel
+type dispatcher struct {
+	// counters first, 64-bit aligned for atomic
+	dispatched uint64
+	dropped    uint64
+	conflated  uint64
+	shards     []*dispatchShard
+	wg         sync.WaitGroup
+}
+
+type dispatchItem struct {
+	key string
+	// conflate (bool): the item can be replaced by a later item of the same key
+	conflate bool
+	handle   func()
+}
+
+// dispatchShard
+// ring buffer of items, handled by one worker
+type dispatchShard struct {
+	dispatcher *dispatcher
+	mu         sync.Mutex
+	notEmpty   *sync.Cond
+	notFull    *sync.Cond
+	items      []*dispatchItem
+	head       int
+	size       int
+	policy     SlowConsumerPolicy
+	closed     bool
+	// conflatable (map[string]*dispatchItem): queued item of key, for SlowConsumerPolicyConflate
+	conflatable map[string]*dispatchItem
+}
+
+func newDispatcher(workers int, queueSize int, policy SlowConsumerPolicy) *dispatcher {
+	if workers < 1 {
+		workers = 1
+	}
+	if queueSize < 1 {
+		queueSize = 1
+	}
+
+	d := &dispatcher{
+		shards: make([]*dispatchShard, workers),
+	}
+	for i := range d.shards {
+		shard := &dispatchShard{
+			dispatcher:  d,
+			items:       make([]*dispatchItem, queueSize),
+			policy:      policy,
+			conflatable: make(map[string]*dispatchItem),
+		}
+		shard.notEmpty = sync.NewCond(&shard.mu)
+		shard.notFull = sync.NewCond(&shard.mu)
+		d.shards[i] = shard
+
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			shard.run()
+		}()
+	}
+	return d
+}
+
+// dispatch
+// queue handle of key, false when it is dropped or the dispatcher is closed
+func (d *dispatcher) dispatch(key string, handle func()) bool {
+	return d.shard(key).push(&dispatchItem{key: key, handle: handle})
+}
+
+// dispatchConflatable
+// same as dispatch, the item is replaced by a later item of key with SlowConsumerPolicyConflate
+func (d *dispatcher) dispatchConflatable(key string, handle func()) bool {
+	return d.shard(key).push(&dispatchItem{key: key, conflate: true, handle: handle})
+}
+
+func (d *dispatcher) stats() DispatchStats {
+	stats := DispatchStats{
+		Dispatched: atomic.LoadUint64(&d.dispatched),
+		Dropped:    atomic.LoadUint64(&d.dropped),
+		Conflated:  atomic.LoadUint64(&d.conflated),
+	}
+	for _, shard := range d.shards {
+		shard.mu.Lock()
+		stats.Queued += shard.size
+		shard.mu.Unlock()
+	}
+	return stats
+}
+
+func (d *dispatcher) shard(key string) *dispatchShard {
+	if len(d.shards) == 1 {
+		return d.shards[0]
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return d.shards[h.Sum32()%uint32(len(d.shards))]
+}
+
+// close
+// stop the workers, queued items are discarded
+func (d *dispatcher) close() {
+	for _, shard := range d.shards {
+		shard.mu.Lock()
+		shard.closed = true
+		shard.notEmpty.Broadcast()
+		shard.notFull.Broadcast()
+		shard.mu.Unlock()
+	}
+	d.wg.Wait()
+}
+
+func (s *dispatchShard) push(item *dispatchItem) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.policy == SlowConsumerPolicyConflate && item.conflate {
+		if queued, ok := s.conflatable[item.key]; ok {
+			queued.handle = item.handle
+			atomic.AddUint64(&s.dispatcher.dispatched, 1)
+			atomic.AddUint64(&s.dispatcher.conflated, 1)
+			return true
+		}
+	}
+
+	for !s.closed && s.size == len(s.items) {
+		if s.policy == SlowConsumerPolicyDropNewest {
+			atomic.AddUint64(&s.dispatcher.dropped, 1)
+			return false
+		}
+		if s.policy == SlowConsumerPolicyDropOldest {
+			s.removeHead()
+			atomic.AddUint64(&s.dispatcher.dropped, 1)
+			break
+		}
+		s.notFull.Wait()
+	}
+	if s.closed {
+		return false
+	}
+
+	s.items[(s.head+s.size)%len(s.items)] = item
+	s.size++
+	if s.policy == SlowConsumerPolicyConflate && item.conflate {
+		s.conflatable[item.key] = item
+	}
+	atomic.AddUint64(&s.dispatcher.dispatched, 1)
+	s.notEmpty.Signal()
+	return true
+}
+
+func (s *dispatchShard) removeHead() *dispatchItem {
+	item := s.items[s.head]
+	s.items[s.head] = nil
+	s.head = (s.head + 1) % len(s.items)
+	s.size--
+	if queued, ok := s.conflatable[item.key]; ok && queued == item {
+		delete(s.conflatable, item.key)
+	}
+	return item
+}
+
+func (s *dispatchShard) pop() (*dispatchItem, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for !s.closed && s.size == 0 {
+		s.notEmpty.Wait()
+	}
+	if s.closed {
+		return nil, false
+	}
+
+	// removed from conflatable, handle is not replaced any more
+	item := s.removeHead()
+	s.notFull.Signal()
+	return item, true
+}
+
+func (s *dispatchShard) run() {
+	for {
+		item, ok := s.pop()
+		if !ok {
+			return
+		}
+		item.handle()
+	}
+}
@@ -0,0 +1,192 @@
+package websocket
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDispatcher_Order(t *testing.T) {
+	d := newDispatcher(4, 16, SlowConsumerPolicyBlock)
+	defer d.close()
+
+	var mu sync.Mutex
+	received := make(map[string][]int)
+	var wg sync.WaitGroup
+	streams := []string{"btcusdt@trade", "ethusdt@trade", "bnbusdt@trade", "btcusdt@depth", "ethusdt@depth"}
+	for i := 0; i < 200; i++ {
+		for _, stream := range streams {
+			stream, i := stream, i
+			wg.Add(1)
+			d.dispatch(stream, func() {
+				defer wg.Done()
+				mu.Lock()
+				received[stream] = append(received[stream], i)
+				mu.Unlock()
+			})
+		}
+	}
+	wg.Wait()
+
+	for _, stream := range streams {
+		if len(received[stream]) != 200 {
+			t.Fatalf("%s received %d", stream, len(received[stream]))
+		}
+		for i, v := range received[stream] {
+			if v != i {
+				t.Fatalf("%s out of order at %d: %d", stream, i, v)
+			}
+		}
+	}
+}
+
+func TestDispatcher_DropNewest(t *testing.T) {
+	d := newDispatcher(1, 2, SlowConsumerPolicyDropNewest)
+	defer d.close()
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	d.dispatch("a", func() {
+		close(started)
+		<-release
+	})
+	<-started
+
+	handled := make(chan string, 4)
+	results := make([]bool, 0)
+	for i := 0; i < 3; i++ {
+		v := strconv.Itoa(i)
+		results = append(results, d.dispatch("a", func() { handled <- v }))
+	}
+	if !results[0] || !results[1] || results[2] {
+		t.Errorf("results = %v", results)
+	}
+
+	close(release)
+	for _, expected := range []string{"0", "1"} {
+		select {
+		case v := <-handled:
+			if v != expected {
+				t.Errorf("handled = %s, expected %s", v, expected)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("timeout")
+		}
+	}
+}
+
+func TestDispatcher_DropOldest(t *testing.T) {
+	d := newDispatcher(1, 2, SlowConsumerPolicyDropOldest)
+	defer d.close()
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	d.dispatch("a", func() {
+		close(started)
+		<-release
+	})
+	<-started
+
+	handled := make(chan string, 4)
+	for i := 0; i < 4; i++ {
+		v := strconv.Itoa(i)
+		if !d.dispatch("a", func() { handled <- v }) {
+			t.Errorf("%s is dropped", v)
+		}
+	}
+	if stats := d.stats(); stats.Dropped != 2 || stats.Queued != 2 || stats.Dispatched != 5 {
+		t.Errorf("stats = %+v", stats)
+	}
+
+	close(release)
+	for _, expected := range []string{"2", "3"} {
+		select {
+		case v := <-handled:
+			if v != expected {
+				t.Errorf("handled = %s, expected %s", v, expected)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("timeout")
+		}
+	}
+}
+
+func TestDispatcher_Conflate(t *testing.T) {
+	d := newDispatcher(1, 4, SlowConsumerPolicyConflate)
+	defer d.close()
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	d.dispatch("btcusdt@trade", func() {
+		close(started)
+		<-release
+	})
+	<-started
+
+	handled := make(chan string, 8)
+	for i := 0; i < 3; i++ {
+		v := "btcusdt@bookTicker " + strconv.Itoa(i)
+		d.dispatchConflatable("btcusdt@bookTicker", func() { handled <- v })
+		w := "btcusdt@trade " + strconv.Itoa(i)
+		d.dispatch("btcusdt@trade", func() { handled <- w })
+	}
+	if stats := d.stats(); stats.Conflated != 2 || stats.Queued != 4 || stats.Dropped != 0 {
+		t.Errorf("stats = %+v", stats)
+	}
+
+	close(release)
+	expected := []string{"btcusdt@bookTicker 2", "btcusdt@trade 0", "btcusdt@trade 1", "btcusdt@trade 2"}
+	for _, e := range expected {
+		select {
+		case v := <-handled:
+			if v != e {
+				t.Errorf("handled = %s, expected %s", v, e)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("timeout")
+		}
+	}
+
+	// the handled item is not replaced
+	d.dispatchConflatable("btcusdt@bookTicker", func() { handled <- "btcusdt@bookTicker 3" })
+	select {
+	case v := <-handled:
+		if v != "btcusdt@bookTicker 3" {
+			t.Errorf("handled = %s", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+	}
+}
+
+func TestDispatcher_CloseUnblocksReader(t *testing.T) {
+	d := newDispatcher(1, 1, SlowConsumerPolicyBlock)
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	d.dispatch("a", func() {
+		close(started)
+		<-release
+	})
+	<-started
+	d.dispatch("a", func() {})
+
+	done := make(chan bool)
+	go func() {
+		done <- d.dispatch("a", func() {})
+	}()
+	select {
+	case <-done:
+		t.Fatal("dispatch should block when the queue is full")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	d.close()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("dispatch is not unblocked by close")
+	}
+}
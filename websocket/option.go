@@ -0,0 +1,97 @@
+package websocket
+
+import (
+	"runtime"
+	"time"
+)
+
+type streamConfig struct {
+	baseUrl            string
+	dispatchWorkers    int
+	dispatchQueueSize  int
+	slowConsumerPolicy SlowConsumerPolicy
+	commandRate        int
+	maxStreams         int
+}
+
+type StreamOption func(config *streamConfig)
+
+// WithBaseUrl
+// e.g. wss://fstream.binance.com/stream of USDⓈ-M futures market streams.
+// By default, base url of spot
+func WithBaseUrl(baseUrl string) StreamOption {
+	return func(config *streamConfig) {
+		config.baseUrl = baseUrl
+	}
+}
+
+// WithDispatchWorkers
+// number of goroutines calling the handlers, messages of one stream are always handled by the same worker in order.
+// By default, GOMAXPROCS. User data stream has one worker
+func WithDispatchWorkers(workers int) StreamOption {
+	return func(config *streamConfig) {
+		config.dispatchWorkers = workers
+	}
+}
+
+// WithDispatchQueueSize
+// messages waiting for the handlers of each worker, default to 1024
+func WithDispatchQueueSize(size int) StreamOption {
+	return func(config *streamConfig) {
+		config.dispatchQueueSize = size
+	}
+}
+
+// WithSlowConsumerPolicy
+// what the reader does when the queue is full. By default, SlowConsumerPolicyBlock.
+// UserDataStream always blocks
+func WithSlowConsumerPolicy(policy SlowConsumerPolicy) StreamOption {
+	return func(config *streamConfig) {
+		config.slowConsumerPolicy = policy
+	}
+}
+
+// WithCommandRate
+// SUBSCRIBE, UNSUBSCRIBE and LIST_SUBSCRIPTIONS sent per second on a connection, the next command waits.
+// By default, 5 which is the limit of incoming messages of Binance, 0 is unlimited
+func WithCommandRate(perSecond int) StreamOption {
+	return func(config *streamConfig) {
+		config.commandRate = perSecond
+	}
+}
+
+// WithMaxStreams
+// streams of a connection of StreamPool, default to 1024 which is the limit of Binance
+func WithMaxStreams(streams int) StreamOption {
+	return func(config *streamConfig) {
+		config.maxStreams = streams
+	}
+}
+
+func newStreamConfig(baseUrl string, opts []StreamOption) *streamConfig {
+	config := &streamConfig{
+		baseUrl:            baseUrl,
+		dispatchWorkers:    runtime.GOMAXPROCS(0),
+		dispatchQueueSize:  1024,
+		slowConsumerPolicy: SlowConsumerPolicyBlock,
+		commandRate:        5,
+		maxStreams:         1024,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return config
+}
+
+// commandInterval
+// time between commands of commandRate, 0 is unlimited
+func (c *streamConfig) commandInterval() time.Duration {
+	if c.commandRate <= 0 {
+		return 0
+	}
+	return time.Second / time.Duration(c.commandRate)
+}
+
+func (c *streamConfig) newDispatcher() *dispatcher {
+	return newDispatcher(c.dispatchWorkers, c.dispatchQueueSize, c.slowConsumerPolicy)
+}
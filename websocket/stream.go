@@ -21,6 +21,9 @@ var (
 	PartialBookDepth100msStreamType = StreamType("<symbol>@depth<levels>@100ms")
 	DiffDepthStreamType             = StreamType("<symbol>@depth")
 	DiffDepth100msStreamType        = StreamType("<symbol>@depth@100ms")
+	// RawStreamType
+	// any stream name, data is passed to RawStreamHandler as is
+	RawStreamType = StreamType("<raw>")
 )
 
 // NewAggregateTradeStreamType
@@ -32,22 +35,11 @@ func NewAggregateTradeStreamType(symbol string) (string, error) {
 }
 
 func (s *Stream) SubscribeAggregateTradeStreams(streams []string, handler ...AggTradeStreamHandler) error {
-	if len(handler) == 0 && len(s.aggTradeStreamHandler) == 0 {
-		return ErrNoStreamHandler
-	}
-
-	if err := s.validateStreams(streams, "[a-z0-9]+@aggTrade"); err != nil {
-		return err
-	}
-
-	err := s.subscribe(streams)
-	if err != nil {
-		return err
-	}
-
-	s.appendStreams(AggregateTradeStreamType, streams)
-	s.aggTradeStreamHandler = append(s.aggTradeStreamHandler, handler...)
-	return nil
+	return subscribeHandlers(s, AggregateTradeStreamType, streams, "[a-z0-9_]+@aggTrade", handler, func(handlers []AggTradeStreamHandler, stream string, data []byte, offset int64) {
+		if r, err := parseAggregateTradeStream(data, offset); err == nil {
+			callAggTradeStreamHandler(handlers, stream, r)
+		}
+	})
 }
 
 // NewTradeStreamType
@@ -59,20 +51,11 @@ func NewTradeStreamType(symbol string) (string, error) {
 }
 
 func (s *Stream) SubscribeTradeStreams(streams []string, handler ...TradeStreamHandler) error {
-	if len(handler) == 0 && len(s.aggTradeStreamHandler) == 0 {
-		return ErrNoStreamHandler
-	}
-
-	if err := s.validateStreams(streams, "[a-z0-9]+@trade"); err != nil {
-		return err
-	}
-	if err := s.subscribe(streams); err != nil {
-		return err
-	}
-
-	s.appendStreams(TradeStreamType, streams)
-	s.tradeStreamHandler = append(s.tradeStreamHandler, handler...)
-	return nil
+	return subscribeHandlers(s, TradeStreamType, streams, "[a-z0-9_]+@trade", handler, func(handlers []TradeStreamHandler, stream string, data []byte, offset int64) {
+		if r, err := parseTradeStream(data, offset); err == nil {
+			callTradeStreamHandler(handlers, stream, r)
+		}
+	})
 }
 
 // NewKlineStreamType
@@ -84,20 +67,11 @@ func NewKlineStreamType(symbol string, interval model.Interval) (string, error)
 }
 
 func (s *Stream) SubscribeKlineStreams(streams []string, handler ...KlineStreamHandler) error {
-	if len(handler) == 0 && len(s.klineStreamHandler) == 0 {
-		return ErrNoStreamHandler
-	}
-
-	if err := s.validateStreams(streams, "[a-z0-9]+@kline_(1m|3m|5m|15m|30m|1h|2h|4h|6h|8h|12h|1d|3d|1w|1M)"); err != nil {
-		return err
-	}
-	if err := s.subscribe(streams); err != nil {
-		return err
-	}
-
-	s.appendStreams(KlineStreamType, streams)
-	s.klineStreamHandler = append(s.klineStreamHandler, handler...)
-	return nil
+	return subscribeHandlers(s, KlineStreamType, streams, "[a-z0-9_]+@kline_(1m|3m|5m|15m|30m|1h|2h|4h|6h|8h|12h|1d|3d|1w|1M)", handler, func(handlers []KlineStreamHandler, stream string, data []byte, offset int64) {
+		if r, err := parseKlineStream(data, offset); err == nil {
+			callKlineStreamHandler(handlers, stream, r)
+		}
+	})
 }
 
 // NewIndividualMiniTickerStreamType (Individual Symbol Mini Ticker Stream)
@@ -110,20 +84,11 @@ func NewIndividualMiniTickerStreamType(symbol string) (string, error) {
 }
 
 func (s *Stream) SubscribeIndividualMiniTickerStreams(streams []string, handler ...IndividualMiniTickerStreamHandler) error {
-	if len(handler) == 0 && len(s.aggTradeStreamHandler) == 0 {
-		return ErrNoStreamHandler
-	}
-
-	if err := s.validateStreams(streams, "[a-z0-9]+@miniTicker"); err != nil {
-		return err
-	}
-	if err := s.subscribe(streams); err != nil {
-		return err
-	}
-
-	s.appendStreams(IndividualMiniTickerStreamType, streams)
-	s.individualMiniTickerStreamHandler = append(s.individualMiniTickerStreamHandler, handler...)
-	return nil
+	return subscribeHandlers(s, IndividualMiniTickerStreamType, streams, "[a-z0-9_]+@miniTicker", handler, func(handlers []IndividualMiniTickerStreamHandler, stream string, data []byte, offset int64) {
+		if r, err := parseIndividualMiniTickerStream(data, offset); err == nil {
+			callIndividualMiniTickerStreamHandler(handlers, stream, r)
+		}
+	})
 }
 
 // SubscribeAllMarketMiniTickersStreams
@@ -132,19 +97,11 @@ func (s *Stream) SubscribeIndividualMiniTickerStreams(streams []string, handler
 //  - Note that only tickers that have changed will be present in the array.
 //  - https://binance-docs.github.io/apidocs/spot/en/#all-market-mini-tickers-stream
 func (s *Stream) SubscribeAllMarketMiniTickersStreams(handler ...AllMarketMiniTickerStreamHandler) error {
-	if len(handler) == 0 && len(s.allMarketMiniTickerStreamHandler) == 0 {
-		return ErrNoStreamHandler
-	}
-
-	streams := make([]string, 0)
-	streams = append(streams, "!miniTicker@arr")
-	if err := s.subscribe(streams); err != nil {
-		return err
-	}
-
-	s.appendStreams(AllMarketMiniTickersStreamType, streams)
-	s.allMarketMiniTickerStreamHandler = append(s.allMarketMiniTickerStreamHandler, handler...)
-	return nil
+	return subscribeHandlers(s, AllMarketMiniTickersStreamType, []string{"!miniTicker@arr"}, "^!miniTicker@arr$", handler, func(handlers []AllMarketMiniTickerStreamHandler, stream string, data []byte, offset int64) {
+		if r, err := parseAllMiniTickerStream(data, offset); err == nil {
+			callAllMarketMiniTickerStreamHandler(handlers, stream, r)
+		}
+	})
 }
 
 // NewIndividualTickerStreamType
@@ -157,20 +114,11 @@ func NewIndividualTickerStreamType(symbol string) (string, error) {
 }
 
 func (s *Stream) SubscribeIndividualTickerStream(streams []string, handler ...IndividualTickerStreamHandler) error {
-	if len(handler) == 0 && len(s.individualTickerStreamHandler) == 0 {
-		return ErrNoStreamHandler
-	}
-
-	if err := s.validateStreams(streams, "[a-z0-9]+@ticker"); err != nil {
-		return err
-	}
-	if err := s.subscribe(streams); err != nil {
-		return err
-	}
-
-	s.appendStreams(IndividualTickerStreamType, streams)
-	s.individualTickerStreamHandler = append(s.individualTickerStreamHandler, handler...)
-	return nil
+	return subscribeHandlers(s, IndividualTickerStreamType, streams, "[a-z0-9_]+@ticker", handler, func(handlers []IndividualTickerStreamHandler, stream string, data []byte, offset int64) {
+		if r, err := parseIndividualTickerStream(data, offset); err == nil {
+			callIndividualTickerStreamHandler(handlers, stream, r)
+		}
+	})
 }
 
 // SubscribeAllMarketTickersStream
@@ -179,19 +127,11 @@ func (s *Stream) SubscribeIndividualTickerStream(streams []string, handler ...In
 //    Note that only tickers that have changed will be present in the array.
 //  - https://binance-docs.github.io/apidocs/spot/en/#all-market-tickers-stream
 func (s *Stream) SubscribeAllMarketTickersStream(handler ...AllMarketTickersStreamHandler) error {
-	if len(handler) == 0 && len(s.allMarketTickersStreamHandler) == 0 {
-		return ErrNoStreamHandler
-	}
-
-	streams := make([]string, 0)
-	streams = append(streams, "!ticker@arr")
-	if err := s.subscribe(streams); err != nil {
-		return err
-	}
-
-	s.appendStreams(AllMarketTickersStreamType, streams)
-	s.allMarketTickersStreamHandler = append(s.allMarketTickersStreamHandler, handler...)
-	return nil
+	return subscribeHandlers(s, AllMarketTickersStreamType, []string{"!ticker@arr"}, "^!ticker@arr$", handler, func(handlers []AllMarketTickersStreamHandler, stream string, data []byte, offset int64) {
+		if r, err := parseAllMarketTickersStreamHandler(data, offset); err == nil {
+			callAllMarketTickersStreamHandler(handlers, stream, r)
+		}
+	})
 }
 
 // NewIndividualBookTickerStreamType
@@ -202,39 +142,22 @@ func NewIndividualBookTickerStreamType(symbol string) (string, error) {
 }
 
 func (s *Stream) SubscribeIndividualBookTickerStream(streams []string, handler ...IndividualBookTickerStreamHandler) error {
-	if len(handler) == 0 && len(s.individualBookTickerStreamHandler) == 0 {
-		return ErrNoStreamHandler
-	}
-
-	if err := s.validateStreams(streams, "[a-z0-9]+@bookTicker"); err != nil {
-		return err
-	}
-	if err := s.subscribe(streams); err != nil {
-		return err
-	}
-
-	s.appendStreams(IndividualBookTickerStreamType, streams)
-	s.individualBookTickerStreamHandler = append(s.individualBookTickerStreamHandler, handler...)
-	return nil
+	return subscribeHandlers(s, IndividualBookTickerStreamType, streams, "[a-z0-9_]+@bookTicker", handler, func(handlers []IndividualBookTickerStreamHandler, stream string, data []byte, offset int64) {
+		if r, err := parseIndividualBookTickerStream(data, offset); err == nil {
+			callIndividualBookTickerStreamHandler(handlers, stream, r)
+		}
+	})
 }
 
 // SubscribeAllBookTickerStream
 // - Pushes any update to the best bid or ask's price or quantity in real-time for all symbols.
 // - https://binance-docs.github.io/apidocs/spot/en/#all-book-tickers-stream
 func (s *Stream) SubscribeAllBookTickerStream(handler ...AllBookTickerStreamHandler) error {
-	if len(handler) == 0 && len(s.allBookTickerStreamHandler) == 0 {
-		return ErrNoStreamHandler
-	}
-
-	streams := make([]string, 0)
-	streams = append(streams, "!bookTicker")
-	if err := s.subscribe(streams); err != nil {
-		return err
-	}
-
-	s.appendStreams(AllBookTickersStreamType, streams)
-	s.allBookTickerStreamHandler = append(s.allBookTickerStreamHandler, handler...)
-	return nil
+	return subscribeHandlers(s, AllBookTickersStreamType, []string{"!bookTicker"}, "^!bookTicker$", handler, func(handlers []AllBookTickerStreamHandler, stream string, data []byte, offset int64) {
+		if r, err := parseIndividualBookTickerStream(data, offset); err == nil {
+			callAllBookTickerStreamHandler(handlers, stream, r)
+		}
+	})
 }
 
 // NewPartialBookDepthStreamType
@@ -255,20 +178,11 @@ func NewPartialBookDepth100msStreamType(symbol string, levels model.StreamDeptLe
 //  - Top bids and asks, Valid are 5, 10, or 20.
 //  - https://binance-docs.github.io/apidocs/spot/en/#partial-book-depth-streams
 func (s *Stream) SubscribePartialBookDepthStream(streams []string, handler ...PartialBookDepthStreamHandler) error {
-	if len(handler) == 0 && len(s.partialBookDepthStreamHandler) == 0 {
-		return ErrNoStreamHandler
-	}
-
-	if err := s.validateStreams(streams, "[a-z0-9]+@depth(5|10|20)(@100ms)?"); err != nil {
-		return err
-	}
-	if err := s.subscribe(streams); err != nil {
-		return err
-	}
-
-	s.appendStreams(PartialBookDepthStreamType, streams)
-	s.partialBookDepthStreamHandler = append(s.partialBookDepthStreamHandler, handler...)
-	return nil
+	return subscribeHandlers(s, PartialBookDepthStreamType, streams, "[a-z0-9_]+@depth(5|10|20)(@100ms)?", handler, func(handlers []PartialBookDepthStreamHandler, stream string, data []byte, offset int64) {
+		if r, err := parsePartialBookDepthStream(data, offset); err == nil {
+			callPartialBookDepthStreamHandler(handlers, stream, r)
+		}
+	})
 }
 
 // NewDiffDepthStreamType
@@ -289,20 +203,33 @@ func NewDiffDepth100msStreamType(symbol string) (string, error) {
 //  - Order book price and quantity depth updates used to locally manage an order book.
 //  - https://binance-docs.github.io/apidocs/spot/en/#diff-depth-stream
 func (s *Stream) SubscribeDiffDepthStream(streams []string, handler ...DiffDepthStreamHandler) error {
-	if len(handler) == 0 && len(s.diffDepthStreamHandler) == 0 {
-		return ErrNoStreamHandler
-	}
+	return subscribeHandlers(s, DiffDepthStreamType, streams, "[a-z0-9_]+@depth(@100ms)?", handler, func(handlers []DiffDepthStreamHandler, stream string, data []byte, offset int64) {
+		if r, err := parseDiffDepthStream(data, offset); err == nil {
+			callDiffDepthStreamHandler(handlers, stream, r)
+		}
+	})
+}
 
-	if err := s.validateStreams(streams, "[a-z0-9]+@depth(@100ms)?"); err != nil {
-		return err
+// subscribeHandlers
+// bind handler of Subscribe* methods to streams until Unsubscribe, at least one handler is required
+func subscribeHandlers[T any](s *Stream, streamType StreamType, streams []string, pattern string, handler []T,
+	deliver func(handlers []T, stream string, data []byte, offset int64)) error {
+	if len(handler) == 0 {
+		return ErrNoStreamHandler
 	}
-	if err := s.subscribe(streams); err != nil {
+	if err := s.validateStreams(streams, pattern); err != nil {
 		return err
 	}
 
-	s.appendStreams(DiffDepthStreamType, streams)
-	s.diffDepthStreamHandler = append(s.diffDepthStreamHandler, handler...)
-	return nil
+	handlers := append([]T(nil), handler...)
+	return s.addConsumer(streamType, &streamConsumer{
+		streams: append([]string(nil), streams...),
+		deliver: func(stream string, data []byte, offset int64) {
+			deliver(handlers, stream, data, offset)
+		},
+		shutdown: func() {},
+		handlers: true,
+	})
 }
 
 // newStreamSymbol
@@ -341,3 +268,12 @@ func newStreamSymbol(streamType StreamType, symbol string, interval model.Interv
 
 	return streamType, nil
 }
+
+// SubscribeRawStreams
+//   - subscribe streams of any name, e.g. streams of futures '<symbol>@markPrice@1s'
+//   - data of the streams is passed to handler without parsing
+func (s *Stream) SubscribeRawStreams(streams []string, handler ...RawStreamHandler) error {
+	return subscribeHandlers(s, RawStreamType, streams, "^[a-zA-Z0-9!_@]+$", handler, func(handlers []RawStreamHandler, stream string, data []byte, offset int64) {
+		callRawStreamHandler(handlers, stream, data)
+	})
+}
@@ -1,5 +1,9 @@
 package websocket
 
+import (
+	"github.com/buger/jsonparser"
+)
+
 type StreamCommand struct {
 	Method string   `json:"method,omitempty"`
 	Params []string `json:"params,omitempty"`
@@ -28,3 +32,37 @@ func newCommandListSubscription(id uint64) *StreamCommand {
 		Id:     id,
 	}
 }
+
+// StreamCommandResponse
+// {"result":null,"id":1} or {"error":{"code":2,"msg":"Invalid request"},"id":1}
+type StreamCommandResponse struct {
+	Id uint64 `json:"id"`
+	// Result ([]byte): raw json of "result", e.g. ["btcusdt@aggTrade"] of LIST_SUBSCRIPTIONS
+	Result []byte              `json:"result"`
+	Error  *StreamCommandError `json:"error,omitempty"`
+}
+
+func parseStreamCommandResponse(b []byte) (*StreamCommandResponse, error) {
+	result := new(StreamCommandResponse)
+
+	if v, err := jsonparser.GetInt(b, "id"); err == nil {
+		result.Id = uint64(v)
+	} else {
+		return nil, err
+	}
+	if v, _, _, err := jsonparser.Get(b, "result"); err == nil {
+		result.Result = v
+	}
+	if v, dataType, _, err := jsonparser.Get(b, "error"); err == nil && dataType == jsonparser.Object {
+		e := new(StreamCommandError)
+		if v, err := jsonparser.GetInt(v, "code"); err == nil {
+			e.Code = v
+		}
+		if v, err := jsonparser.GetString(v, "msg"); err == nil {
+			e.Msg = v
+		}
+		result.Error = e
+	}
+
+	return result, nil
+}
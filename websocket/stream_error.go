@@ -1,5 +1,7 @@
 package websocket
 
+import "fmt"
+
 type ErrStreamParameterRequired struct {
 	error
 	Message string
@@ -8,3 +10,15 @@ type ErrStreamParameterRequired struct {
 func (e *ErrStreamParameterRequired) Error() string {
 	return e.Message
 }
+
+// StreamCommandError
+// error of SUBSCRIBE, UNSUBSCRIBE and LIST_SUBSCRIPTIONS returned from server
+// https://binance-docs.github.io/apidocs/spot/en/#error-messages
+type StreamCommandError struct {
+	Code int64  `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+func (e *StreamCommandError) Error() string {
+	return fmt.Sprintf("stream command error::code: %d, message: %s", e.Code, e.Msg)
+}
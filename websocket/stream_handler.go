@@ -32,9 +32,9 @@ func parseStreamData(b []byte) (*StreamData, error) {
 // }
 type AggTradeStreamHandler = func(string, *AggregateTradeStream, error)
 
-func (s *Stream) callAggTradeStreamHandler(stream string, data *AggregateTradeStream) {
+func callAggTradeStreamHandler(handlers []AggTradeStreamHandler, stream string, data *AggregateTradeStream) {
 	var err error
-	for _, handler := range s.aggTradeStreamHandler {
+	for _, handler := range handlers {
 		handler(stream, data, err)
 		if err != nil {
 			break
@@ -50,9 +50,9 @@ func (s *Stream) callAggTradeStreamHandler(stream string, data *AggregateTradeSt
 // }
 type TradeStreamHandler = func(string, *TradeStream, error)
 
-func (s *Stream) callTradeStreamHandler(stream string, data *TradeStream) {
+func callTradeStreamHandler(handlers []TradeStreamHandler, stream string, data *TradeStream) {
 	var err error
-	for _, handler := range s.tradeStreamHandler {
+	for _, handler := range handlers {
 		handler(stream, data, err)
 		if err != nil {
 			break
@@ -68,9 +68,9 @@ func (s *Stream) callTradeStreamHandler(stream string, data *TradeStream) {
 // }
 type KlineStreamHandler = func(string, *KlineStream, error)
 
-func (s *Stream) callKlineStreamHandler(stream string, data *KlineStream) {
+func callKlineStreamHandler(handlers []KlineStreamHandler, stream string, data *KlineStream) {
 	var err error
-	for _, handler := range s.klineStreamHandler {
+	for _, handler := range handlers {
 		handler(stream, data, err)
 		if err != nil {
 			break
@@ -86,9 +86,9 @@ func (s *Stream) callKlineStreamHandler(stream string, data *KlineStream) {
 // }
 type IndividualMiniTickerStreamHandler = func(string, *IndividualMiniTickerStream, error)
 
-func (s *Stream) callIndividualMiniTickerStreamHandler(stream string, data *IndividualMiniTickerStream) {
+func callIndividualMiniTickerStreamHandler(handlers []IndividualMiniTickerStreamHandler, stream string, data *IndividualMiniTickerStream) {
 	var err error
-	for _, handler := range s.individualMiniTickerStreamHandler {
+	for _, handler := range handlers {
 		handler(stream, data, err)
 		if err != nil {
 			break
@@ -104,9 +104,9 @@ func (s *Stream) callIndividualMiniTickerStreamHandler(stream string, data *Indi
 // }
 type AllMarketMiniTickerStreamHandler = func(string, []*IndividualMiniTickerStream, error)
 
-func (s *Stream) callAllMarketMiniTickerStreamHandler(stream string, data []*IndividualMiniTickerStream) {
+func callAllMarketMiniTickerStreamHandler(handlers []AllMarketMiniTickerStreamHandler, stream string, data []*IndividualMiniTickerStream) {
 	var err error
-	for _, handler := range s.allMarketMiniTickerStreamHandler {
+	for _, handler := range handlers {
 		handler(stream, data, err)
 		if err != nil {
 			break
@@ -122,9 +122,9 @@ func (s *Stream) callAllMarketMiniTickerStreamHandler(stream string, data []*Ind
 // }
 type IndividualTickerStreamHandler = func(string, *IndividualTickerStream, error)
 
-func (s *Stream) callIndividualTickerStreamHandler(stream string, data *IndividualTickerStream) {
+func callIndividualTickerStreamHandler(handlers []IndividualTickerStreamHandler, stream string, data *IndividualTickerStream) {
 	var err error
-	for _, handler := range s.individualTickerStreamHandler {
+	for _, handler := range handlers {
 		handler(stream, data, err)
 		if err != nil {
 			break
@@ -140,9 +140,9 @@ func (s *Stream) callIndividualTickerStreamHandler(stream string, data *Individu
 // }
 type AllMarketTickersStreamHandler = func(string, []*IndividualTickerStream, error)
 
-func (s *Stream) callAllMarketTickersStreamHandler(stream string, data []*IndividualTickerStream) {
+func callAllMarketTickersStreamHandler(handlers []AllMarketTickersStreamHandler, stream string, data []*IndividualTickerStream) {
 	var err error
-	for _, handler := range s.allMarketTickersStreamHandler {
+	for _, handler := range handlers {
 		handler(stream, data, err)
 		if err != nil {
 			break
@@ -158,9 +158,9 @@ func (s *Stream) callAllMarketTickersStreamHandler(stream string, data []*Indivi
 // }
 type IndividualBookTickerStreamHandler = func(string, *IndividualBookTickerStream, error)
 
-func (s *Stream) callIndividualBookTickerStreamHandler(stream string, data *IndividualBookTickerStream) {
+func callIndividualBookTickerStreamHandler(handlers []IndividualBookTickerStreamHandler, stream string, data *IndividualBookTickerStream) {
 	var err error
-	for _, handler := range s.individualBookTickerStreamHandler {
+	for _, handler := range handlers {
 		handler(stream, data, err)
 		if err != nil {
 			break
@@ -176,9 +176,9 @@ func (s *Stream) callIndividualBookTickerStreamHandler(stream string, data *Indi
 // }
 type AllBookTickerStreamHandler = func(string, *IndividualBookTickerStream, error)
 
-func (s *Stream) callAllBookTickerStreamHandler(stream string, data *IndividualBookTickerStream) {
+func callAllBookTickerStreamHandler(handlers []AllBookTickerStreamHandler, stream string, data *IndividualBookTickerStream) {
 	var err error
-	for _, handler := range s.individualBookTickerStreamHandler {
+	for _, handler := range handlers {
 		handler(stream, data, err)
 		if err != nil {
 			break
@@ -195,9 +195,9 @@ func (s *Stream) callAllBookTickerStreamHandler(stream string, data *IndividualB
 // }
 type PartialBookDepthStreamHandler = func(string, *PartialBookDepthStream, error)
 
-func (s *Stream) callPartialBookDepthStreamHandler(stream string, data *PartialBookDepthStream) {
+func callPartialBookDepthStreamHandler(handlers []PartialBookDepthStreamHandler, stream string, data *PartialBookDepthStream) {
 	var err error
-	for _, handler := range s.partialBookDepthStreamHandler {
+	for _, handler := range handlers {
 		handler(stream, data, err)
 		if err != nil {
 			break
@@ -214,12 +214,25 @@ func (s *Stream) callPartialBookDepthStreamHandler(stream string, data *PartialB
 // }
 type DiffDepthStreamHandler = func(string, *DiffDepthStream, error)
 
-func (s *Stream) callDiffDepthStreamHandler(stream string, data *DiffDepthStream) {
+func callDiffDepthStreamHandler(handlers []DiffDepthStreamHandler, stream string, data *DiffDepthStream) {
 	var err error
-	for _, handler := range s.diffDepthStreamHandler {
+	for _, handler := range handlers {
 		handler(stream, data, err)
 		if err != nil {
 			break
 		}
 	}
 }
+
+// RawStreamHandler
+//
+// func(stream string, data []byte) {
+//   parse data of the stream
+// }
+type RawStreamHandler = func(string, []byte)
+
+func callRawStreamHandler(handlers []RawStreamHandler, stream string, data []byte) {
+	for _, handler := range handlers {
+		handler(stream, data)
+	}
+}
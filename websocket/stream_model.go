@@ -2,38 +2,39 @@ package websocket
 
 import (
 	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
 	"github.com/buger/jsonparser"
 	"time"
 )
 
 type AggregateTradeStream struct {
-	EventType          string    `json:"e"`
-	EventTime          time.Time `json:"E"`
-	AggregateTradeId   int64     `json:"a"`
-	Price              string    `json:"p"`
-	Quantity           string    `json:"q"`
-	FirstTradeId       int64     `json:"f"`
-	LastTradeId        int64     `json:"l"`
-	TradeTime          time.Time `json:"T"`
-	IsBuyerMarketMaker bool      `json:"m"`
+	EventType          string        `json:"e"`
+	EventTime          time.Time     `json:"E"`
+	AggregateTradeId   int64         `json:"a"`
+	Price              model.Decimal `json:"p"`
+	Quantity           model.Decimal `json:"q"`
+	FirstTradeId       int64         `json:"f"`
+	LastTradeId        int64         `json:"l"`
+	TradeTime          time.Time     `json:"T"`
+	IsBuyerMarketMaker bool          `json:"m"`
 }
 
-func parseAggregateTradeStream(b []byte) (*AggregateTradeStream, error) {
+func parseAggregateTradeStream(b []byte, offset int64) (*AggregateTradeStream, error) {
 	result := new(AggregateTradeStream)
 
 	if v, err := jsonparser.GetString(b, "e"); err == nil {
 		result.EventType = v
 	}
 	if v, err := jsonparser.GetInt(b, "E"); err == nil {
-		result.EventTime = lib.ConvertIntToTime(v, 0)
+		result.EventTime = lib.ConvertIntToTime(v, offset)
 	}
 	if v, err := jsonparser.GetInt(b, "a"); err == nil {
 		result.AggregateTradeId = v
 	}
-	if v, err := jsonparser.GetString(b, "p"); err == nil {
+	if v, err := model.GetDecimal(b, "p"); err == nil {
 		result.Price = v
 	}
-	if v, err := jsonparser.GetString(b, "q"); err == nil {
+	if v, err := model.GetDecimal(b, "q"); err == nil {
 		result.Quantity = v
 	}
 	if v, err := jsonparser.GetInt(b, "f"); err == nil {
@@ -43,7 +44,7 @@ func parseAggregateTradeStream(b []byte) (*AggregateTradeStream, error) {
 		result.LastTradeId = v
 	}
 	if v, err := jsonparser.GetInt(b, "T"); err == nil {
-		result.TradeTime = lib.ConvertIntToTime(v, 0)
+		result.TradeTime = lib.ConvertIntToTime(v, offset)
 	}
 	if v, err := jsonparser.GetBoolean(b, "m"); err == nil {
 		result.IsBuyerMarketMaker = v
@@ -53,19 +54,19 @@ func parseAggregateTradeStream(b []byte) (*AggregateTradeStream, error) {
 }
 
 type TradeStream struct {
-	EventType          string    `json:"e"`
-	EventTime          time.Time `json:"E"`
-	Symbol             string    `json:"s"`
-	TradeId            int64     `json:"t"`
-	Price              string    `json:"p"`
-	Quantity           string    `json:"q"`
-	BuyerOrderId       int64     `json:"b"`
-	SellerOrderId      int64     `json:"a"`
-	TradeTime          time.Time `json:"T"`
-	IsBuyerMarketMaker bool      `json:"m"`
+	EventType          string        `json:"e"`
+	EventTime          time.Time     `json:"E"`
+	Symbol             string        `json:"s"`
+	TradeId            int64         `json:"t"`
+	Price              model.Decimal `json:"p"`
+	Quantity           model.Decimal `json:"q"`
+	BuyerOrderId       int64         `json:"b"`
+	SellerOrderId      int64         `json:"a"`
+	TradeTime          time.Time     `json:"T"`
+	IsBuyerMarketMaker bool          `json:"m"`
 }
 
-func parseTradeStream(b []byte) (*TradeStream, error) {
+func parseTradeStream(b []byte, offset int64) (*TradeStream, error) {
 	result := new(TradeStream)
 
 	if v, err := jsonparser.GetString(b, "e"); err == nil {
@@ -74,7 +75,7 @@ func parseTradeStream(b []byte) (*TradeStream, error) {
 		return nil, err
 	}
 	if v, err := jsonparser.GetInt(b, "E"); err == nil {
-		result.EventTime = lib.ConvertIntToTime(v, 0)
+		result.EventTime = lib.ConvertIntToTime(v, offset)
 	} else {
 		return nil, err
 	}
@@ -88,12 +89,12 @@ func parseTradeStream(b []byte) (*TradeStream, error) {
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "p"); err == nil {
+	if v, err := model.GetDecimal(b, "p"); err == nil {
 		result.Price = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "q"); err == nil {
+	if v, err := model.GetDecimal(b, "q"); err == nil {
 		result.Quantity = v
 	} else {
 		return nil, err
@@ -109,7 +110,7 @@ func parseTradeStream(b []byte) (*TradeStream, error) {
 		return nil, err
 	}
 	if v, err := jsonparser.GetInt(b, "a"); err == nil {
-		result.TradeTime = lib.ConvertIntToTime(v, 0)
+		result.TradeTime = lib.ConvertIntToTime(v, offset)
 	} else {
 		return nil, err
 	}
@@ -130,25 +131,25 @@ type KlineStream struct {
 }
 
 type KlineStreamInfo struct {
-	KlineStartTime           time.Time `json:"t"`
-	KlineCloseTime           time.Time `json:"T"`
-	Symbol                   string    `json:"s"`
-	Interval                 string    `json:"i"`
-	FirstTradeId             int64     `json:"f"`
-	LastTradeId              int64     `json:"L"`
-	OpenPrice                string    `json:"o"`
-	ClosePrice               string    `json:"c"`
-	HighPrice                string    `json:"h"`
-	LowPrice                 string    `json:"l"`
-	BaseAssetVolume          string    `json:"v"`
-	NumberOfTrades           int64     `json:"n"`
-	IsKlineClosed            bool      `json:"x"`
-	QuoteAssetVolume         string    `json:"q"`
-	TakerBuyBaseAssetVolume  string    `json:"V"`
-	TakerBuyQuoteAssetVolume string    `json:"Q"`
+	KlineStartTime           time.Time     `json:"t"`
+	KlineCloseTime           time.Time     `json:"T"`
+	Symbol                   string        `json:"s"`
+	Interval                 string        `json:"i"`
+	FirstTradeId             int64         `json:"f"`
+	LastTradeId              int64         `json:"L"`
+	OpenPrice                model.Decimal `json:"o"`
+	ClosePrice               model.Decimal `json:"c"`
+	HighPrice                model.Decimal `json:"h"`
+	LowPrice                 model.Decimal `json:"l"`
+	BaseAssetVolume          model.Decimal `json:"v"`
+	NumberOfTrades           int64         `json:"n"`
+	IsKlineClosed            bool          `json:"x"`
+	QuoteAssetVolume         model.Decimal `json:"q"`
+	TakerBuyBaseAssetVolume  model.Decimal `json:"V"`
+	TakerBuyQuoteAssetVolume model.Decimal `json:"Q"`
 }
 
-func parseKlineStream(b []byte) (*KlineStream, error) {
+func parseKlineStream(b []byte, offset int64) (*KlineStream, error) {
 	result := new(KlineStream)
 	if v, err := jsonparser.GetString(b, "e"); err == nil {
 		result.EventType = v
@@ -156,7 +157,7 @@ func parseKlineStream(b []byte) (*KlineStream, error) {
 		return nil, err
 	}
 	if v, err := jsonparser.GetInt(b, "E"); err == nil {
-		result.EventTime = lib.ConvertIntToTime(v, 0)
+		result.EventTime = lib.ConvertIntToTime(v, offset)
 	} else {
 		return nil, err
 	}
@@ -167,12 +168,12 @@ func parseKlineStream(b []byte) (*KlineStream, error) {
 	}
 	result.Info = KlineStreamInfo{}
 	if v, err := jsonparser.GetInt(b, "k", "t"); err == nil {
-		result.Info.KlineStartTime = lib.ConvertIntToTime(v, 0)
+		result.Info.KlineStartTime = lib.ConvertIntToTime(v, offset)
 	} else {
 		return nil, err
 	}
 	if v, err := jsonparser.GetInt(b, "k", "T"); err == nil {
-		result.Info.KlineCloseTime = lib.ConvertIntToTime(v, 0)
+		result.Info.KlineCloseTime = lib.ConvertIntToTime(v, offset)
 	} else {
 		return nil, err
 	}
@@ -196,27 +197,27 @@ func parseKlineStream(b []byte) (*KlineStream, error) {
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "k", "o"); err == nil {
+	if v, err := model.GetDecimal(b, "k", "o"); err == nil {
 		result.Info.OpenPrice = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "k", "c"); err == nil {
+	if v, err := model.GetDecimal(b, "k", "c"); err == nil {
 		result.Info.ClosePrice = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "k", "h"); err == nil {
+	if v, err := model.GetDecimal(b, "k", "h"); err == nil {
 		result.Info.HighPrice = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "k", "l"); err == nil {
+	if v, err := model.GetDecimal(b, "k", "l"); err == nil {
 		result.Info.LowPrice = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "k", "v"); err == nil {
+	if v, err := model.GetDecimal(b, "k", "v"); err == nil {
 		result.Info.BaseAssetVolume = v
 	} else {
 		return nil, err
@@ -231,17 +232,17 @@ func parseKlineStream(b []byte) (*KlineStream, error) {
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "k", "q"); err == nil {
+	if v, err := model.GetDecimal(b, "k", "q"); err == nil {
 		result.Info.QuoteAssetVolume = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "k", "V"); err == nil {
+	if v, err := model.GetDecimal(b, "k", "V"); err == nil {
 		result.Info.TakerBuyBaseAssetVolume = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "k", "Q"); err == nil {
+	if v, err := model.GetDecimal(b, "k", "Q"); err == nil {
 		result.Info.TakerBuyQuoteAssetVolume = v
 	} else {
 		return nil, err
@@ -250,18 +251,18 @@ func parseKlineStream(b []byte) (*KlineStream, error) {
 }
 
 type IndividualMiniTickerStream struct {
-	EventType                  string    `json:"e"`
-	EventTime                  time.Time `json:"E"`
-	Symbol                     string    `json:"s"`
-	ClosePrice                 string    `json:"c"`
-	OpenPrice                  string    `json:"o"`
-	HighPrice                  string    `json:"h"`
-	LowPrice                   string    `json:"l"`
-	TotalTradeBaseAssetVolume  string    `json:"v"`
-	TotalTradeQuoteAssetVolume string    `json:"q"`
+	EventType                  string        `json:"e"`
+	EventTime                  time.Time     `json:"E"`
+	Symbol                     string        `json:"s"`
+	ClosePrice                 model.Decimal `json:"c"`
+	OpenPrice                  model.Decimal `json:"o"`
+	HighPrice                  model.Decimal `json:"h"`
+	LowPrice                   model.Decimal `json:"l"`
+	TotalTradeBaseAssetVolume  model.Decimal `json:"v"`
+	TotalTradeQuoteAssetVolume model.Decimal `json:"q"`
 }
 
-func parseIndividualMiniTickerStream(b []byte) (*IndividualMiniTickerStream, error) {
+func parseIndividualMiniTickerStream(b []byte, offset int64) (*IndividualMiniTickerStream, error) {
 	result := new(IndividualMiniTickerStream)
 	if v, err := jsonparser.GetString(b, "e"); err == nil {
 		result.EventType = v
@@ -269,7 +270,7 @@ func parseIndividualMiniTickerStream(b []byte) (*IndividualMiniTickerStream, err
 		return nil, err
 	}
 	if v, err := jsonparser.GetInt(b, "E"); err == nil {
-		result.EventTime = lib.ConvertIntToTime(v, 0)
+		result.EventTime = lib.ConvertIntToTime(v, offset)
 	} else {
 		return nil, err
 	}
@@ -278,32 +279,32 @@ func parseIndividualMiniTickerStream(b []byte) (*IndividualMiniTickerStream, err
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "c"); err == nil {
+	if v, err := model.GetDecimal(b, "c"); err == nil {
 		result.ClosePrice = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "o"); err == nil {
+	if v, err := model.GetDecimal(b, "o"); err == nil {
 		result.OpenPrice = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "h"); err == nil {
+	if v, err := model.GetDecimal(b, "h"); err == nil {
 		result.HighPrice = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "l"); err == nil {
+	if v, err := model.GetDecimal(b, "l"); err == nil {
 		result.LowPrice = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "v"); err == nil {
+	if v, err := model.GetDecimal(b, "v"); err == nil {
 		result.TotalTradeBaseAssetVolume = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "q"); err == nil {
+	if v, err := model.GetDecimal(b, "q"); err == nil {
 		result.TotalTradeQuoteAssetVolume = v
 	} else {
 		return nil, err
@@ -311,11 +312,11 @@ func parseIndividualMiniTickerStream(b []byte) (*IndividualMiniTickerStream, err
 	return result, nil
 }
 
-func parseAllMiniTickerStream(b []byte) ([]*IndividualMiniTickerStream, error) {
+func parseAllMiniTickerStream(b []byte, offset int64) ([]*IndividualMiniTickerStream, error) {
 	results := make([]*IndividualMiniTickerStream, 0)
 
-	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
-		if v, _err := parseIndividualMiniTickerStream(value); _err == nil {
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, _ int, _err error) {
+		if v, _err := parseIndividualMiniTickerStream(value, offset); _err == nil {
 			results = append(results, v)
 		}
 	})
@@ -327,32 +328,32 @@ func parseAllMiniTickerStream(b []byte) ([]*IndividualMiniTickerStream, error) {
 }
 
 type IndividualTickerStream struct {
-	EventType                  string    `json:"e"`
-	EventTime                  time.Time `json:"E"`
-	Symbol                     string    `json:"s"`
-	PriceChange                string    `json:"p"`
-	PricePercentChange         string    `json:"P"`
-	WeightAveragePrice         string    `json:"w"`
-	FirstTraderBefore24hr      string    `json:"x"`
-	LastPrice                  string    `json:"c"`
-	LastQuantity               string    `json:"Q"`
-	BestBidPrice               string    `json:"b"`
-	BestBidQuantity            string    `json:"B"`
-	BestAskPrice               string    `json:"a"`
-	BestAskQuantity            string    `json:"A"`
-	OpenPrice                  string    `json:"o"`
-	HighPrice                  string    `json:"h"`
-	LowPrice                   string    `json:"l"`
-	TotalTradeBaseAssetVolume  string    `json:"v"`
-	TotalTradeQuoteAssetVolume string    `json:"q"`
-	StatisticsOpenTime         int64     `json:"O"`
-	StatisticsCloseTime        int64     `json:"C"`
-	FirstTradeId               int64     `json:"F"`
-	LastTradeId                int64     `json:"L"`
-	TotalNumberOfTrades        int64     `json:"n"`
+	EventType                  string        `json:"e"`
+	EventTime                  time.Time     `json:"E"`
+	Symbol                     string        `json:"s"`
+	PriceChange                model.Decimal `json:"p"`
+	PricePercentChange         model.Decimal `json:"P"`
+	WeightAveragePrice         model.Decimal `json:"w"`
+	FirstTraderBefore24hr      model.Decimal `json:"x"`
+	LastPrice                  model.Decimal `json:"c"`
+	LastQuantity               model.Decimal `json:"Q"`
+	BestBidPrice               model.Decimal `json:"b"`
+	BestBidQuantity            model.Decimal `json:"B"`
+	BestAskPrice               model.Decimal `json:"a"`
+	BestAskQuantity            model.Decimal `json:"A"`
+	OpenPrice                  model.Decimal `json:"o"`
+	HighPrice                  model.Decimal `json:"h"`
+	LowPrice                   model.Decimal `json:"l"`
+	TotalTradeBaseAssetVolume  model.Decimal `json:"v"`
+	TotalTradeQuoteAssetVolume model.Decimal `json:"q"`
+	StatisticsOpenTime         int64         `json:"O"`
+	StatisticsCloseTime        int64         `json:"C"`
+	FirstTradeId               int64         `json:"F"`
+	LastTradeId                int64         `json:"L"`
+	TotalNumberOfTrades        int64         `json:"n"`
 }
 
-func parseIndividualTickerStream(b []byte) (*IndividualTickerStream, error) {
+func parseIndividualTickerStream(b []byte, offset int64) (*IndividualTickerStream, error) {
 	result := new(IndividualTickerStream)
 	if v, err := jsonparser.GetString(b, "e"); err == nil {
 		result.EventType = v
@@ -360,7 +361,7 @@ func parseIndividualTickerStream(b []byte) (*IndividualTickerStream, error) {
 		return nil, err
 	}
 	if v, err := jsonparser.GetInt(b, "E"); err == nil {
-		result.EventTime = lib.ConvertIntToTime(v, 0)
+		result.EventTime = lib.ConvertIntToTime(v, offset)
 	} else {
 		return nil, err
 	}
@@ -369,77 +370,77 @@ func parseIndividualTickerStream(b []byte) (*IndividualTickerStream, error) {
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "p"); err == nil {
+	if v, err := model.GetDecimal(b, "p"); err == nil {
 		result.PriceChange = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "P"); err == nil {
+	if v, err := model.GetDecimal(b, "P"); err == nil {
 		result.PricePercentChange = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "w"); err == nil {
+	if v, err := model.GetDecimal(b, "w"); err == nil {
 		result.WeightAveragePrice = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "x"); err == nil {
+	if v, err := model.GetDecimal(b, "x"); err == nil {
 		result.FirstTraderBefore24hr = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "c"); err == nil {
+	if v, err := model.GetDecimal(b, "c"); err == nil {
 		result.LastPrice = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "Q"); err == nil {
+	if v, err := model.GetDecimal(b, "Q"); err == nil {
 		result.LastQuantity = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "b"); err == nil {
+	if v, err := model.GetDecimal(b, "b"); err == nil {
 		result.BestBidPrice = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "B"); err == nil {
+	if v, err := model.GetDecimal(b, "B"); err == nil {
 		result.BestBidQuantity = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "a"); err == nil {
+	if v, err := model.GetDecimal(b, "a"); err == nil {
 		result.BestAskPrice = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "A"); err == nil {
+	if v, err := model.GetDecimal(b, "A"); err == nil {
 		result.BestAskQuantity = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "o"); err == nil {
+	if v, err := model.GetDecimal(b, "o"); err == nil {
 		result.OpenPrice = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "h"); err == nil {
+	if v, err := model.GetDecimal(b, "h"); err == nil {
 		result.HighPrice = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "l"); err == nil {
+	if v, err := model.GetDecimal(b, "l"); err == nil {
 		result.LowPrice = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "v"); err == nil {
+	if v, err := model.GetDecimal(b, "v"); err == nil {
 		result.TotalTradeBaseAssetVolume = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "q"); err == nil {
+	if v, err := model.GetDecimal(b, "q"); err == nil {
 		result.TotalTradeQuoteAssetVolume = v
 	} else {
 		return nil, err
@@ -472,10 +473,10 @@ func parseIndividualTickerStream(b []byte) (*IndividualTickerStream, error) {
 	return result, nil
 }
 
-func parseAllMarketTickersStreamHandler(b []byte) ([]*IndividualTickerStream, error) {
+func parseAllMarketTickersStreamHandler(b []byte, offset int64) ([]*IndividualTickerStream, error) {
 	results := make([]*IndividualTickerStream, 0)
-	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
-		if v, _err := parseIndividualTickerStream(value); _err == nil {
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, _ int, _err error) {
+		if v, _err := parseIndividualTickerStream(value, offset); _err == nil {
 			results = append(results, v)
 		} else {
 			return
@@ -489,15 +490,15 @@ func parseAllMarketTickersStreamHandler(b []byte) ([]*IndividualTickerStream, er
 }
 
 type IndividualBookTickerStream struct {
-	OrderBookUpdateId int64  `json:"u"`
-	Symbol            string `json:"s"`
-	BestBidPrice      string `json:"b"`
-	BestBidQuantity   string `json:"B"`
-	BestAskPrice      string `json:"a"`
-	BestAskQuantity   string `json:"A"`
+	OrderBookUpdateId int64         `json:"u"`
+	Symbol            string        `json:"s"`
+	BestBidPrice      model.Decimal `json:"b"`
+	BestBidQuantity   model.Decimal `json:"B"`
+	BestAskPrice      model.Decimal `json:"a"`
+	BestAskQuantity   model.Decimal `json:"A"`
 }
 
-func parseIndividualBookTickerStream(b []byte) (*IndividualBookTickerStream, error) {
+func parseIndividualBookTickerStream(b []byte, offset int64) (*IndividualBookTickerStream, error) {
 	result := new(IndividualBookTickerStream)
 	if v, err := jsonparser.GetInt(b, "u"); err == nil {
 		result.OrderBookUpdateId = v
@@ -509,22 +510,22 @@ func parseIndividualBookTickerStream(b []byte) (*IndividualBookTickerStream, err
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "b"); err == nil {
+	if v, err := model.GetDecimal(b, "b"); err == nil {
 		result.BestBidPrice = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "B"); err == nil {
+	if v, err := model.GetDecimal(b, "B"); err == nil {
 		result.BestBidQuantity = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "a"); err == nil {
+	if v, err := model.GetDecimal(b, "a"); err == nil {
 		result.BestAskPrice = v
 	} else {
 		return nil, err
 	}
-	if v, err := jsonparser.GetString(b, "A"); err == nil {
+	if v, err := model.GetDecimal(b, "A"); err == nil {
 		result.BestBidQuantity = v
 	} else {
 		return nil, err
@@ -539,11 +540,11 @@ type PartialBookDepthStream struct {
 }
 
 type PartialBookDepthStreamPriceLevel struct {
-	Price    string `json:"p"`
-	Quantity string `json:"quantity"`
+	Price    model.Decimal `json:"p"`
+	Quantity model.Decimal `json:"quantity"`
 }
 
-func parsePartialBookDepthStream(b []byte) (*PartialBookDepthStream, error) {
+func parsePartialBookDepthStream(b []byte, offset int64) (*PartialBookDepthStream, error) {
 	result := new(PartialBookDepthStream)
 	if v, err := jsonparser.GetInt(b, "lastUpdateId"); err == nil {
 		result.LastUpdateId = v
@@ -553,12 +554,12 @@ func parsePartialBookDepthStream(b []byte) (*PartialBookDepthStream, error) {
 	result.Bids = make([]*PartialBookDepthStreamPriceLevel, 0)
 	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
 		bid := new(PartialBookDepthStreamPriceLevel)
-		if v, _err := jsonparser.GetString(value, "[0]"); _err == nil {
+		if v, _err := model.GetDecimal(value, "[0]"); _err == nil {
 			bid.Price = v
 		} else {
 			return
 		}
-		if v, _err := jsonparser.GetString(value, "[1]"); _err == nil {
+		if v, _err := model.GetDecimal(value, "[1]"); _err == nil {
 			bid.Quantity = v
 		} else {
 			return
@@ -571,12 +572,12 @@ func parsePartialBookDepthStream(b []byte) (*PartialBookDepthStream, error) {
 	result.Asks = make([]*PartialBookDepthStreamPriceLevel, 0)
 	_, err = jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
 		ask := new(PartialBookDepthStreamPriceLevel)
-		if v, _err := jsonparser.GetString(value, "[0]"); _err == nil {
+		if v, _err := model.GetDecimal(value, "[0]"); _err == nil {
 			ask.Price = v
 		} else {
 			return
 		}
-		if v, _err := jsonparser.GetString(value, "[1]"); _err == nil {
+		if v, _err := model.GetDecimal(value, "[1]"); _err == nil {
 			ask.Quantity = v
 		} else {
 			return
@@ -600,11 +601,11 @@ type DiffDepthStream struct {
 }
 
 type DiffDepthStreamPriceLevel struct {
-	Price    string `json:"p"`
-	Quantity string `json:"quantity"`
+	Price    model.Decimal `json:"p"`
+	Quantity model.Decimal `json:"quantity"`
 }
 
-func parseDiffDepthStream(b []byte) (*DiffDepthStream, error) {
+func parseDiffDepthStream(b []byte, offset int64) (*DiffDepthStream, error) {
 	result := new(DiffDepthStream)
 	if v, err := jsonparser.GetString(b, "e"); err == nil {
 		result.EventType = v
@@ -612,7 +613,7 @@ func parseDiffDepthStream(b []byte) (*DiffDepthStream, error) {
 		return nil, err
 	}
 	if v, err := jsonparser.GetInt(b, "E"); err == nil {
-		result.EventTime = lib.ConvertIntToTime(v, 0)
+		result.EventTime = lib.ConvertIntToTime(v, offset)
 	} else {
 		return nil, err
 	}
@@ -634,36 +635,36 @@ func parseDiffDepthStream(b []byte) (*DiffDepthStream, error) {
 	result.Bids = make([]*DiffDepthStreamPriceLevel, 0)
 	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
 		bid := new(DiffDepthStreamPriceLevel)
-		if v, _err := jsonparser.GetString(value, "[0]"); _err == nil {
+		if v, _err := model.GetDecimal(value, "[0]"); _err == nil {
 			bid.Price = v
 		} else {
 			return
 		}
-		if v, _err := jsonparser.GetString(value, "[1]"); _err == nil {
+		if v, _err := model.GetDecimal(value, "[1]"); _err == nil {
 			bid.Quantity = v
 		} else {
 			return
 		}
 		result.Bids = append(result.Bids, bid)
-	}, "bids")
+	}, "b")
 	if err != nil {
 		return nil, err
 	}
 	result.Asks = make([]*DiffDepthStreamPriceLevel, 0)
 	_, err = jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
 		ask := new(DiffDepthStreamPriceLevel)
-		if v, _err := jsonparser.GetString(value, "[0]"); _err == nil {
+		if v, _err := model.GetDecimal(value, "[0]"); _err == nil {
 			ask.Price = v
 		} else {
 			return
 		}
-		if v, _err := jsonparser.GetString(value, "[1]"); _err == nil {
+		if v, _err := model.GetDecimal(value, "[1]"); _err == nil {
 			ask.Quantity = v
 		} else {
 			return
 		}
 		result.Asks = append(result.Asks, ask)
-	}, "asks")
+	}, "a")
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,683 @@
+package websocket
+
+import (
+	"context"
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"sort"
+	"sync"
+	"time"
+)
+
+// StreamPool
+// market streams over as many connections as required by WithMaxStreams, used by Subscribe and SubscribeFunc.
+//   - a new stream is subscribed on the connection which has the least streams,
+//     a connection is created when all connections are full
+//   - a connection is closed when its last stream is unsubscribed
+//   - streams are rebalanced after subscribe and unsubscribe, see nextMove
+//   - commands of each connection are throttled by WithCommandRate
+//   - a new connection is dialed within SetDialTimeout, Subscribe fails with the error of the dial
+type StreamPool struct {
+	opts          []StreamOption
+	maxStreams    int
+	mu            sync.Mutex
+	symbolChecker SymbolChecker
+	clock         *lib.Clock
+	// default to 10 seconds
+	commandTimeout time.Duration
+	// default to 30 seconds
+	dialTimeout time.Duration
+	// ctx (context.Context): connections are dialed within ctx, it is cancelled by Shutdown
+	ctx         context.Context
+	cancel      context.CancelFunc
+	connections []*poolConnection
+	// owners (map[string]*poolConnection): connection of each stream
+	owners map[string]*poolConnection
+	// children (map[*streamConsumer]map[*poolConnection][]*streamConsumer): parts of the consumer on each connection
+	children map[*streamConsumer]map[*poolConnection][]*streamConsumer
+	closed   bool
+	// rebalanceMu (sync.RWMutex): held by rebalance, subscribe and unsubscribe in progress hold it for reading
+	rebalanceMu sync.RWMutex
+}
+
+// poolConnection
+// a connection of the pool, streams are assigned by placeStream before it is dialed and subscribed
+type poolConnection struct {
+	// stream (*Stream): set when ready is closed, nil when the dial failed or the pool is shutdown meanwhile
+	stream  *Stream
+	ready   chan struct{}
+	streams map[string]bool
+	// adding (map[string]int): addConsumer of the stream in progress, the stream is kept assigned meanwhile
+	adding map[string]int
+	// err (error): error of the dial, the connection is removed from the pool
+	err error
+}
+
+// NewStreamPool
+// connections are created on Subscribe, options are passed to NewWsStream of every connection
+func NewStreamPool(opts ...StreamOption) (*StreamPool, error) {
+	config := newStreamConfig("", opts)
+	maxStreams := config.maxStreams
+	if maxStreams < 1 {
+		maxStreams = 1
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &StreamPool{
+		opts:           opts,
+		maxStreams:     maxStreams,
+		commandTimeout: 10 * time.Second,
+		dialTimeout:    30 * time.Second,
+		ctx:            ctx,
+		cancel:         cancel,
+		connections:    make([]*poolConnection, 0),
+		owners:         make(map[string]*poolConnection),
+		children:       make(map[*streamConsumer]map[*poolConnection][]*streamConsumer),
+	}, nil
+}
+
+// SetCommandTimeout
+// timeout of the response of SUBSCRIBE, UNSUBSCRIBE and LIST_SUBSCRIPTIONS of every connection
+func (p *StreamPool) SetCommandTimeout(timeout time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.commandTimeout = timeout
+	for _, stream := range p.streams() {
+		stream.SetCommandTimeout(timeout)
+	}
+}
+
+// SetDialTimeout
+// time to connect a new connection, Subscribe which needs it fails with context.DeadlineExceeded after it
+func (p *StreamPool) SetDialTimeout(timeout time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.dialTimeout = timeout
+}
+
+// SetClock
+// convert event times of every connection to local time, see Stream.SetClock
+func (p *StreamPool) SetClock(clock *lib.Clock) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.clock = clock
+	for _, stream := range p.streams() {
+		stream.SetClock(clock)
+	}
+}
+
+// SetSymbolChecker
+// reject subscribing to a stream of an unknown symbol, nil disables the check
+func (p *StreamPool) SetSymbolChecker(checker SymbolChecker) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.symbolChecker = checker
+}
+
+// Connections
+// number of open connections
+func (p *StreamPool) Connections() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.streams())
+}
+
+// streams
+// stream of each dialed connection, p.mu is locked by the caller
+func (p *StreamPool) streams() []*Stream {
+	streams := make([]*Stream, 0, len(p.connections))
+	for _, conn := range p.connections {
+		if conn.stream != nil {
+			streams = append(streams, conn.stream)
+		}
+	}
+	return streams
+}
+
+// ListSubscription
+// streams subscribed on server of every connection
+func (p *StreamPool) ListSubscription() ([]string, error) {
+	p.mu.Lock()
+	connections := p.streams()
+	p.mu.Unlock()
+
+	streams := make([]string, 0)
+	for _, conn := range connections {
+		v, err := conn.ListSubscription()
+		if err != nil {
+			return nil, err
+		}
+		streams = append(streams, v...)
+	}
+	sort.Strings(streams)
+	return streams, nil
+}
+
+// Stats
+// sum of the messages of open connections, see Stream.Stats
+func (p *StreamPool) Stats() DispatchStats {
+	p.mu.Lock()
+	connections := p.streams()
+	p.mu.Unlock()
+
+	stats := DispatchStats{}
+	for _, conn := range connections {
+		v := conn.Stats()
+		stats.Dispatched += v.Dispatched
+		stats.Dropped += v.Dropped
+		stats.Conflated += v.Conflated
+		stats.Queued += v.Queued
+	}
+	return stats
+}
+
+// Shutdown
+// close every connection, subscriptions are closed with ErrStreamShutdown.
+// A connection still dialing is cancelled
+func (p *StreamPool) Shutdown() {
+	p.mu.Lock()
+	p.closed = true
+	p.cancel()
+	connections := p.streams()
+	p.connections = make([]*poolConnection, 0)
+	p.owners = make(map[string]*poolConnection)
+	p.children = make(map[*streamConsumer]map[*poolConnection][]*streamConsumer)
+	p.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, conn := range connections {
+		wg.Add(1)
+		go func(conn *Stream) {
+			defer wg.Done()
+			conn.Shutdown()
+		}(conn)
+	}
+	wg.Wait()
+}
+
+func (p *StreamPool) validateStreams(streams []string, pattern string) error {
+	p.mu.Lock()
+	checker := p.symbolChecker
+	p.mu.Unlock()
+
+	return validateStreams(streams, pattern, checker)
+}
+
+// addConsumer
+// bind consumer to the connection of each stream and rebalance the streams
+func (p *StreamPool) addConsumer(streamType StreamType, consumer *streamConsumer) error {
+	p.rebalanceMu.RLock()
+	err := p.bind(streamType, consumer)
+	p.rebalanceMu.RUnlock()
+
+	if err == nil {
+		p.rebalance()
+	}
+	return err
+}
+
+// bind
+// bind consumer to the connection of each stream, new streams are placed by placeStream.
+// Placement is reserved under the lock, connections are dialed and subscribed without it
+func (p *StreamPool) bind(streamType StreamType, consumer *streamConsumer) error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return ErrStreamShutdown
+	}
+
+	groups := make(map[*poolConnection][]string)
+	order := make([]*poolConnection, 0)
+	dials := make([]*poolConnection, 0)
+	consumer.streams = uniqueStreams(consumer.streams)
+	for _, stream := range consumer.streams {
+		conn, ok := p.owners[stream]
+		if !ok {
+			var created bool
+			conn, created = p.placeStream(stream)
+			if created {
+				dials = append(dials, conn)
+			}
+		}
+		conn.adding[stream]++
+		if _, ok := groups[conn]; !ok {
+			order = append(order, conn)
+		}
+		groups[conn] = append(groups[conn], stream)
+	}
+	p.mu.Unlock()
+
+	var result error
+	for _, conn := range dials {
+		if result != nil {
+			// the rest is not dialed, the caller fails anyway
+			_ = p.connected(conn, nil, result)
+			continue
+		}
+		result = p.dial(conn)
+	}
+
+	// every connection is waited, release needs their streams
+	children := make(map[*poolConnection][]*streamConsumer)
+	for _, conn := range order {
+		<-conn.ready
+		if result != nil {
+			continue
+		}
+		if conn.stream == nil {
+			result = conn.err
+			if result == nil {
+				result = ErrStreamShutdown
+			}
+			continue
+		}
+		child := &streamConsumer{
+			streams:  groups[conn],
+			deliver:  consumer.deliver,
+			shutdown: consumer.shutdown,
+		}
+		if err := conn.stream.addConsumer(streamType, child); err != nil {
+			result = err
+			continue
+		}
+		children[conn] = []*streamConsumer{child}
+	}
+	if result != nil {
+		for conn, parts := range children {
+			_ = conn.stream.removeConsumer(parts[0], nil)
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, conn := range order {
+		for _, stream := range groups[conn] {
+			if conn.adding[stream]--; conn.adding[stream] == 0 {
+				delete(conn.adding, stream)
+			}
+		}
+	}
+	if result == nil && p.closed {
+		result = ErrStreamShutdown
+	}
+	if result != nil {
+		// streams which are not subscribed on the connections are unassigned
+		for _, conn := range order {
+			p.release(conn, groups[conn])
+		}
+		return result
+	}
+
+	p.children[consumer] = children
+	return nil
+}
+
+// placeStream
+// assign stream to the connection which has the least streams, true when a new connection is created for it
+func (p *StreamPool) placeStream(stream string) (*poolConnection, bool) {
+	var target *poolConnection
+	for _, conn := range p.connections {
+		if len(conn.streams) >= p.maxStreams {
+			continue
+		}
+		if target == nil || len(conn.streams) < len(target.streams) {
+			target = conn
+		}
+	}
+	created := target == nil
+	if created {
+		target = &poolConnection{
+			ready:   make(chan struct{}),
+			streams: make(map[string]bool),
+			adding:  make(map[string]int),
+		}
+		p.connections = append(p.connections, target)
+	}
+
+	target.streams[stream] = true
+	p.owners[stream] = target
+	return target, created
+}
+
+// dial
+// connect conn created by placeStream within the dial timeout, it is cancelled by Shutdown
+func (p *StreamPool) dial(conn *poolConnection) error {
+	p.mu.Lock()
+	ctx, cancel := context.WithTimeout(p.ctx, p.dialTimeout)
+	p.mu.Unlock()
+	defer cancel()
+
+	stream, err := newWsStream(ctx, p.opts)
+	return p.connected(conn, stream, err)
+}
+
+// connected
+// set the result of the dial and close ready, conn is removed from the pool when the dial failed.
+// The connection is closed and ErrStreamShutdown is returned when the pool is shutdown meanwhile
+func (p *StreamPool) connected(conn *poolConnection, stream *Stream, err error) error {
+	p.mu.Lock()
+	closed := p.closed
+	if err != nil && !closed {
+		conn.err = err
+		p.removeConnection(conn)
+	}
+	if err == nil && !closed {
+		stream.SetCommandTimeout(p.commandTimeout)
+		stream.SetClock(p.clock)
+		conn.stream = stream
+	}
+	p.mu.Unlock()
+	close(conn.ready)
+
+	if !closed {
+		return err
+	}
+	if err == nil {
+		stream.Shutdown()
+	}
+	return ErrStreamShutdown
+}
+
+// release
+// unassign the streams which are not subscribed on conn any more, conn is closed when it has no stream
+func (p *StreamPool) release(conn *poolConnection, streams []string) {
+	if p.closed || conn.stream == nil {
+		return
+	}
+
+	for _, stream := range streams {
+		if conn.adding[stream] > 0 || conn.stream.hasStream(stream) {
+			continue
+		}
+		delete(conn.streams, stream)
+		if p.owners[stream] == conn {
+			delete(p.owners, stream)
+		}
+	}
+	if len(conn.streams) > 0 {
+		return
+	}
+
+	p.removeConnection(conn)
+	// shutdown waits for the ping handler, the pool is not locked meanwhile
+	go conn.stream.Shutdown()
+}
+
+// removeConnection
+// unassign conn and its streams, p.mu is locked by the caller
+func (p *StreamPool) removeConnection(conn *poolConnection) {
+	for stream := range conn.streams {
+		if p.owners[stream] == conn {
+			delete(p.owners, stream)
+		}
+	}
+	for i, v := range p.connections {
+		if v == conn {
+			p.connections = append(p.connections[:i:i], p.connections[i+1:]...)
+			break
+		}
+	}
+}
+
+// removeConsumer
+// unbind consumer from streams on their connections, all of its streams when streams is nil.
+// The streams are rebalanced after it
+func (p *StreamPool) removeConsumer(consumer *streamConsumer, streams []string) error {
+	p.rebalanceMu.RLock()
+	err := p.unbind(consumer, streams)
+	p.rebalanceMu.RUnlock()
+
+	p.rebalance()
+	return err
+}
+
+// poolPart
+// streams of a part of the consumer on a connection
+type poolPart struct {
+	conn     *poolConnection
+	consumer *streamConsumer
+	child    *streamConsumer
+	streams  []string
+}
+
+// parts
+// parts of the consumers which have the streams on conn, every connection when conn is nil. p.mu is locked by the caller
+func (p *StreamPool) parts(consumers []*streamConsumer, conn *poolConnection, streams map[string]bool) []*poolPart {
+	result := make([]*poolPart, 0)
+	for _, consumer := range consumers {
+		for c, children := range p.children[consumer] {
+			if conn != nil && c != conn {
+				continue
+			}
+			for _, child := range children {
+				part := &poolPart{conn: c, consumer: consumer, child: child}
+				for _, stream := range c.stream.consumerStreams(child) {
+					if streams[stream] {
+						part.streams = append(part.streams, stream)
+					}
+				}
+				if len(part.streams) > 0 {
+					result = append(result, part)
+				}
+			}
+		}
+	}
+	return result
+}
+
+// removePart
+// forget the child of part once it has no stream, p.mu is locked by the caller
+func (p *StreamPool) removePart(part *poolPart) {
+	if len(part.conn.stream.consumerStreams(part.child)) > 0 {
+		return
+	}
+	children := p.children[part.consumer]
+	for i, child := range children[part.conn] {
+		if child == part.child {
+			children[part.conn] = append(children[part.conn][:i:i], children[part.conn][i+1:]...)
+			break
+		}
+	}
+	if len(children[part.conn]) == 0 {
+		delete(children, part.conn)
+	}
+	if len(children) == 0 {
+		delete(p.children, part.consumer)
+	}
+}
+
+// unbind
+// unbind consumer from streams on their connections, all of its streams when streams is nil
+func (p *StreamPool) unbind(consumer *streamConsumer, streams []string) error {
+	p.mu.Lock()
+	if streams == nil {
+		streams = append([]string(nil), consumer.streams...)
+	}
+
+	removing := make(map[string]bool)
+	for _, stream := range streams {
+		removing[stream] = true
+		for i, v := range consumer.streams {
+			if v == stream {
+				consumer.streams = append(consumer.streams[:i:i], consumer.streams[i+1:]...)
+				break
+			}
+		}
+	}
+	parts := p.parts([]*streamConsumer{consumer}, nil, removing)
+	p.mu.Unlock()
+
+	// UNSUBSCRIBE is waited without the lock
+	var result error
+	for _, part := range parts {
+		if err := part.conn.stream.removeConsumer(part.child, part.streams); err != nil && result == nil {
+			result = err
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, part := range parts {
+		p.removePart(part)
+		p.release(part.conn, part.streams)
+	}
+	return result
+}
+
+// rebalance
+// apply nextMove until the streams are balanced. Subscribe and unsubscribe of the pool wait meanwhile.
+// It is skipped while another subscribe or unsubscribe is in progress, the last one rebalances
+func (p *StreamPool) rebalance() {
+	if !p.rebalanceMu.TryLock() {
+		return
+	}
+	defer p.rebalanceMu.Unlock()
+
+	for {
+		p.mu.Lock()
+		from, to, streams := p.nextMove()
+		p.mu.Unlock()
+		if from == nil {
+			return
+		}
+		if !p.move(from, to, streams) {
+			return
+		}
+	}
+}
+
+// nextMove
+// streams to move between dialed connections, nil when they are balanced. p.mu is locked by the caller
+//   - merge: when the streams fit into one connection less, the connection which has the least streams
+//     is moved into the fullest connection which has room, it is closed once it is empty
+//   - spread: a full connection moves half of the difference to the connection which has the least streams
+func (p *StreamPool) nextMove() (*poolConnection, *poolConnection, []string) {
+	if p.closed {
+		return nil, nil, nil
+	}
+	connections := make([]*poolConnection, 0, len(p.connections))
+	total := 0
+	var least *poolConnection
+	for _, conn := range p.connections {
+		if conn.stream == nil {
+			continue
+		}
+		connections = append(connections, conn)
+		total += len(conn.streams)
+		if least == nil || len(conn.streams) < len(least.streams) {
+			least = conn
+		}
+	}
+	if len(connections) < 2 || len(least.streams) == 0 {
+		return nil, nil, nil
+	}
+
+	if total <= (len(connections)-1)*p.maxStreams {
+		var to *poolConnection
+		for _, conn := range connections {
+			if conn == least || len(conn.streams) >= p.maxStreams {
+				continue
+			}
+			if to == nil || len(conn.streams) > len(to.streams) {
+				to = conn
+			}
+		}
+		n := p.maxStreams - len(to.streams)
+		if n > len(least.streams) {
+			n = len(least.streams)
+		}
+		return least, to, sortedStreams(least, n)
+	}
+
+	for _, conn := range connections {
+		if len(conn.streams) >= p.maxStreams && len(conn.streams)-len(least.streams) > 1 {
+			return conn, least, sortedStreams(conn, (len(conn.streams)-len(least.streams))/2)
+		}
+	}
+	return nil, nil, nil
+}
+
+// sortedStreams
+// first n streams of conn by name
+func sortedStreams(conn *poolConnection, n int) []string {
+	streams := make([]string, 0, len(conn.streams))
+	for stream := range conn.streams {
+		streams = append(streams, stream)
+	}
+	sort.Strings(streams)
+	return streams[:n]
+}
+
+// move
+// subscribe streams on to for their consumers, then unsubscribe them on from, false when nothing is moved.
+// A message of the moment may be delivered by both connections
+func (p *StreamPool) move(from *poolConnection, to *poolConnection, streams []string) bool {
+	p.mu.Lock()
+	moving := make(map[string]bool)
+	for _, stream := range streams {
+		moving[stream] = true
+	}
+	consumers := make([]*streamConsumer, 0, len(p.children))
+	for consumer := range p.children {
+		consumers = append(consumers, consumer)
+	}
+	parts := p.parts(consumers, from, moving)
+	p.mu.Unlock()
+
+	added := make([]*streamConsumer, 0, len(parts))
+	for _, part := range parts {
+		streamType, _ := from.stream.streamType(part.streams[0])
+		child := &streamConsumer{
+			streams:  append([]string(nil), part.streams...),
+			deliver:  part.consumer.deliver,
+			shutdown: part.consumer.shutdown,
+		}
+		if err := to.stream.addConsumer(streamType, child); err != nil {
+			for _, child := range added {
+				_ = to.stream.removeConsumer(child, nil)
+			}
+			return false
+		}
+		added = append(added, child)
+	}
+
+	// the streams are detached even when UNSUBSCRIBE fails
+	for _, part := range parts {
+		_ = from.stream.removeConsumer(part.child, part.streams)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return false
+	}
+	moved := make([]string, 0, len(streams))
+	for i, part := range parts {
+		children := p.children[part.consumer]
+		children[to] = append(children[to], added[i])
+		p.removePart(part)
+		for _, stream := range part.streams {
+			if !to.streams[stream] {
+				to.streams[stream] = true
+				p.owners[stream] = to
+				moved = append(moved, stream)
+			}
+		}
+	}
+	p.release(from, streams)
+	return len(moved) > 0
+}
+
+func (p *StreamPool) consumerStreams(consumer *streamConsumer) []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]string(nil), consumer.streams...)
+}
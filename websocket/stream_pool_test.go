@@ -0,0 +1,304 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newTestStreamPool
+// connections of the pool are served by server
+func newTestStreamPool(t *testing.T, server *testStreamServer, opts ...StreamOption) *StreamPool {
+	pool, err := NewStreamPool(append([]StreamOption{WithBaseUrl(server.url)}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(pool.Shutdown)
+	return pool
+}
+
+func TestStreamPool(t *testing.T) {
+	server := newTestStreamServer(t, nil)
+	pool := newTestStreamPool(t, server, WithMaxStreams(2), WithCommandRate(0))
+
+	first, err := Subscribe(pool, TradeStreams, []string{"btcusdt@trade", "ethusdt@trade", "bnbusdt@trade"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := pool.Connections(); n != 2 {
+		t.Errorf("connections = %d", n)
+	}
+	// xrpusdt@trade is placed on the connection of bnbusdt@trade which has less streams
+	second, err := Subscribe(pool, TradeStreams, []string{"bnbusdt@trade", "xrpusdt@trade"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := pool.Connections(); n != 2 {
+		t.Errorf("connections = %d", n)
+	}
+	streams, err := pool.ListSubscription()
+	if err != nil || strings.Join(streams, ",") != "bnbusdt@trade,btcusdt@trade,ethusdt@trade,xrpusdt@trade" {
+		t.Errorf("streams = %v, err = %v", streams, err)
+	}
+
+	pushTrade := func(stream string, id int) {
+		server.push(stream, `{"stream":"`+stream+`","data":{"e":"trade","E":123456789,"s":"BTCUSDT","t":`+strconv.Itoa(id)+`,"p":"0.001","q":"100","b":88,"a":50,"T":123456785,"m":true,"M":true}}`)
+	}
+	receive := func(subscription *Subscription[*TradeStream]) int64 {
+		select {
+		case v := <-subscription.C():
+			return v.TradeId
+		case <-time.After(time.Second):
+			t.Fatal("timeout")
+		}
+		return 0
+	}
+	pushTrade("btcusdt@trade", 1)
+	if v := receive(first); v != 1 {
+		t.Errorf("first received %d", v)
+	}
+	pushTrade("bnbusdt@trade", 2)
+	if v := receive(first); v != 2 {
+		t.Errorf("first received %d", v)
+	}
+	if v := receive(second); v != 2 {
+		t.Errorf("second received %d", v)
+	}
+
+	// the connection of btcusdt@trade and ethusdt@trade has no stream and is closed
+	if err = first.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if n := pool.Connections(); n != 1 {
+		t.Errorf("connections = %d", n)
+	}
+	streams, err = pool.ListSubscription()
+	if err != nil || strings.Join(streams, ",") != "bnbusdt@trade,xrpusdt@trade" {
+		t.Errorf("streams = %v, err = %v", streams, err)
+	}
+	pushTrade("xrpusdt@trade", 3)
+	if v := receive(second); v != 3 {
+		t.Errorf("second received %d", v)
+	}
+	if stats := pool.Stats(); stats.Dispatched < 1 {
+		t.Errorf("stats = %+v", stats)
+	}
+
+	pool.Shutdown()
+	if _, ok := <-second.C(); ok || second.Err() != ErrStreamShutdown {
+		t.Errorf("err = %v", second.Err())
+	}
+	if _, err = Subscribe(pool, TradeStreams, []string{"btcusdt@trade"}); err != ErrStreamShutdown {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestStreamPool_SubscribeUnlocked(t *testing.T) {
+	release := make(chan struct{})
+	server := newTestStreamServer(t, func(command *StreamCommand) []byte {
+		if command.Method == "SUBSCRIBE" && command.Params[0] == "btcusdt@trade" {
+			<-release
+		}
+		return testCommandResponse(command, nil)
+	})
+	var once sync.Once
+	unblock := func() {
+		once.Do(func() {
+			close(release)
+		})
+	}
+	// the server is closed after the response is released
+	t.Cleanup(unblock)
+	pool := newTestStreamPool(t, server, WithMaxStreams(1), WithCommandRate(0))
+
+	subscribed := make(chan error, 1)
+	go func() {
+		_, err := Subscribe(pool, TradeStreams, []string{"btcusdt@trade"})
+		subscribed <- err
+	}()
+	// the pool is not locked while SUBSCRIBE of btcusdt@trade is waited
+	time.Sleep(100 * time.Millisecond)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		pool.Stats()
+		if _, err := Subscribe(pool, TradeStreams, []string{"ethusdt@trade"}); err != nil {
+			t.Error(err)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatal("pool is locked by a pending subscribe")
+	}
+	if n := pool.Connections(); n != 2 {
+		t.Errorf("connections = %d", n)
+	}
+
+	unblock()
+	if err := <-subscribed; err != nil {
+		t.Fatal(err)
+	}
+	if streams := server.subscriptions(); strings.Join(streams, ",") != "btcusdt@trade,ethusdt@trade" {
+		t.Errorf("streams = %v", streams)
+	}
+}
+
+// connectionStreams
+// number of streams subscribed on each connection of the server
+func (s *testStreamServer) connectionStreams() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]int, 0)
+	for _, streams := range s.connections {
+		if len(streams) > 0 {
+			result = append(result, len(streams))
+		}
+	}
+	sort.Ints(result)
+	return result
+}
+
+func TestStreamPool_Rebalance(t *testing.T) {
+	server := newTestStreamServer(t, nil)
+	pool := newTestStreamPool(t, server, WithMaxStreams(3), WithCommandRate(0))
+
+	first, err := Subscribe(pool, TradeStreams, []string{"a@trade", "b@trade", "c@trade"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := Subscribe(pool, TradeStreams, []string{"d@trade", "e@trade", "f@trade"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	kept, err := Subscribe(pool, TradeStreams, []string{"c@trade", "f@trade"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := server.connectionStreams(); fmt.Sprint(v) != "[3 3]" {
+		t.Errorf("connection streams = %v", v)
+	}
+
+	// c@trade is left alone, d@trade is moved to it from the full connection
+	if err = first.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if v := server.connectionStreams(); fmt.Sprint(v) != "[2 2]" {
+		t.Errorf("connection streams = %v", v)
+	}
+
+	// c@trade and f@trade are left on two connections and merged into one
+	if err = second.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if n := pool.Connections(); n != 1 {
+		t.Errorf("connections = %d", n)
+	}
+	if v := server.connectionStreams(); fmt.Sprint(v) != "[2]" {
+		t.Errorf("connection streams = %v", v)
+	}
+	if streams, err := pool.ListSubscription(); err != nil || strings.Join(streams, ",") != "c@trade,f@trade" {
+		t.Errorf("streams = %v, err = %v", streams, err)
+	}
+
+	// the moved stream is delivered once
+	server.push("c@trade", `{"stream":"c@trade","data":{"e":"trade","E":123456789,"s":"BTCUSDT","t":1,"p":"0.001","q":"100","b":88,"a":50,"T":123456785,"m":true,"M":true}}`)
+	select {
+	case v := <-kept.C():
+		if v.TradeId != 1 {
+			t.Errorf("received %d", v.TradeId)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+	}
+	select {
+	case v := <-kept.C():
+		t.Errorf("received %d again", v.TradeId)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// g@trade fills the connection, h@trade on a new connection is spread with the full one
+	if _, err = Subscribe(pool, TradeStreams, []string{"g@trade", "h@trade"}); err != nil {
+		t.Fatal(err)
+	}
+	if n := pool.Connections(); n != 2 {
+		t.Errorf("connections = %d", n)
+	}
+	if v := server.connectionStreams(); fmt.Sprint(v) != "[2 2]" {
+		t.Errorf("connection streams = %v", v)
+	}
+}
+
+func TestStreamPool_DialTimeout(t *testing.T) {
+	// nothing listens on the address
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	url := "ws://" + listener.Addr().String()
+	_ = listener.Close()
+
+	pool, err := NewStreamPool(WithBaseUrl(url))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(pool.Shutdown)
+	pool.SetDialTimeout(300 * time.Millisecond)
+
+	start := time.Now()
+	if _, err = Subscribe(pool, TradeStreams, []string{"btcusdt@trade"}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Subscribe returned after %v", elapsed)
+	}
+	pool.mu.Lock()
+	if len(pool.connections) != 0 || len(pool.owners) != 0 {
+		t.Errorf("connections = %d, owners = %v", len(pool.connections), pool.owners)
+	}
+	pool.mu.Unlock()
+
+	// a connection still dialing is cancelled by Shutdown
+	pool.SetDialTimeout(time.Minute)
+	done := make(chan error, 1)
+	go func() {
+		_, err := Subscribe(pool, TradeStreams, []string{"btcusdt@trade"})
+		done <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+	pool.Shutdown()
+	select {
+	case err = <-done:
+		if !errors.Is(err, ErrStreamShutdown) {
+			t.Errorf("err = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Subscribe is not cancelled by Shutdown")
+	}
+}
+
+func TestStream_ReserveCommand(t *testing.T) {
+	config := newStreamConfig("", []StreamOption{WithCommandRate(5)})
+	stream := &Stream{commandInterval: config.commandInterval()}
+
+	waits := make([]time.Duration, 0)
+	for i := 0; i < 3; i++ {
+		waits = append(waits, stream.reserveCommand())
+	}
+	if waits[0] != 0 || waits[1] < 190*time.Millisecond || waits[2] < 390*time.Millisecond || waits[2] > 400*time.Millisecond {
+		t.Errorf("waits = %v", waits)
+	}
+
+	unlimited := &Stream{commandInterval: newStreamConfig("", []StreamOption{WithCommandRate(0)}).commandInterval()}
+	if wait := unlimited.reserveCommand(); wait != 0 {
+		t.Errorf("wait = %v", wait)
+	}
+}
@@ -1,10 +1,13 @@
 package websocket
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/buger/jsonparser"
 	"regexp"
+	"strings"
 	"sync"
 	"time"
 )
@@ -13,47 +16,58 @@ var (
 	ErrNoStreamHandler     = errors.New("not found stream handler")
 	ErrRequireStreamSymbol = errors.New("stream is required")
 	ErrStreamSymbolInvalid = errors.New("stream invalid pattern")
+	ErrStreamSymbolUnknown = errors.New("stream symbol is not listed")
+	ErrCommandTimeout      = errors.New("stream command response timeout")
 )
 
+// SymbolChecker
+// validate symbol of stream name against the real symbols, e.g. *spot.SymbolRegistry
+type SymbolChecker interface {
+	HasSymbol(symbol string) bool
+}
+
 type Stream struct {
-	ws                                *Websocket
-	requestId                         uint64
-	streams                           map[string]StreamType
-	aggTradeStreamHandler             []AggTradeStreamHandler
-	tradeStreamHandler                []TradeStreamHandler
-	klineStreamHandler                []KlineStreamHandler
-	individualMiniTickerStreamHandler []IndividualMiniTickerStreamHandler
-	allMarketMiniTickerStreamHandler  []AllMarketMiniTickerStreamHandler
-	individualTickerStreamHandler     []IndividualTickerStreamHandler
-	allMarketTickersStreamHandler     []AllMarketTickersStreamHandler
-	individualBookTickerStreamHandler []IndividualBookTickerStreamHandler
-	allBookTickerStreamHandler        []AllBookTickerStreamHandler
-	partialBookDepthStreamHandler     []PartialBookDepthStreamHandler
-	diffDepthStreamHandler            []DiffDepthStreamHandler
+	ws            *Websocket
+	dispatcher    *dispatcher
+	mu            sync.Mutex
+	requestId     uint64
+	symbolChecker SymbolChecker
+	// event times are converted to local time by the clock (optional)
+	clock *lib.Clock
+	// default to 10 seconds
+	commandTimeout time.Duration
+	// commandInterval (time.Duration): time between commands by WithCommandRate
+	commandInterval time.Duration
+	nextCommand     time.Time
+	pendingCommands map[uint64]chan *StreamCommandResponse
+	streams         map[string]*streamState
+	// unsubscribing (map[string]chan struct{}): closed when UNSUBSCRIBE of the stream is answered
+	unsubscribing map[string]chan struct{}
 }
 
 // NewWsStream
 //
 // https://binance-docs.github.io/apidocs/spot/en/#websocket-market-streams
-func NewWsStream() (*Stream, error) {
+func NewWsStream(opts ...StreamOption) (*Stream, error) {
+	return newWsStream(context.Background(), opts)
+}
+
+// newWsStream
+// connect with retry until it is connected, ctx.Err() when ctx is done first
+func newWsStream(ctx context.Context, opts []StreamOption) (*Stream, error) {
+	config := newStreamConfig("wss://stream.binance.com:9443/stream", opts)
 	wss := &Stream{
-		requestId:                         1,
-		streams:                           make(map[string]string),
-		aggTradeStreamHandler:             make([]AggTradeStreamHandler, 0),
-		tradeStreamHandler:                make([]TradeStreamHandler, 0),
-		klineStreamHandler:                make([]KlineStreamHandler, 0),
-		individualMiniTickerStreamHandler: make([]IndividualMiniTickerStreamHandler, 0),
-		allMarketMiniTickerStreamHandler:  make([]AllMarketMiniTickerStreamHandler, 0),
-		individualTickerStreamHandler:     make([]IndividualTickerStreamHandler, 0),
-		allMarketTickersStreamHandler:     make([]AllMarketTickersStreamHandler, 0),
-		individualBookTickerStreamHandler: make([]IndividualBookTickerStreamHandler, 0),
-		allBookTickerStreamHandler:        make([]AllBookTickerStreamHandler, 0),
-		partialBookDepthStreamHandler:     make([]PartialBookDepthStreamHandler, 0),
-		diffDepthStreamHandler:            make([]DiffDepthStreamHandler, 0),
+		dispatcher:      config.newDispatcher(),
+		requestId:       1,
+		commandTimeout:  10 * time.Second,
+		commandInterval: config.commandInterval(),
+		pendingCommands: make(map[uint64]chan *StreamCommandResponse),
+		streams:         make(map[string]*streamState),
+		unsubscribing:   make(map[string]chan struct{}),
 	}
 	wss.ws = &Websocket{
 		id:           lib.RandomInt(),
-		url:          "wss://stream.binance.com:9443/stream",
+		url:          config.baseUrl,
 		PingDuration: 2 * time.Minute,
 		PongDuration: 5 * time.Minute,
 		mu:           sync.Mutex{},
@@ -62,35 +76,88 @@ func NewWsStream() (*Stream, error) {
 		OnConnect:    wss.onWebsocketConnect,
 	}
 
-	wss.ws.Connect()
+	if err := wss.ws.connect(ctx); err != nil {
+		return nil, err
+	}
 	go wss.readMessage()
 
 	return wss, nil
 }
 
+// onWebsocketConnect
+// called by the reader on reconnect, the response of auto subscribe is waited in another goroutine
 func (s *Stream) onWebsocketConnect(ws *Websocket) {
-	if len(s.streams) > 0 {
-		streams := make([]string, 0)
-		for key := range s.streams {
-			streams = append(streams, key)
-		}
+	s.failPendingCommands()
 
+	s.mu.Lock()
+	streams := make([]string, 0, len(s.streams))
+	for key := range s.streams {
+		streams = append(streams, key)
+	}
+	s.mu.Unlock()
+
+	if len(streams) > 0 {
 		if ws.logger.CanDebug() {
 			ws.logger.Debug("auto subscribe after connect")
 		}
 
-		err := s.subscribe(streams)
-		if err != nil {
-			ws.logger.Error(fmt.Sprintf("auto subscribe error: %s", err.Error()))
-		}
+		go func() {
+			if err := s.subscribe(streams); err != nil {
+				ws.logger.Error(fmt.Sprintf("auto subscribe error: %s", err.Error()))
+			}
+		}()
 	}
 }
 
+// SetCommandTimeout
+// timeout of the response of SUBSCRIBE, UNSUBSCRIBE and LIST_SUBSCRIPTIONS
+func (s *Stream) SetCommandTimeout(timeout time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.commandTimeout = timeout
+}
+
+// SetClock
+// convert event times from server time to local time, e.g. API.Clock() of spot. By default, server time as is
+func (s *Stream) SetClock(clock *lib.Clock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.clock = clock
+}
+
+// SetSymbolChecker
+// reject subscribing to a stream of an unknown symbol, nil disables the check
+func (s *Stream) SetSymbolChecker(checker SymbolChecker) {
+	s.symbolChecker = checker
+}
+
+// Shutdown
+// consumers are shutdown first, a worker waiting on a full Subscription is released before the dispatcher is closed
 func (s *Stream) Shutdown() {
+	s.mu.Lock()
+	consumers := make(map[*streamConsumer]struct{})
+	for _, state := range s.streams {
+		for _, consumer := range state.consumers {
+			consumers[consumer] = struct{}{}
+		}
+	}
+	s.mu.Unlock()
+	for consumer := range consumers {
+		consumer.shutdown()
+	}
+
 	s.ws.Shutdown()
+	s.failPendingCommands()
+	s.dispatcher.close()
 }
 
 func (s *Stream) validateStreams(streams []string, pattern string) error {
+	return validateStreams(streams, pattern, s.symbolChecker)
+}
+
+func validateStreams(streams []string, pattern string, symbolChecker SymbolChecker) error {
 	if len(streams) == 0 {
 		return ErrRequireStreamSymbol
 	}
@@ -100,60 +167,376 @@ func (s *Stream) validateStreams(streams []string, pattern string) error {
 		if !regex.MatchString(stream) {
 			return ErrStreamSymbolInvalid
 		}
+		if symbolChecker != nil && !strings.HasPrefix(stream, "!") {
+			symbol := strings.ToUpper(strings.SplitN(stream, "@", 2)[0])
+			if !symbolChecker.HasSymbol(symbol) {
+				return ErrStreamSymbolUnknown
+			}
+		}
 	}
 
 	return nil
 }
 
-func (s *Stream) appendStreams(streamType StreamType, streams []string) {
-	for _, stream := range streams {
-		s.streams[stream] = streamType
+// streamState
+// a stream subscribed on server and its consumers
+type streamState struct {
+	streamType StreamType
+	consumers  []*streamConsumer
+	// pending (*pendingSubscribe): SUBSCRIBE of the stream is not answered yet, nil once it is subscribed
+	pending *pendingSubscribe
+}
+
+// pendingSubscribe
+// SUBSCRIBE sent by addConsumer, done is closed when it is answered
+type pendingSubscribe struct {
+	done chan struct{}
+	err  error
+}
+
+// streamConsumer
+// consumer of Subscribe, Subscribe* methods and SubscribeFunc, bound to its streams only
+type streamConsumer struct {
+	streams []string
+	deliver func(stream string, data []byte, offset int64)
+	// shutdown (func()): the stream is shutdown
+	shutdown func()
+	// handlers (bool): consumer of Subscribe* methods, removed by Unsubscribe
+	handlers bool
+}
+
+func (s *Stream) streamType(stream string) (StreamType, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if state, ok := s.streams[stream]; ok {
+		return state.streamType, true
 	}
+	return "", false
 }
 
-func (s *Stream) subscribe(streams []string) error {
-	command := newCommandSubscribe(s.requestId, streams)
-	s.requestId = s.requestId + 1
+// addConsumer
+// bind consumer to its streams and subscribe the streams which are not subscribed yet.
+// The consumer is bound before unlocking, so the streams are not unsubscribed by removeConsumer meanwhile
+func (s *Stream) addConsumer(streamType StreamType, consumer *streamConsumer) error {
+	s.mu.Lock()
+	consumer.streams = uniqueStreams(consumer.streams)
+	pending := &pendingSubscribe{done: make(chan struct{})}
+	streams := make([]string, 0)
+	waits := make([]chan struct{}, 0)
+	others := make(map[*pendingSubscribe]bool)
+	for _, stream := range consumer.streams {
+		state, ok := s.streams[stream]
+		if !ok {
+			state = &streamState{streamType: streamType, pending: pending}
+			s.streams[stream] = state
+			streams = append(streams, stream)
+			// UNSUBSCRIBE of the previous consumers is written first
+			if done, ok := s.unsubscribing[stream]; ok {
+				waits = append(waits, done)
+			}
+		} else if state.pending != nil {
+			others[state.pending] = true
+		}
+		state.consumers = append(state.consumers, consumer)
+	}
+	s.mu.Unlock()
 
-	err := s.ws.WriteJSON(command)
+	err := s.subscribePending(streams, pending, waits)
+	for other := range others {
+		<-other.done
+		if err == nil {
+			err = other.err
+		}
+	}
 	if err != nil {
+		_ = s.removeConsumer(consumer, nil)
 		return err
 	}
-
 	return nil
 }
 
-func (s *Stream) Unsubscribe(streams []string) error {
-	command := newCommandUnSubscribe(s.requestId, streams)
-	s.requestId = s.requestId + 1
-
-	if s.ws.logger.CanDebug() {
-		s.ws.logger.Debug(fmt.Sprintf("websocket[%d] unsubscribe stream", s.ws.id))
+// subscribePending
+// subscribe the streams of pending, the streams are deleted when it is failed
+func (s *Stream) subscribePending(streams []string, pending *pendingSubscribe, waits []chan struct{}) error {
+	if len(streams) == 0 {
+		close(pending.done)
+		return nil
 	}
 
-	err := s.ws.WriteJSON(command)
-	if err != nil {
-		return err
+	for _, done := range waits {
+		<-done
 	}
+	err := s.subscribe(streams)
 
+	s.mu.Lock()
 	for _, stream := range streams {
-		if _, exists := s.streams[stream]; exists {
+		state, ok := s.streams[stream]
+		if !ok || state.pending != pending {
+			continue
+		}
+		state.pending = nil
+		if err != nil {
 			delete(s.streams, stream)
 		}
 	}
+	s.mu.Unlock()
 
-	return nil
+	pending.err = err
+	close(pending.done)
+	return err
 }
 
-func (s *Stream) ListSubscription() {
-	command := newCommandListSubscription(s.requestId)
-	s.requestId = s.requestId + 1
-	err := s.ws.WriteJSON(command)
+func uniqueStreams(streams []string) []string {
+	result := make([]string, 0, len(streams))
+	seen := make(map[string]bool)
+	for _, stream := range streams {
+		if !seen[stream] {
+			seen[stream] = true
+			result = append(result, stream)
+		}
+	}
+	return result
+}
+
+// removeConsumer
+// unbind consumer from streams, all of its streams when streams is nil.
+// The streams which have no consumer any more are unsubscribed
+func (s *Stream) removeConsumer(consumer *streamConsumer, streams []string) error {
+	s.mu.Lock()
+	if streams == nil {
+		streams = append([]string(nil), consumer.streams...)
+	}
+	unsubscribe := make([]string, 0)
+	for _, stream := range streams {
+		if s.detach(consumer, stream) {
+			unsubscribe = append(unsubscribe, stream)
+		}
+	}
+
+	return s.releaseStreams(unsubscribe)
+}
+
+// detach
+// unbind consumer from stream, true when the stream has no consumer any more and is deleted. s.mu is locked by the caller
+func (s *Stream) detach(consumer *streamConsumer, stream string) bool {
+	bound := false
+	for i, v := range consumer.streams {
+		if v == stream {
+			consumer.streams = append(consumer.streams[:i:i], consumer.streams[i+1:]...)
+			bound = true
+			break
+		}
+	}
+	state, ok := s.streams[stream]
+	if !bound || !ok {
+		return false
+	}
+	for i, c := range state.consumers {
+		if c == consumer {
+			state.consumers = append(state.consumers[:i:i], state.consumers[i+1:]...)
+			break
+		}
+	}
+	if len(state.consumers) > 0 {
+		return false
+	}
+	delete(s.streams, stream)
+	return true
+}
+
+// releaseStreams
+// unsubscribe the streams deleted by detach, s.mu is locked by the caller and unlocked here.
+// A consumer subscribing the streams again waits until UNSUBSCRIBE is answered
+func (s *Stream) releaseStreams(streams []string) error {
+	if len(streams) == 0 {
+		s.mu.Unlock()
+		return nil
+	}
+	done := make(chan struct{})
+	for _, stream := range streams {
+		s.unsubscribing[stream] = done
+	}
+	s.mu.Unlock()
+
+	err := s.unsubscribe(streams)
+
+	s.mu.Lock()
+	for _, stream := range streams {
+		if s.unsubscribing[stream] == done {
+			delete(s.unsubscribing, stream)
+		}
+	}
+	s.mu.Unlock()
+	close(done)
+	return err
+}
+
+// hasStream
+// stream is subscribed by a consumer
+func (s *Stream) hasStream(stream string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.streams[stream]
+	return ok
+}
+
+func (s *Stream) consumerStreams(consumer *streamConsumer) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), consumer.streams...)
+}
+
+func (s *Stream) subscribe(streams []string) error {
+	_, err := s.sendCommand(func(id uint64) *StreamCommand {
+		return newCommandSubscribe(id, streams)
+	})
+	return err
+}
+
+// Unsubscribe
+// remove the handlers of Subscribe* methods from the streams, the streams which are still used by
+// Subscribe or SubscribeFunc are kept subscribed on server for them
+func (s *Stream) Unsubscribe(streams []string) error {
+	s.mu.Lock()
+	unsubscribe := make([]string, 0)
+	for _, stream := range streams {
+		state, ok := s.streams[stream]
+		if !ok {
+			continue
+		}
+		for _, consumer := range append([]*streamConsumer(nil), state.consumers...) {
+			if consumer.handlers && s.detach(consumer, stream) {
+				unsubscribe = append(unsubscribe, stream)
+			}
+		}
+	}
+
+	return s.releaseStreams(unsubscribe)
+}
+
+func (s *Stream) unsubscribe(streams []string) error {
+	if len(streams) == 0 {
+		return nil
+	}
+	if s.ws.logger.CanDebug() {
+		s.ws.logger.Debug(fmt.Sprintf("websocket[%d] unsubscribe stream", s.ws.id))
+	}
+
+	_, err := s.sendCommand(func(id uint64) *StreamCommand {
+		return newCommandUnSubscribe(id, streams)
+	})
+	return err
+}
+
+// ListSubscription
+// streams subscribed on server
+func (s *Stream) ListSubscription() ([]string, error) {
+	response, err := s.sendCommand(newCommandListSubscription)
 	if err != nil {
 		s.ws.logger.Error("list subscription error")
 		if s.ws.logger.CanTrace() {
 			s.ws.logger.Error(err.Error())
 		}
+		return nil, err
+	}
+
+	streams := make([]string, 0)
+	_, err = jsonparser.ArrayEach(response.Result, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		streams = append(streams, string(value))
+	})
+	if err != nil && len(response.Result) > 0 && string(response.Result) != "null" {
+		return nil, err
+	}
+
+	return streams, nil
+}
+
+// sendCommand
+// write the command with next id and wait for the response of the same id,
+// error of server is returned as *StreamCommandError
+func (s *Stream) sendCommand(newCommand func(id uint64) *StreamCommand) (*StreamCommandResponse, error) {
+	s.mu.Lock()
+	command := newCommand(s.requestId)
+	s.requestId = s.requestId + 1
+	timeout := s.commandTimeout
+	wait := s.reserveCommand()
+	ch := make(chan *StreamCommandResponse, 1)
+	s.pendingCommands[command.Id] = ch
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.pendingCommands, command.Id)
+		s.mu.Unlock()
+	}()
+
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+	if err := s.ws.WriteJSON(command); err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case response, ok := <-ch:
+		if !ok {
+			return nil, ErrConnectionReset
+		}
+		if response.Error != nil {
+			return response, response.Error
+		}
+		return response, nil
+	case <-timer.C:
+		return nil, ErrCommandTimeout
+	}
+}
+
+// reserveCommand
+// time to wait before writing the next command, commands are spaced by commandInterval
+func (s *Stream) reserveCommand() time.Duration {
+	if s.commandInterval <= 0 {
+		return 0
+	}
+
+	now := time.Now()
+	if s.nextCommand.Before(now) {
+		s.nextCommand = now
+	}
+	wait := s.nextCommand.Sub(now)
+	s.nextCommand = s.nextCommand.Add(s.commandInterval)
+	return wait
+}
+
+func (s *Stream) commandHandler(message []byte) {
+	response, err := parseStreamCommandResponse(message)
+	if err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if ch, ok := s.pendingCommands[response.Id]; ok {
+		ch <- response
+		delete(s.pendingCommands, response.Id)
+	}
+}
+
+// failPendingCommands
+// responses of the previous connection are never received
+func (s *Stream) failPendingCommands() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, ch := range s.pendingCommands {
+		close(ch)
+		delete(s.pendingCommands, id)
 	}
 }
 
@@ -172,63 +555,58 @@ func (s *Stream) readMessage() {
 			if err == nil {
 				streamData, err := parseStreamData(message)
 				if err == nil {
-					go s.messageHandler(streamData)
+					s.dispatchStreamData(streamData)
 				} else {
-					// on event subscribe, unsubscribe, listSubscription
-
+					// response of subscribe, unsubscribe, listSubscription
+					s.commandHandler(message)
 				}
 			}
 		}
 	}
 }
 
+// dispatchStreamData
+// every message of tickers, book tickers and partial book depth is a full state, only the latest is needed
+func (s *Stream) dispatchStreamData(streamData *StreamData) {
+	handle := func() {
+		s.messageHandler(streamData)
+	}
+
+	streamType, _ := s.streamType(streamData.Stream)
+	switch streamType {
+	case IndividualMiniTickerStreamType, AllMarketMiniTickersStreamType, IndividualTickerStreamType, AllMarketTickersStreamType,
+		IndividualBookTickerStreamType, AllBookTickersStreamType, PartialBookDepthStreamType, PartialBookDepth100msStreamType:
+		s.dispatcher.dispatchConflatable(streamData.Stream, handle)
+	default:
+		s.dispatcher.dispatch(streamData.Stream, handle)
+	}
+}
+
+// Stats
+// messages dispatched, dropped and conflated by WithSlowConsumerPolicy
+func (s *Stream) Stats() DispatchStats {
+	return s.dispatcher.stats()
+}
+
 func (s *Stream) messageHandler(streamData *StreamData) {
-	if streamType, ok := s.streams[streamData.Stream]; ok {
-		switch streamType {
-		case AggregateTradeStreamType:
-			if r, err := parseAggregateTradeStream(streamData.Data); err == nil {
-				s.callAggTradeStreamHandler(streamData.Stream, r)
-			}
-		case TradeStreamType:
-			if r, err := parseTradeStream(streamData.Data); err == nil {
-				s.callTradeStreamHandler(streamData.Stream, r)
-			}
-		case KlineStreamType:
-			if r, err := parseKlineStream(streamData.Data); err == nil {
-				s.callKlineStreamHandler(streamData.Stream, r)
-			}
-		case IndividualMiniTickerStreamType:
-			if r, err := parseIndividualMiniTickerStream(streamData.Data); err == nil {
-				s.callIndividualMiniTickerStreamHandler(streamData.Stream, r)
-			}
-		case AllMarketMiniTickersStreamType:
-			if r, err := parseAllMiniTickerStream(streamData.Data); err == nil {
-				s.callAllMarketMiniTickerStreamHandler(streamData.Stream, r)
-			}
-		case IndividualTickerStreamType:
-			if r, err := parseIndividualTickerStream(streamData.Data); err == nil {
-				s.callIndividualTickerStreamHandler(streamData.Stream, r)
-			}
-		case AllMarketTickersStreamType:
-			if r, err := parseAllMarketTickersStreamHandler(streamData.Data); err == nil {
-				s.callAllMarketTickersStreamHandler(streamData.Stream, r)
-			}
-		case IndividualBookTickerStreamType:
-			if r, err := parseIndividualBookTickerStream(streamData.Data); err == nil {
-				s.callIndividualBookTickerStreamHandler(streamData.Stream, r)
-			}
-		case AllBookTickersStreamType:
-			if r, err := parseIndividualBookTickerStream(streamData.Data); err == nil {
-				s.callAllBookTickerStreamHandler(streamData.Stream, r)
-			}
-		case PartialBookDepthStreamType, PartialBookDepth100msStreamType:
-			if r, err := parsePartialBookDepthStream(streamData.Data); err == nil {
-				s.callPartialBookDepthStreamHandler(streamData.Stream, r)
-			}
-		case DiffDepthStreamType, DiffDepth100msStreamType:
-			if r, err := parseDiffDepthStream(streamData.Data); err == nil {
-				s.callDiffDepthStreamHandler(streamData.Stream, r)
-			}
-		}
+	s.mu.Lock()
+	var consumers []*streamConsumer
+	if state, ok := s.streams[streamData.Stream]; ok {
+		consumers = state.consumers
+	}
+	offset := clockOffset(s.clock)
+	s.mu.Unlock()
+
+	for _, consumer := range consumers {
+		consumer.deliver(streamData.Stream, streamData.Data, offset)
+	}
+}
+
+// clockOffset
+// offset of lib.ConvertIntToTime in milliseconds, 0 without clock
+func clockOffset(clock *lib.Clock) int64 {
+	if clock == nil {
+		return 0
 	}
+	return clock.Offset().Milliseconds()
 }
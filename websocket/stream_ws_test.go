@@ -0,0 +1,330 @@
+package websocket
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/gorilla/websocket"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testStreamServer
+// websocket server of market streams, the subscriptions of each connection are kept in order of the commands
+type testStreamServer struct {
+	url         string
+	mu          sync.Mutex
+	connections map[*websocket.Conn]map[string]bool
+}
+
+// newTestStreamServer
+// handler returns the response of a command, nil for no response. Without handler, every command succeeds.
+// A command is applied to the subscriptions of the connection unless its response is an error
+func newTestStreamServer(t *testing.T, handler func(command *StreamCommand) []byte) *testStreamServer {
+	upgrader := websocket.Upgrader{}
+	s := &testStreamServer{connections: make(map[*websocket.Conn]map[string]bool)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		s.mu.Lock()
+		s.connections[conn] = make(map[string]bool)
+		s.mu.Unlock()
+		defer func() {
+			s.mu.Lock()
+			delete(s.connections, conn)
+			s.mu.Unlock()
+		}()
+
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			command := new(StreamCommand)
+			if err = json.Unmarshal(message, command); err != nil {
+				continue
+			}
+			var response []byte
+			if handler != nil {
+				response = handler(command)
+			}
+
+			s.mu.Lock()
+			if handler == nil {
+				response = testCommandResponse(command, s.connections[conn])
+			}
+			if response != nil && !strings.Contains(string(response), `"error"`) {
+				for _, stream := range command.Params {
+					switch command.Method {
+					case "SUBSCRIBE":
+						s.connections[conn][stream] = true
+					case "UNSUBSCRIBE":
+						delete(s.connections[conn], stream)
+					}
+				}
+			}
+			if response != nil {
+				_ = conn.WriteMessage(websocket.TextMessage, response)
+			}
+			s.mu.Unlock()
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	s.url = "ws" + strings.TrimPrefix(server.URL, "http")
+	return s
+}
+
+// testCommandResponse
+// success of command, LIST_SUBSCRIPTIONS is answered by streams
+func testCommandResponse(command *StreamCommand, streams map[string]bool) []byte {
+	result := "null"
+	if command.Method == "LIST_SUBSCRIPTIONS" {
+		names := make([]string, 0)
+		for stream := range streams {
+			names = append(names, `"`+stream+`"`)
+		}
+		result = "[" + strings.Join(names, ",") + "]"
+	}
+	return []byte(`{"result":` + result + `,"id":` + strconv.FormatUint(command.Id, 10) + `}`)
+}
+
+// push
+// send message to the connections subscribing stream, every connection when stream is empty
+func (s *testStreamServer) push(stream string, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for conn, streams := range s.connections {
+		if stream == "" || streams[stream] {
+			_ = conn.WriteMessage(websocket.TextMessage, []byte(message))
+		}
+	}
+}
+
+// subscriptions
+// streams subscribed on every connection
+func (s *testStreamServer) subscriptions() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	streams := make([]string, 0)
+	for _, v := range s.connections {
+		for stream := range v {
+			streams = append(streams, stream)
+		}
+	}
+	sort.Strings(streams)
+	return streams
+}
+
+// newTestStream
+// handler returns the response of a command, nil for no response.
+// push sends a message to the stream
+func newTestStream(t *testing.T, handler func(command *StreamCommand) []byte) (stream *Stream, push func(message string)) {
+	server := newTestStreamServer(t, handler)
+	stream, err := NewWsStream(WithBaseUrl(server.url))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(stream.Shutdown)
+
+	return stream, func(message string) {
+		server.push("", message)
+	}
+}
+
+func TestStream_Command(t *testing.T) {
+	stream, _ := newTestStream(t, func(command *StreamCommand) []byte {
+		id := []byte(`,"id":` + strconv.FormatUint(command.Id, 10) + `}`)
+		switch command.Method {
+		case "SUBSCRIBE":
+			if command.Params[0] == "btcusdt@kline_1x" {
+				return append([]byte(`{"error":{"code":2,"msg":"Invalid request: unknown variable"}`), id...)
+			}
+			if command.Params[0] == "ethusdt@trade" {
+				return nil
+			}
+			return append([]byte(`{"result":null`), id...)
+		case "UNSUBSCRIBE":
+			return append([]byte(`{"result":null`), id...)
+		case "LIST_SUBSCRIPTIONS":
+			return append([]byte(`{"result":["btcusdt@aggTrade","bnbbtc@depth"]`), id...)
+		}
+		return nil
+	})
+	stream.SetCommandTimeout(100 * time.Millisecond)
+
+	if err := stream.SubscribeRawStreams([]string{"btcusdt@aggTrade"}, func(stream string, data []byte) {}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := stream.streamType("btcusdt@aggTrade"); !ok {
+		t.Error("stream is not added")
+	}
+
+	var commandError *StreamCommandError
+	if err := stream.SubscribeRawStreams([]string{"btcusdt@kline_1x"}, func(stream string, data []byte) {}); !errors.As(err, &commandError) || commandError.Code != 2 {
+		t.Errorf("err = %v", err)
+	}
+	if _, ok := stream.streamType("btcusdt@kline_1x"); ok {
+		t.Error("rejected stream is added")
+	}
+
+	if err := stream.SubscribeRawStreams([]string{"ethusdt@trade"}, func(stream string, data []byte) {}); !errors.Is(err, ErrCommandTimeout) {
+		t.Errorf("err = %v", err)
+	}
+
+	streams, err := stream.ListSubscription()
+	if err != nil || len(streams) != 2 || streams[0] != "btcusdt@aggTrade" || streams[1] != "bnbbtc@depth" {
+		t.Errorf("streams = %v, err = %v", streams, err)
+	}
+
+	if err = stream.Unsubscribe([]string{"btcusdt@aggTrade"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := stream.streamType("btcusdt@aggTrade"); ok {
+		t.Error("stream is not removed")
+	}
+}
+
+type testSymbolChecker map[string]bool
+
+func (c testSymbolChecker) HasSymbol(symbol string) bool {
+	return c[symbol]
+}
+
+func TestStream_ValidateStreamsSymbolChecker(t *testing.T) {
+	stream := &Stream{symbolChecker: testSymbolChecker{"BTCUSDT": true}}
+
+	if err := stream.validateStreams([]string{"btcusdt@trade"}, "^[a-z0-9]+@trade$"); err != nil {
+		t.Errorf("err = %v", err)
+	}
+	if err := stream.validateStreams([]string{"ethusdt@trade"}, "^[a-z0-9]+@trade$"); !errors.Is(err, ErrStreamSymbolUnknown) {
+		t.Errorf("err = %v", err)
+	}
+	// all market streams have no symbol to check
+	for _, v := range []string{"!miniTicker@arr", "!ticker@arr", "!bookTicker"} {
+		if err := stream.validateStreams([]string{v}, "^!(miniTicker@arr|ticker@arr|bookTicker)$"); err != nil {
+			t.Errorf("%s err = %v", v, err)
+		}
+	}
+}
+
+func TestStream_SubscribeHandlers(t *testing.T) {
+	server := newTestStreamServer(t, nil)
+	stream, err := NewWsStream(WithBaseUrl(server.url))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(stream.Shutdown)
+
+	received := make(chan string, 16)
+	newHandler := func(name string) TradeStreamHandler {
+		return func(stream string, data *TradeStream, err error) {
+			received <- name + " " + stream + " " + strconv.FormatInt(data.TradeId, 10)
+		}
+	}
+	if err = stream.SubscribeTradeStreams([]string{"btcusdt@trade"}, newHandler("first")); err != nil {
+		t.Fatal(err)
+	}
+	if err = stream.SubscribeTradeStreams([]string{"ethusdt@trade"}, newHandler("second")); err != nil {
+		t.Fatal(err)
+	}
+	if err = stream.SubscribeTradeStreams([]string{"bnbusdt@trade"}, newHandler("first"), newHandler("second")); err != nil {
+		t.Fatal(err)
+	}
+	// handlers of the previous calls are not reused
+	if err = stream.SubscribeTradeStreams([]string{"xrpusdt@trade"}); !errors.Is(err, ErrNoStreamHandler) {
+		t.Errorf("err = %v", err)
+	}
+
+	expect := func(expected ...string) {
+		got := make(map[string]bool)
+		for range expected {
+			select {
+			case v := <-received:
+				got[v] = true
+			case <-time.After(time.Second):
+				t.Fatalf("timeout, received %v", got)
+			}
+		}
+		for _, v := range expected {
+			if !got[v] {
+				t.Errorf("%s is not received, received %v", v, got)
+			}
+		}
+		select {
+		case v := <-received:
+			t.Errorf("unexpected %s", v)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+	pushTrade := func(stream string, id int) {
+		server.push(stream, `{"stream":"`+stream+`","data":{"e":"trade","E":123456789,"s":"BTCUSDT","t":`+strconv.Itoa(id)+`,"p":"0.001","q":"100","b":88,"a":50,"T":123456785,"m":true,"M":true}}`)
+	}
+
+	pushTrade("btcusdt@trade", 1)
+	pushTrade("ethusdt@trade", 2)
+	pushTrade("bnbusdt@trade", 3)
+	expect("first btcusdt@trade 1", "second ethusdt@trade 2", "first bnbusdt@trade 3", "second bnbusdt@trade 3")
+
+	if err = stream.Unsubscribe([]string{"btcusdt@trade", "bnbusdt@trade"}); err != nil {
+		t.Fatal(err)
+	}
+	if streams := server.subscriptions(); strings.Join(streams, ",") != "ethusdt@trade" {
+		t.Errorf("streams = %v", streams)
+	}
+	pushTrade("ethusdt@trade", 4)
+	expect("second ethusdt@trade 4")
+}
+
+func TestStream_AddRemoveConsumer(t *testing.T) {
+	server := newTestStreamServer(t, nil)
+	stream, err := NewWsStream(WithBaseUrl(server.url), WithCommandRate(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(stream.Shutdown)
+
+	// the stream is never unsubscribed on server while a consumer is added
+	for i := 0; i < 20; i++ {
+		first, err := Subscribe(stream, TradeStreams, []string{"btcusdt@trade"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var wg sync.WaitGroup
+		var second *Subscription[*TradeStream]
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_ = first.Close()
+		}()
+		go func() {
+			defer wg.Done()
+			second, err = Subscribe(stream, TradeStreams, []string{"btcusdt@trade"})
+		}()
+		wg.Wait()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if streams := server.subscriptions(); strings.Join(streams, ",") != "btcusdt@trade" {
+			t.Fatalf("streams = %v", streams)
+		}
+		if err = second.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if streams := server.subscriptions(); len(streams) != 0 {
+			t.Fatalf("streams = %v", streams)
+		}
+	}
+}
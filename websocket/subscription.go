@@ -0,0 +1,294 @@
+package websocket
+
+import (
+	"errors"
+	"sync"
+)
+
+var (
+	ErrSubscriptionClosed = errors.New("subscription is closed")
+	ErrStreamShutdown     = errors.New("stream is shutdown")
+)
+
+// StreamSource
+// *Stream or *StreamPool for Subscribe and SubscribeFunc
+type StreamSource interface {
+	validateStreams(streams []string, pattern string) error
+	addConsumer(streamType StreamType, consumer *streamConsumer) error
+	removeConsumer(consumer *streamConsumer, streams []string) error
+	consumerStreams(consumer *streamConsumer) []string
+}
+
+// StreamKind
+// type of streams for Subscribe and the parser of their data
+type StreamKind[T any] struct {
+	streamType StreamType
+	pattern    string
+	parse      func(stream string, data []byte, offset int64) (T, error)
+}
+
+// RawStreamMessage
+// message of RawStreams, data is not parsed
+type RawStreamMessage struct {
+	Stream string
+	Data   []byte
+}
+
+func newStreamKind[T any](streamType StreamType, pattern string, parse func([]byte, int64) (T, error)) *StreamKind[T] {
+	return &StreamKind[T]{
+		streamType: streamType,
+		pattern:    pattern,
+		parse: func(_ string, data []byte, offset int64) (T, error) {
+			return parse(data, offset)
+		},
+	}
+}
+
+var (
+	AggregateTradeStreams       = newStreamKind(AggregateTradeStreamType, "^[a-z0-9_]+@aggTrade$", parseAggregateTradeStream)
+	TradeStreams                = newStreamKind(TradeStreamType, "^[a-z0-9_]+@trade$", parseTradeStream)
+	KlineStreams                = newStreamKind(KlineStreamType, "^[a-z0-9_]+@kline_(1m|3m|5m|15m|30m|1h|2h|4h|6h|8h|12h|1d|3d|1w|1M)$", parseKlineStream)
+	IndividualMiniTickerStreams = newStreamKind(IndividualMiniTickerStreamType, "^[a-z0-9_]+@miniTicker$", parseIndividualMiniTickerStream)
+	// AllMarketMiniTickersStreams
+	// stream '!miniTicker@arr'
+	AllMarketMiniTickersStreams = newStreamKind(AllMarketMiniTickersStreamType, "^!miniTicker@arr$", parseAllMiniTickerStream)
+	IndividualTickerStreams     = newStreamKind(IndividualTickerStreamType, "^[a-z0-9_]+@ticker$", parseIndividualTickerStream)
+	// AllMarketTickersStreams
+	// stream '!ticker@arr'
+	AllMarketTickersStreams     = newStreamKind(AllMarketTickersStreamType, "^!ticker@arr$", parseAllMarketTickersStreamHandler)
+	IndividualBookTickerStreams = newStreamKind(IndividualBookTickerStreamType, "^[a-z0-9_]+@bookTicker$", parseIndividualBookTickerStream)
+	// AllBookTickersStreams
+	// stream '!bookTicker'
+	AllBookTickersStreams   = newStreamKind(AllBookTickersStreamType, "^!bookTicker$", parseIndividualBookTickerStream)
+	PartialBookDepthStreams = newStreamKind(PartialBookDepthStreamType, "^[a-z0-9_]+@depth(5|10|20)(@100ms)?$", parsePartialBookDepthStream)
+	DiffDepthStreams        = newStreamKind(DiffDepthStreamType, "^[a-z0-9_]+@depth(@100ms)?$", parseDiffDepthStream)
+	// RawStreams
+	// streams of any name, e.g. streams of futures '<symbol>@markPrice@1s'
+	RawStreams = &StreamKind[*RawStreamMessage]{
+		streamType: RawStreamType,
+		pattern:    "^[a-zA-Z0-9!_@]+$",
+		parse: func(stream string, data []byte, _ int64) (*RawStreamMessage, error) {
+			return &RawStreamMessage{Stream: stream, Data: data}, nil
+		},
+	}
+)
+
+type subscriptionConfig struct {
+	bufferSize int
+}
+
+type SubscriptionOption func(config *subscriptionConfig)
+
+// WithSubscriptionBuffer
+// messages waiting on Subscription.C(), default to 64.
+// The worker of the stream waits when it is full
+func WithSubscriptionBuffer(size int) SubscriptionOption {
+	return func(config *subscriptionConfig) {
+		config.bufferSize = size
+	}
+}
+
+// Subscription
+// messages of the streams for one consumer, in order of each stream
+type Subscription[T any] struct {
+	stream    StreamSource
+	kind      *StreamKind[T]
+	consumer  *streamConsumer
+	ch        chan T
+	done      chan struct{}
+	closeOnce sync.Once
+	mu        sync.RWMutex
+	closed    bool
+	err       error
+}
+
+// Subscribe
+//   - typed messages of streams on Subscription.C(), e.g. Subscribe(ws, KlineStreams, []string{"btcusdt@kline_1m"})
+//   - several subscriptions can share a stream, it is subscribed on server by the first one
+//     and unsubscribed when the last one is closed
+func Subscribe[T any](s StreamSource, kind *StreamKind[T], streams []string, opts ...SubscriptionOption) (*Subscription[T], error) {
+	if err := s.validateStreams(streams, kind.pattern); err != nil {
+		return nil, err
+	}
+
+	config := &subscriptionConfig{
+		bufferSize: 64,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	subscription := &Subscription[T]{
+		stream: s,
+		kind:   kind,
+		ch:     make(chan T, config.bufferSize),
+		done:   make(chan struct{}),
+	}
+	subscription.consumer = &streamConsumer{
+		streams:  append([]string(nil), streams...),
+		deliver:  subscription.deliver,
+		shutdown: subscription.shutdown,
+	}
+
+	if err := s.addConsumer(kind.streamType, subscription.consumer); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// C
+// closed after Close or Shutdown of the stream
+func (r *Subscription[T]) C() <-chan T {
+	return r.ch
+}
+
+// Err
+// nil while the subscription is open, ErrSubscriptionClosed or ErrStreamShutdown after C() is closed
+func (r *Subscription[T]) Err() error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.err
+}
+
+// Streams
+// streams of the subscription
+func (r *Subscription[T]) Streams() []string {
+	return r.stream.consumerStreams(r.consumer)
+}
+
+// Close
+// stop the messages of this subscription only, the error of UNSUBSCRIBE is returned
+func (r *Subscription[T]) Close() error {
+	var err error
+	r.closeOnce.Do(func() {
+		close(r.done)
+		err = r.stream.removeConsumer(r.consumer, nil)
+		r.finish(ErrSubscriptionClosed)
+	})
+	return err
+}
+
+func (r *Subscription[T]) shutdown() {
+	r.closeOnce.Do(func() {
+		close(r.done)
+		r.finish(ErrStreamShutdown)
+	})
+}
+
+// finish
+// close ch after the delivering message is given up
+func (r *Subscription[T]) finish(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.closed = true
+	r.err = err
+	close(r.ch)
+}
+
+func (r *Subscription[T]) deliver(stream string, data []byte, offset int64) {
+	v, err := r.kind.parse(stream, data, offset)
+	if err != nil {
+		return
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.closed {
+		return
+	}
+	select {
+	case r.ch <- v:
+	case <-r.done:
+	}
+}
+
+// StreamHandle
+// handler of SubscribeFunc bound to its streams
+type StreamHandle struct {
+	stream   StreamSource
+	consumer *streamConsumer
+	mu       sync.Mutex
+	active   map[string]bool
+}
+
+// SubscribeFunc
+//   - handler is called with typed messages of streams in order of each stream,
+//     e.g. SubscribeFunc(ws, KlineStreams, []string{"btcusdt@kline_1m"}, func(stream string, data *KlineStream) {})
+//   - handler is bound to the streams only, unlike the handlers of Subscribe* methods
+//   - a stream is unsubscribed on server when its last handle or subscription is removed
+func SubscribeFunc[T any](s StreamSource, kind *StreamKind[T], streams []string, handler func(stream string, data T)) (*StreamHandle, error) {
+	if handler == nil {
+		return nil, ErrNoStreamHandler
+	}
+	if err := s.validateStreams(streams, kind.pattern); err != nil {
+		return nil, err
+	}
+
+	handle := &StreamHandle{
+		stream: s,
+		active: make(map[string]bool),
+	}
+	for _, stream := range streams {
+		handle.active[stream] = true
+	}
+	handle.consumer = &streamConsumer{
+		streams: append([]string(nil), streams...),
+		deliver: func(stream string, data []byte, offset int64) {
+			if !handle.isActive(stream) {
+				return
+			}
+			if v, err := kind.parse(stream, data, offset); err == nil {
+				handler(stream, v)
+			}
+		},
+		shutdown: func() {},
+	}
+
+	if err := s.addConsumer(kind.streamType, handle.consumer); err != nil {
+		return nil, err
+	}
+	return handle, nil
+}
+
+// Streams
+// streams of the handler which are not removed
+func (h *StreamHandle) Streams() []string {
+	return h.stream.consumerStreams(h.consumer)
+}
+
+// RemoveStream
+// stop calling the handler for streams, other handlers of the streams are not affected.
+// A message being handled may still be passed
+func (h *StreamHandle) RemoveStream(streams ...string) error {
+	if len(streams) == 0 {
+		return nil
+	}
+
+	h.mu.Lock()
+	for _, stream := range streams {
+		delete(h.active, stream)
+	}
+	h.mu.Unlock()
+
+	return h.stream.removeConsumer(h.consumer, streams)
+}
+
+// Remove
+// stop calling the handler for all of its streams
+func (h *StreamHandle) Remove() error {
+	h.mu.Lock()
+	h.active = make(map[string]bool)
+	h.mu.Unlock()
+
+	return h.stream.removeConsumer(h.consumer, nil)
+}
+
+func (h *StreamHandle) isActive(stream string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.active[stream]
+}
@@ -0,0 +1,244 @@
+package websocket
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSubscribe(t *testing.T) {
+	var mu sync.Mutex
+	commands := make([]string, 0)
+	stream, push := newTestStream(t, func(command *StreamCommand) []byte {
+		mu.Lock()
+		commands = append(commands, command.Method+" "+strings.Join(command.Params, ","))
+		mu.Unlock()
+		return []byte(`{"result":null,"id":` + strconv.FormatUint(command.Id, 10) + `}`)
+	})
+
+	first, err := Subscribe(stream, TradeStreams, []string{"btcusdt@trade"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := Subscribe(stream, TradeStreams, []string{"btcusdt@trade", "ethusdt@trade"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = Subscribe(stream, TradeStreams, []string{"btcusdt@aggTrade"}); !errors.Is(err, ErrStreamSymbolInvalid) {
+		t.Errorf("err = %v", err)
+	}
+
+	for i := 1; i <= 3; i++ {
+		push(`{"stream":"btcusdt@trade","data":{"e":"trade","E":123456789,"s":"BTCUSDT","t":` + strconv.Itoa(i) + `,"p":"0.001","q":"100","b":88,"a":50,"T":123456785,"m":true,"M":true}}`)
+	}
+	push(`{"stream":"ethusdt@trade","data":{"e":"trade","E":123456789,"s":"ETHUSDT","t":4,"p":"0.001","q":"100","b":88,"a":50,"T":123456785,"m":true,"M":true}}`)
+
+	receive := func(subscription *Subscription[*TradeStream]) int64 {
+		select {
+		case v := <-subscription.C():
+			return v.TradeId
+		case <-time.After(time.Second):
+			t.Fatal("timeout")
+		}
+		return 0
+	}
+	for i := int64(1); i <= 3; i++ {
+		if v := receive(first); v != i {
+			t.Errorf("first received %d, expected %d", v, i)
+		}
+	}
+	// btcusdt and ethusdt are on different workers, order between streams is not kept
+	received := make(map[int64]bool)
+	for i := 0; i < 4; i++ {
+		received[receive(second)] = true
+	}
+	if len(received) != 4 {
+		t.Errorf("second received %v", received)
+	}
+
+	// btcusdt@trade is still consumed by second
+	if err = first.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-first.C(); ok || !errors.Is(first.Err(), ErrSubscriptionClosed) {
+		t.Errorf("first is not closed, err = %v", first.Err())
+	}
+	if second.Err() != nil {
+		t.Errorf("second err = %v", second.Err())
+	}
+	if err = second.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	expected := []string{"SUBSCRIBE btcusdt@trade", "SUBSCRIBE ethusdt@trade", "UNSUBSCRIBE btcusdt@trade,ethusdt@trade"}
+	if strings.Join(commands, "|") != strings.Join(expected, "|") {
+		t.Errorf("commands = %v", commands)
+	}
+}
+
+func TestSubscribe_Shutdown(t *testing.T) {
+	stream, _ := newTestStream(t, func(command *StreamCommand) []byte {
+		return []byte(`{"result":null,"id":` + strconv.FormatUint(command.Id, 10) + `}`)
+	})
+
+	subscription, err := Subscribe(stream, RawStreams, []string{"btcusdt@markPrice@1s"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream.Shutdown()
+	if _, ok := <-subscription.C(); ok || !errors.Is(subscription.Err(), ErrStreamShutdown) {
+		t.Errorf("err = %v", subscription.Err())
+	}
+}
+
+func TestSubscribe_ShutdownFullBuffer(t *testing.T) {
+	stream, push := newTestStream(t, func(command *StreamCommand) []byte {
+		return []byte(`{"result":null,"id":` + strconv.FormatUint(command.Id, 10) + `}`)
+	})
+
+	subscription, err := Subscribe(stream, RawStreams, []string{"btcusdt@markPrice@1s"}, WithSubscriptionBuffer(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// C() is never read, the worker waits on the full buffer
+	for i := 0; i < 5; i++ {
+		push(`{"stream":"btcusdt@markPrice@1s","data":{"e":"markPriceUpdate"}}`)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		stream.Shutdown()
+	}()
+	select {
+	case <-done:
+	case <-time.After(15 * time.Second):
+		t.Fatal("shutdown is blocked by the full subscription")
+	}
+	if !errors.Is(subscription.Err(), ErrStreamShutdown) {
+		t.Errorf("err = %v", subscription.Err())
+	}
+}
+
+func TestSubscribeFunc(t *testing.T) {
+	var mu sync.Mutex
+	commands := make([]string, 0)
+	stream, push := newTestStream(t, func(command *StreamCommand) []byte {
+		mu.Lock()
+		commands = append(commands, command.Method+" "+strings.Join(command.Params, ","))
+		mu.Unlock()
+		return []byte(`{"result":null,"id":` + strconv.FormatUint(command.Id, 10) + `}`)
+	})
+
+	received := make(chan string, 16)
+	newHandler := func(name string) func(string, *TradeStream) {
+		return func(stream string, data *TradeStream) {
+			received <- name + " " + stream + " " + strconv.FormatInt(data.TradeId, 10)
+		}
+	}
+	first, err := SubscribeFunc(stream, TradeStreams, []string{"btcusdt@trade", "ethusdt@trade"}, newHandler("first"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := SubscribeFunc(stream, TradeStreams, []string{"ethusdt@trade"}, newHandler("second"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = stream.SubscribeTradeStreams([]string{"btcusdt@trade"}, func(string, *TradeStream, error) {}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = SubscribeFunc[*TradeStream](stream, TradeStreams, []string{"btcusdt@trade"}, nil); !errors.Is(err, ErrNoStreamHandler) {
+		t.Errorf("err = %v", err)
+	}
+
+	expect := func(expected ...string) {
+		got := make(map[string]bool)
+		for range expected {
+			select {
+			case v := <-received:
+				got[v] = true
+			case <-time.After(time.Second):
+				t.Fatalf("timeout, received %v", got)
+			}
+		}
+		for _, v := range expected {
+			if !got[v] {
+				t.Errorf("%s is not received, received %v", v, got)
+			}
+		}
+		select {
+		case v := <-received:
+			t.Errorf("unexpected %s", v)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+	pushTrade := func(stream string, id int) {
+		push(`{"stream":"` + stream + `","data":{"e":"trade","E":123456789,"s":"BTCUSDT","t":` + strconv.Itoa(id) + `,"p":"0.001","q":"100","b":88,"a":50,"T":123456785,"m":true,"M":true}}`)
+	}
+
+	pushTrade("btcusdt@trade", 1)
+	pushTrade("ethusdt@trade", 2)
+	expect("first btcusdt@trade 1", "first ethusdt@trade 2", "second ethusdt@trade 2")
+
+	// ethusdt@trade is still handled by second
+	if err = first.RemoveStream("ethusdt@trade"); err != nil {
+		t.Fatal(err)
+	}
+	if streams := first.Streams(); len(streams) != 1 || streams[0] != "btcusdt@trade" {
+		t.Errorf("streams = %v", streams)
+	}
+	pushTrade("btcusdt@trade", 3)
+	pushTrade("ethusdt@trade", 4)
+	expect("first btcusdt@trade 3", "second ethusdt@trade 4")
+
+	// btcusdt@trade is still handled by first
+	if err = stream.Unsubscribe([]string{"btcusdt@trade"}); err != nil {
+		t.Fatal(err)
+	}
+	pushTrade("btcusdt@trade", 5)
+	expect("first btcusdt@trade 5")
+
+	if err = first.Remove(); err != nil {
+		t.Fatal(err)
+	}
+	if err = second.Remove(); err != nil {
+		t.Fatal(err)
+	}
+	pushTrade("btcusdt@trade", 6)
+	pushTrade("ethusdt@trade", 7)
+	expect()
+
+	mu.Lock()
+	defer mu.Unlock()
+	// btcusdt@trade of SubscribeTradeStreams is subscribed already
+	expected := []string{"SUBSCRIBE btcusdt@trade,ethusdt@trade", "UNSUBSCRIBE btcusdt@trade", "UNSUBSCRIBE ethusdt@trade"}
+	if strings.Join(commands, "|") != strings.Join(expected, "|") {
+		t.Errorf("commands = %v", commands)
+	}
+}
+
+func TestStreamKind_Pattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		stream  string
+	}{
+		{TradeStreams.pattern, "btcusdt@trade"},
+		{KlineStreams.pattern, "btcusd_perp@kline_1m"},
+		{AggregateTradeStreams.pattern, "btcusd_220624@aggTrade"},
+		{DiffDepthStreams.pattern, "btcusd_perp@depth@100ms"},
+	}
+	for _, test := range tests {
+		if err := validateStreams([]string{test.stream}, test.pattern, nil); err != nil {
+			t.Errorf("%s: %v", test.stream, err)
+		}
+	}
+	if err := validateStreams([]string{"BTCUSDT@trade"}, TradeStreams.pattern, nil); err != ErrStreamSymbolInvalid {
+		t.Errorf("err = %v", err)
+	}
+}
@@ -0,0 +1,324 @@
+package websocket
+
+import (
+	"errors"
+	"fmt"
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/buger/jsonparser"
+	"sync"
+	"time"
+)
+
+var (
+	ErrListenKeyServiceRequired = errors.New("listen key service is required")
+)
+
+// ListenKeyService
+// create, keep alive and close listenKey of user data stream.
+// spot.API is implemented this interface
+type ListenKeyService interface {
+	CreateListenKey() (string, error)
+	KeepAliveListenKey(listenKey string) error
+	CloseListenKey(listenKey string) error
+}
+
+type UserDataStream struct {
+	ws         *Websocket
+	dispatcher *dispatcher
+	service    ListenKeyService
+	baseUrl    string
+	// event times are converted to local time by the clock (optional)
+	clock *lib.Clock
+	// default to 30 minutes
+	keepAliveInterval              time.Duration
+	listenKey                      string
+	mu                             sync.Mutex
+	done                           chan struct{}
+	outboundAccountPositionHandler []OutboundAccountPositionHandler
+	balanceUpdateHandler           []BalanceUpdateHandler
+	executionReportHandler         []ExecutionReportHandler
+	listStatusHandler              []ListStatusHandler
+	rawEventHandler                []RawEventHandler
+}
+
+// NewUserDataStream
+//   - obtain listenKey from service and connect to user data stream
+//   - listenKey is kept alive every 30 minutes, and re-created when it expired
+//   - https://binance-docs.github.io/apidocs/spot/en/#user-data-streams
+//   - WithBaseUrl for other markets, e.g. wss://fstream.binance.com/ws of USDⓈ-M futures
+//   - WithSlowConsumerPolicy is ignored, account events are never dropped or conflated
+func NewUserDataStream(service ListenKeyService, opts ...StreamOption) (*UserDataStream, error) {
+	return newUserDataStream(service, newStreamConfig("wss://stream.binance.com:9443/ws", append([]StreamOption{WithDispatchWorkers(1)}, opts...)))
+}
+
+func NewTestnetUserDataStream(service ListenKeyService, opts ...StreamOption) (*UserDataStream, error) {
+	return newUserDataStream(service, newStreamConfig("wss://testnet.binance.vision/ws", append([]StreamOption{WithDispatchWorkers(1)}, opts...)))
+}
+
+func newUserDataStream(service ListenKeyService, config *streamConfig) (*UserDataStream, error) {
+	if service == nil {
+		return nil, ErrListenKeyServiceRequired
+	}
+	// a lost execution report or balance update can not be recovered from the stream
+	config.slowConsumerPolicy = SlowConsumerPolicyBlock
+
+	listenKey, err := service.CreateListenKey()
+	if err != nil {
+		return nil, err
+	}
+
+	uds := &UserDataStream{
+		dispatcher:                     config.newDispatcher(),
+		service:                        service,
+		baseUrl:                        config.baseUrl,
+		keepAliveInterval:              30 * time.Minute,
+		listenKey:                      listenKey,
+		mu:                             sync.Mutex{},
+		done:                           make(chan struct{}),
+		outboundAccountPositionHandler: make([]OutboundAccountPositionHandler, 0),
+		balanceUpdateHandler:           make([]BalanceUpdateHandler, 0),
+		executionReportHandler:         make([]ExecutionReportHandler, 0),
+		listStatusHandler:              make([]ListStatusHandler, 0),
+		rawEventHandler:                make([]RawEventHandler, 0),
+	}
+	uds.ws = &Websocket{
+		id:           lib.RandomInt(),
+		url:          uds.streamUrl(listenKey),
+		PingDuration: 2 * time.Minute,
+		PongDuration: 5 * time.Minute,
+		mu:           sync.Mutex{},
+		logger:       lib.NewLogger("ws-binance-user-data", lib.LogLevelDebug),
+		wg:           sync.WaitGroup{},
+	}
+
+	uds.ws.Connect()
+	go uds.readMessage()
+	go uds.keepAlive()
+
+	return uds, nil
+}
+
+func (s *UserDataStream) streamUrl(listenKey string) string {
+	return fmt.Sprintf("%s/%s", s.baseUrl, listenKey)
+}
+
+// ListenKey
+// current listenKey of user data stream
+func (s *UserDataStream) ListenKey() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.listenKey
+}
+
+// SetClock
+// convert event times from server time to local time, e.g. API.Clock() of spot. By default, server time as is
+func (s *UserDataStream) SetClock(clock *lib.Clock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.clock = clock
+}
+
+// SubscribeOutboundAccountPosition
+//   - is sent any time an account balance has changed
+//   - https://binance-docs.github.io/apidocs/spot/en/#account-update
+func (s *UserDataStream) SubscribeOutboundAccountPosition(handler ...OutboundAccountPositionHandler) error {
+	if len(handler) == 0 {
+		return ErrNoStreamHandler
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.outboundAccountPositionHandler = append(s.outboundAccountPositionHandler, handler...)
+	return nil
+}
+
+// SubscribeBalanceUpdate
+//   - occurs during deposits or withdrawals from the account and transfer of funds between accounts
+//   - https://binance-docs.github.io/apidocs/spot/en/#balance-update
+func (s *UserDataStream) SubscribeBalanceUpdate(handler ...BalanceUpdateHandler) error {
+	if len(handler) == 0 {
+		return ErrNoStreamHandler
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.balanceUpdateHandler = append(s.balanceUpdateHandler, handler...)
+	return nil
+}
+
+// SubscribeExecutionReport
+//   - orders are updated with the executionReport event
+//   - https://binance-docs.github.io/apidocs/spot/en/#order-update
+func (s *UserDataStream) SubscribeExecutionReport(handler ...ExecutionReportHandler) error {
+	if len(handler) == 0 {
+		return ErrNoStreamHandler
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.executionReportHandler = append(s.executionReportHandler, handler...)
+	return nil
+}
+
+// SubscribeListStatus
+//   - sent in addition to the executionReport event when the order is an OCO
+//   - https://binance-docs.github.io/apidocs/spot/en/#order-update
+func (s *UserDataStream) SubscribeListStatus(handler ...ListStatusHandler) error {
+	if len(handler) == 0 {
+		return ErrNoStreamHandler
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.listStatusHandler = append(s.listStatusHandler, handler...)
+	return nil
+}
+
+// SubscribeRawEvent
+//   - every event is passed to handler without parsing, including the events of other markets
+//     e.g. ORDER_TRADE_UPDATE of futures
+func (s *UserDataStream) SubscribeRawEvent(handler ...RawEventHandler) error {
+	if len(handler) == 0 {
+		return ErrNoStreamHandler
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rawEventHandler = append(s.rawEventHandler, handler...)
+	return nil
+}
+
+// Stats
+// events dispatched and dropped by WithSlowConsumerPolicy, events are never conflated
+func (s *UserDataStream) Stats() DispatchStats {
+	return s.dispatcher.stats()
+}
+
+// Shutdown
+// stop keep alive, close listenKey and websocket
+func (s *UserDataStream) Shutdown() {
+	select {
+	case <-s.done:
+		return
+	default:
+		close(s.done)
+	}
+
+	if err := s.service.CloseListenKey(s.ListenKey()); err != nil {
+		s.ws.logger.Error(fmt.Sprintf("websocket[%d] close listenKey error: %s", s.ws.id, err.Error()))
+	}
+	s.ws.Shutdown()
+	s.dispatcher.close()
+}
+
+func (s *UserDataStream) keepAlive() {
+	ticker := time.NewTicker(s.keepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			s.keepAliveListenKey()
+		}
+	}
+}
+
+// keepAliveListenKey
+// listenKey is renewed when keep alive failed, e.g. it expired
+func (s *UserDataStream) keepAliveListenKey() {
+	err := s.service.KeepAliveListenKey(s.ListenKey())
+	if err == nil {
+		if s.ws.logger.CanDebug() {
+			s.ws.logger.Debug(fmt.Sprintf("websocket[%d] listenKey was kept alive", s.ws.id))
+		}
+		return
+	}
+
+	s.ws.logger.Error(fmt.Sprintf("websocket[%d] keep alive listenKey error: %s", s.ws.id, err.Error()))
+	s.renewListenKey()
+}
+
+// renewListenKey
+// create new listenKey and reconnect websocket with it
+func (s *UserDataStream) renewListenKey() {
+	listenKey, err := s.service.CreateListenKey()
+	if err != nil {
+		s.ws.logger.Error(fmt.Sprintf("websocket[%d] create listenKey error: %s", s.ws.id, err.Error()))
+		return
+	}
+
+	s.mu.Lock()
+	changed := s.listenKey != listenKey
+	s.listenKey = listenKey
+	s.mu.Unlock()
+
+	if changed {
+		s.ws.logger.Info(fmt.Sprintf("websocket[%d] listenKey was renewed, reconnecting", s.ws.id))
+		s.ws.redial(s.streamUrl(listenKey))
+	}
+}
+
+func (s *UserDataStream) readMessage() {
+	s.ws.SetPongHandler()
+	s.ws.wg.Add(1)
+
+	for {
+		if !s.ws.IsNotDone() {
+			s.ws.logger.Info(fmt.Sprintf("websocket[%d] stop read message", s.ws.id))
+			s.ws.wg.Done()
+			return
+		}
+		if s.ws.IsConnected() {
+			_, message, err := s.ws.ReadMessage()
+			if err == nil {
+				// events of user data are handled in order
+				s.dispatcher.dispatch("", func() {
+					s.messageHandler(message)
+				})
+			}
+		}
+	}
+}
+
+func (s *UserDataStream) messageHandler(message []byte) {
+	eventType, err := jsonparser.GetString(message, "e")
+	if err != nil {
+		return
+	}
+	s.callRawEventHandler(eventType, message)
+
+	s.mu.Lock()
+	offset := clockOffset(s.clock)
+	s.mu.Unlock()
+
+	switch eventType {
+	case OutboundAccountPositionEventType:
+		if r, err := parseOutboundAccountPositionEvent(message, offset); err == nil {
+			s.callOutboundAccountPositionHandler(r)
+		}
+	case BalanceUpdateEventType:
+		if r, err := parseBalanceUpdateEvent(message, offset); err == nil {
+			s.callBalanceUpdateHandler(r)
+		}
+	case ExecutionReportEventType:
+		if r, err := parseExecutionReportEvent(message, offset); err == nil {
+			s.callExecutionReportHandler(r)
+		}
+	case ListStatusEventType:
+		if r, err := parseListStatusEvent(message, offset); err == nil {
+			s.callListStatusHandler(r)
+		}
+	case ListenKeyExpiredEventType:
+		s.ws.logger.Info(fmt.Sprintf("websocket[%d] listenKey expired", s.ws.id))
+		s.renewListenKey()
+	}
+}
@@ -0,0 +1,106 @@
+package websocket
+
+// OutboundAccountPositionHandler
+//
+// func(value *OutboundAccountPositionEvent, err error) {
+//   do something
+//   when have error then set to err and return for stop next handler
+// }
+type OutboundAccountPositionHandler = func(*OutboundAccountPositionEvent, error)
+
+func (s *UserDataStream) callOutboundAccountPositionHandler(data *OutboundAccountPositionEvent) {
+	var err error
+	s.mu.Lock()
+	handlers := s.outboundAccountPositionHandler
+	s.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler(data, err)
+		if err != nil {
+			break
+		}
+	}
+}
+
+// BalanceUpdateHandler
+//
+// func(value *BalanceUpdateEvent, err error) {
+//   do something
+//   when have error then set to err and return for stop next handler
+// }
+type BalanceUpdateHandler = func(*BalanceUpdateEvent, error)
+
+func (s *UserDataStream) callBalanceUpdateHandler(data *BalanceUpdateEvent) {
+	var err error
+	s.mu.Lock()
+	handlers := s.balanceUpdateHandler
+	s.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler(data, err)
+		if err != nil {
+			break
+		}
+	}
+}
+
+// ExecutionReportHandler
+//
+// func(value *ExecutionReportEvent, err error) {
+//   do something
+//   when have error then set to err and return for stop next handler
+// }
+type ExecutionReportHandler = func(*ExecutionReportEvent, error)
+
+func (s *UserDataStream) callExecutionReportHandler(data *ExecutionReportEvent) {
+	var err error
+	s.mu.Lock()
+	handlers := s.executionReportHandler
+	s.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler(data, err)
+		if err != nil {
+			break
+		}
+	}
+}
+
+// ListStatusHandler
+//
+// func(value *ListStatusEvent, err error) {
+//   do something
+//   when have error then set to err and return for stop next handler
+// }
+type ListStatusHandler = func(*ListStatusEvent, error)
+
+func (s *UserDataStream) callListStatusHandler(data *ListStatusEvent) {
+	var err error
+	s.mu.Lock()
+	handlers := s.listStatusHandler
+	s.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler(data, err)
+		if err != nil {
+			break
+		}
+	}
+}
+
+// RawEventHandler
+//
+// func(eventType string, message []byte) {
+//   parse message of the event
+// }
+type RawEventHandler = func(string, []byte)
+
+func (s *UserDataStream) callRawEventHandler(eventType string, message []byte) {
+	s.mu.Lock()
+	handlers := s.rawEventHandler
+	s.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler(eventType, message)
+	}
+}
@@ -0,0 +1,333 @@
+package websocket
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"github.com/buger/jsonparser"
+	"time"
+)
+
+type UserDataEventType = string
+
+var (
+	OutboundAccountPositionEventType = UserDataEventType("outboundAccountPosition")
+	BalanceUpdateEventType           = UserDataEventType("balanceUpdate")
+	ExecutionReportEventType         = UserDataEventType("executionReport")
+	ListStatusEventType              = UserDataEventType("listStatus")
+	ListenKeyExpiredEventType        = UserDataEventType("listenKeyExpired")
+)
+
+// OutboundAccountPositionEvent
+//   - is sent any time an account balance has changed and contains the assets
+//     that were possibly changed by the event that generated the balance change.
+//   - https://binance-docs.github.io/apidocs/spot/en/#account-update
+type OutboundAccountPositionEvent struct {
+	EventType      string                            `json:"e"`
+	EventTime      time.Time                         `json:"E"`
+	LastUpdateTime time.Time                         `json:"u"`
+	Balances       []*OutboundAccountPositionBalance `json:"B"`
+}
+
+type OutboundAccountPositionBalance struct {
+	Asset  string        `json:"a"`
+	Free   model.Decimal `json:"f"`
+	Locked model.Decimal `json:"l"`
+}
+
+func parseOutboundAccountPositionEvent(b []byte, offset int64) (*OutboundAccountPositionEvent, error) {
+	result := new(OutboundAccountPositionEvent)
+	if v, err := jsonparser.GetString(b, "e"); err == nil {
+		result.EventType = v
+	} else {
+		return nil, err
+	}
+	if v, err := jsonparser.GetInt(b, "E"); err == nil {
+		result.EventTime = lib.ConvertIntToTime(v, offset)
+	} else {
+		return nil, err
+	}
+	if v, err := jsonparser.GetInt(b, "u"); err == nil {
+		result.LastUpdateTime = lib.ConvertIntToTime(v, offset)
+	} else {
+		return nil, err
+	}
+	result.Balances = make([]*OutboundAccountPositionBalance, 0)
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		balance := new(OutboundAccountPositionBalance)
+		if v, _err := jsonparser.GetString(value, "a"); _err == nil {
+			balance.Asset = v
+		} else {
+			return
+		}
+		if v, _err := model.GetDecimal(value, "f"); _err == nil {
+			balance.Free = v
+		} else {
+			return
+		}
+		if v, _err := model.GetDecimal(value, "l"); _err == nil {
+			balance.Locked = v
+		} else {
+			return
+		}
+		result.Balances = append(result.Balances, balance)
+	}, "B")
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BalanceUpdateEvent
+//   - occurs during deposits or withdrawals from the account and transfer of funds between accounts.
+//   - https://binance-docs.github.io/apidocs/spot/en/#balance-update
+type BalanceUpdateEvent struct {
+	EventType    string        `json:"e"`
+	EventTime    time.Time     `json:"E"`
+	Asset        string        `json:"a"`
+	BalanceDelta model.Decimal `json:"d"`
+	ClearTime    time.Time     `json:"T"`
+}
+
+func parseBalanceUpdateEvent(b []byte, offset int64) (*BalanceUpdateEvent, error) {
+	result := new(BalanceUpdateEvent)
+	if v, err := jsonparser.GetString(b, "e"); err == nil {
+		result.EventType = v
+	} else {
+		return nil, err
+	}
+	if v, err := jsonparser.GetInt(b, "E"); err == nil {
+		result.EventTime = lib.ConvertIntToTime(v, offset)
+	} else {
+		return nil, err
+	}
+	if v, err := jsonparser.GetString(b, "a"); err == nil {
+		result.Asset = v
+	} else {
+		return nil, err
+	}
+	if v, err := model.GetDecimal(b, "d"); err == nil {
+		result.BalanceDelta = v
+	} else {
+		return nil, err
+	}
+	if v, err := jsonparser.GetInt(b, "T"); err == nil {
+		result.ClearTime = lib.ConvertIntToTime(v, offset)
+	} else {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ExecutionReportEvent
+//   - orders are updated with the executionReport event.
+//   - https://binance-docs.github.io/apidocs/spot/en/#order-update
+type ExecutionReportEvent struct {
+	EventType                string        `json:"e"`
+	EventTime                time.Time     `json:"E"`
+	Symbol                   string        `json:"s"`
+	ClientOrderId            string        `json:"c"`
+	Side                     string        `json:"S"`
+	OrderType                string        `json:"o"`
+	TimeInForce              string        `json:"f"`
+	Quantity                 model.Decimal `json:"q"`
+	Price                    model.Decimal `json:"p"`
+	StopPrice                model.Decimal `json:"P"`
+	IcebergQuantity          model.Decimal `json:"F"`
+	OrderListId              int64         `json:"g"`
+	OrigClientOrderId        string        `json:"C"`
+	ExecutionType            string        `json:"x"`
+	OrderStatus              string        `json:"X"`
+	RejectReason             string        `json:"r"`
+	OrderId                  int64         `json:"i"`
+	LastExecutedQuantity     model.Decimal `json:"l"`
+	CumulativeFilledQuantity model.Decimal `json:"z"`
+	LastExecutedPrice        model.Decimal `json:"L"`
+	CommissionAmount         model.Decimal `json:"n"`
+	CommissionAsset          string        `json:"N"`
+	TransactionTime          time.Time     `json:"T"`
+	TradeId                  int64         `json:"t"`
+	IsOnBook                 bool          `json:"w"`
+	IsMaker                  bool          `json:"m"`
+	OrderCreationTime        time.Time     `json:"O"`
+	CumulativeQuoteQuantity  model.Decimal `json:"Z"`
+	LastQuoteQuantity        model.Decimal `json:"Y"`
+	QuoteOrderQuantity       model.Decimal `json:"Q"`
+}
+
+func parseExecutionReportEvent(b []byte, offset int64) (*ExecutionReportEvent, error) {
+	result := new(ExecutionReportEvent)
+	if v, err := jsonparser.GetString(b, "e"); err == nil {
+		result.EventType = v
+	} else {
+		return nil, err
+	}
+	if v, err := jsonparser.GetInt(b, "E"); err == nil {
+		result.EventTime = lib.ConvertIntToTime(v, offset)
+	} else {
+		return nil, err
+	}
+	if v, err := jsonparser.GetString(b, "s"); err == nil {
+		result.Symbol = v
+	}
+	if v, err := jsonparser.GetString(b, "c"); err == nil {
+		result.ClientOrderId = v
+	}
+	if v, err := jsonparser.GetString(b, "S"); err == nil {
+		result.Side = v
+	}
+	if v, err := jsonparser.GetString(b, "o"); err == nil {
+		result.OrderType = v
+	}
+	if v, err := jsonparser.GetString(b, "f"); err == nil {
+		result.TimeInForce = v
+	}
+	if v, err := model.GetDecimal(b, "q"); err == nil {
+		result.Quantity = v
+	}
+	if v, err := model.GetDecimal(b, "p"); err == nil {
+		result.Price = v
+	}
+	if v, err := model.GetDecimal(b, "P"); err == nil {
+		result.StopPrice = v
+	}
+	if v, err := model.GetDecimal(b, "F"); err == nil {
+		result.IcebergQuantity = v
+	}
+	if v, err := jsonparser.GetInt(b, "g"); err == nil {
+		result.OrderListId = v
+	}
+	if v, err := jsonparser.GetString(b, "C"); err == nil {
+		result.OrigClientOrderId = v
+	}
+	if v, err := jsonparser.GetString(b, "x"); err == nil {
+		result.ExecutionType = v
+	}
+	if v, err := jsonparser.GetString(b, "X"); err == nil {
+		result.OrderStatus = v
+	}
+	if v, err := jsonparser.GetString(b, "r"); err == nil {
+		result.RejectReason = v
+	}
+	if v, err := jsonparser.GetInt(b, "i"); err == nil {
+		result.OrderId = v
+	}
+	if v, err := model.GetDecimal(b, "l"); err == nil {
+		result.LastExecutedQuantity = v
+	}
+	if v, err := model.GetDecimal(b, "z"); err == nil {
+		result.CumulativeFilledQuantity = v
+	}
+	if v, err := model.GetDecimal(b, "L"); err == nil {
+		result.LastExecutedPrice = v
+	}
+	if v, err := model.GetDecimal(b, "n"); err == nil {
+		result.CommissionAmount = v
+	}
+	if v, err := jsonparser.GetString(b, "N"); err == nil {
+		result.CommissionAsset = v
+	}
+	if v, err := jsonparser.GetInt(b, "T"); err == nil {
+		result.TransactionTime = lib.ConvertIntToTime(v, offset)
+	}
+	if v, err := jsonparser.GetInt(b, "t"); err == nil {
+		result.TradeId = v
+	}
+	if v, err := jsonparser.GetBoolean(b, "w"); err == nil {
+		result.IsOnBook = v
+	}
+	if v, err := jsonparser.GetBoolean(b, "m"); err == nil {
+		result.IsMaker = v
+	}
+	if v, err := jsonparser.GetInt(b, "O"); err == nil {
+		result.OrderCreationTime = lib.ConvertIntToTime(v, offset)
+	}
+	if v, err := model.GetDecimal(b, "Z"); err == nil {
+		result.CumulativeQuoteQuantity = v
+	}
+	if v, err := model.GetDecimal(b, "Y"); err == nil {
+		result.LastQuoteQuantity = v
+	}
+	if v, err := model.GetDecimal(b, "Q"); err == nil {
+		result.QuoteOrderQuantity = v
+	}
+	return result, nil
+}
+
+// ListStatusEvent
+//   - if the order is an OCO, an event will be displayed named ListStatus in addition to the executionReport event.
+//   - https://binance-docs.github.io/apidocs/spot/en/#order-update
+type ListStatusEvent struct {
+	EventType         string             `json:"e"`
+	EventTime         time.Time          `json:"E"`
+	Symbol            string             `json:"s"`
+	OrderListId       int64              `json:"g"`
+	ContingencyType   string             `json:"c"`
+	ListStatusType    string             `json:"l"`
+	ListOrderStatus   string             `json:"L"`
+	ListRejectReason  string             `json:"r"`
+	ListClientOrderId string             `json:"C"`
+	TransactionTime   time.Time          `json:"T"`
+	Orders            []*ListStatusOrder `json:"O"`
+}
+
+type ListStatusOrder struct {
+	Symbol        string `json:"s"`
+	OrderId       int64  `json:"i"`
+	ClientOrderId string `json:"c"`
+}
+
+func parseListStatusEvent(b []byte, offset int64) (*ListStatusEvent, error) {
+	result := new(ListStatusEvent)
+	if v, err := jsonparser.GetString(b, "e"); err == nil {
+		result.EventType = v
+	} else {
+		return nil, err
+	}
+	if v, err := jsonparser.GetInt(b, "E"); err == nil {
+		result.EventTime = lib.ConvertIntToTime(v, offset)
+	} else {
+		return nil, err
+	}
+	if v, err := jsonparser.GetString(b, "s"); err == nil {
+		result.Symbol = v
+	}
+	if v, err := jsonparser.GetInt(b, "g"); err == nil {
+		result.OrderListId = v
+	}
+	if v, err := jsonparser.GetString(b, "c"); err == nil {
+		result.ContingencyType = v
+	}
+	if v, err := jsonparser.GetString(b, "l"); err == nil {
+		result.ListStatusType = v
+	}
+	if v, err := jsonparser.GetString(b, "L"); err == nil {
+		result.ListOrderStatus = v
+	}
+	if v, err := jsonparser.GetString(b, "r"); err == nil {
+		result.ListRejectReason = v
+	}
+	if v, err := jsonparser.GetString(b, "C"); err == nil {
+		result.ListClientOrderId = v
+	}
+	if v, err := jsonparser.GetInt(b, "T"); err == nil {
+		result.TransactionTime = lib.ConvertIntToTime(v, offset)
+	}
+	result.Orders = make([]*ListStatusOrder, 0)
+	_, err := jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, _err error) {
+		order := new(ListStatusOrder)
+		if v, _err := jsonparser.GetString(value, "s"); _err == nil {
+			order.Symbol = v
+		}
+		if v, _err := jsonparser.GetInt(value, "i"); _err == nil {
+			order.OrderId = v
+		}
+		if v, _err := jsonparser.GetString(value, "c"); _err == nil {
+			order.ClientOrderId = v
+		}
+		result.Orders = append(result.Orders, order)
+	}, "O")
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
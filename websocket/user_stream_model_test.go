@@ -0,0 +1,117 @@
+package websocket
+
+import (
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"testing"
+)
+
+func TestParseOutboundAccountPositionEvent(t *testing.T) {
+	b := []byte(`{"e":"outboundAccountPosition","E":1564034571105,"u":1564034571073,"B":[{"a":"ETH","f":"10000.000000","l":"0.000000"},{"a":"BTC","f":"0.50000000","l":"0.10000000"}]}`)
+
+	event, err := parseOutboundAccountPositionEvent(b, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.EventType != OutboundAccountPositionEventType || event.EventTime.UnixMilli() != 1564034571105 || event.LastUpdateTime.UnixMilli() != 1564034571073 {
+		t.Errorf("event = %+v", event)
+	}
+	if len(event.Balances) != 2 {
+		t.Fatalf("balances = %d", len(event.Balances))
+	}
+	if v := event.Balances[0]; v.Asset != "ETH" || !v.Free.Equal(model.MustDecimal("10000")) || !v.Locked.IsZero() {
+		t.Errorf("balance = %+v", v)
+	}
+	if v := event.Balances[1]; v.Asset != "BTC" || !v.Free.Equal(model.MustDecimal("0.5")) || !v.Locked.Equal(model.MustDecimal("0.1")) {
+		t.Errorf("balance = %+v", v)
+	}
+
+	if _, err = parseOutboundAccountPositionEvent([]byte(`{"e":"outboundAccountPosition"}`), 0); err == nil {
+		t.Error("event time is required")
+	}
+}
+
+func TestParseBalanceUpdateEvent(t *testing.T) {
+	b := []byte(`{"e":"balanceUpdate","E":1573200697110,"a":"BTC","d":"100.00000000","T":1573200697068}`)
+
+	event, err := parseBalanceUpdateEvent(b, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.EventType != BalanceUpdateEventType || event.EventTime.UnixMilli() != 1573200697110 || event.ClearTime.UnixMilli() != 1573200697068 {
+		t.Errorf("event = %+v", event)
+	}
+	if event.Asset != "BTC" || !event.BalanceDelta.Equal(model.MustDecimal("100")) {
+		t.Errorf("event = %+v", event)
+	}
+
+	if _, err = parseBalanceUpdateEvent([]byte(`{"e":"balanceUpdate","E":1573200697110,"a":"BTC","d":"x","T":1573200697068}`), 0); err == nil {
+		t.Error("invalid delta should be error")
+	}
+
+	// local time is 1 second ahead of server time
+	event, err = parseBalanceUpdateEvent(b, 1000)
+	if err != nil || event.EventTime.UnixMilli() != 1573200698110 || event.ClearTime.UnixMilli() != 1573200698068 {
+		t.Errorf("event = %+v, err = %v", event, err)
+	}
+}
+
+func TestParseExecutionReportEvent(t *testing.T) {
+	b := []byte(`{"e":"executionReport","E":1499405658658,"s":"ETHBTC","c":"mUvoqJxFIILMdfAW5iGSOW","S":"BUY","o":"LIMIT","f":"GTC","q":"1.00000000","p":"0.10264410","P":"0.00000000","F":"0.00000000","g":-1,"C":"","x":"TRADE","X":"PARTIALLY_FILLED","r":"NONE","i":4293153,"l":"0.40000000","z":"0.40000000","L":"0.10264410","n":"0.00004000","N":"BNB","T":1499405658657,"t":12345,"I":8641984,"w":true,"m":true,"M":false,"O":1499405658650,"Z":"0.04105764","Y":"0.04105764","Q":"0.00000000"}`)
+
+	event, err := parseExecutionReportEvent(b, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.EventType != ExecutionReportEventType || event.EventTime.UnixMilli() != 1499405658658 {
+		t.Errorf("event = %+v", event)
+	}
+	if event.Symbol != "ETHBTC" || event.ClientOrderId != "mUvoqJxFIILMdfAW5iGSOW" || event.Side != "BUY" || event.OrderType != "LIMIT" || event.TimeInForce != "GTC" {
+		t.Errorf("event = %+v", event)
+	}
+	if !event.Quantity.Equal(model.MustDecimal("1")) || !event.Price.Equal(model.MustDecimal("0.1026441")) || !event.StopPrice.IsZero() || !event.IcebergQuantity.IsZero() {
+		t.Errorf("event = %+v", event)
+	}
+	if event.OrderListId != -1 || event.OrigClientOrderId != "" || event.ExecutionType != "TRADE" || event.OrderStatus != "PARTIALLY_FILLED" || event.RejectReason != "NONE" || event.OrderId != 4293153 {
+		t.Errorf("event = %+v", event)
+	}
+	if !event.LastExecutedQuantity.Equal(model.MustDecimal("0.4")) || !event.CumulativeFilledQuantity.Equal(model.MustDecimal("0.4")) || !event.LastExecutedPrice.Equal(model.MustDecimal("0.1026441")) {
+		t.Errorf("event = %+v", event)
+	}
+	if !event.CommissionAmount.Equal(model.MustDecimal("0.00004")) || event.CommissionAsset != "BNB" || event.TransactionTime.UnixMilli() != 1499405658657 || event.TradeId != 12345 {
+		t.Errorf("event = %+v", event)
+	}
+	if !event.IsOnBook || !event.IsMaker || event.OrderCreationTime.UnixMilli() != 1499405658650 {
+		t.Errorf("event = %+v", event)
+	}
+	if !event.CumulativeQuoteQuantity.Equal(model.MustDecimal("0.04105764")) || !event.LastQuoteQuantity.Equal(model.MustDecimal("0.04105764")) || !event.QuoteOrderQuantity.IsZero() {
+		t.Errorf("event = %+v", event)
+	}
+
+	// commission asset is null of a new order
+	event, err = parseExecutionReportEvent([]byte(`{"e":"executionReport","E":1499405658658,"s":"ETHBTC","x":"NEW","X":"NEW","n":"0","N":null}`), 0)
+	if err != nil || event.CommissionAsset != "" || !event.CommissionAmount.IsZero() || event.OrderStatus != "NEW" {
+		t.Errorf("event = %+v, err = %v", event, err)
+	}
+}
+
+func TestParseListStatusEvent(t *testing.T) {
+	b := []byte(`{"e":"listStatus","E":1564035303637,"s":"ETHBTC","g":2,"c":"OCO","l":"EXEC_STARTED","L":"EXECUTING","r":"NONE","C":"F4QN4G8DlFATFlIUQ0cjdD","T":1564035303625,"O":[{"s":"ETHBTC","i":17,"c":"AJYsMjErWJesZvqlJCTUgL"},{"s":"ETHBTC","i":18,"c":"bfYPSQdLoqAJeNrOr9adzq"}]}`)
+
+	event, err := parseListStatusEvent(b, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.EventType != ListStatusEventType || event.EventTime.UnixMilli() != 1564035303637 || event.TransactionTime.UnixMilli() != 1564035303625 {
+		t.Errorf("event = %+v", event)
+	}
+	if event.Symbol != "ETHBTC" || event.OrderListId != 2 || event.ContingencyType != "OCO" || event.ListStatusType != "EXEC_STARTED" ||
+		event.ListOrderStatus != "EXECUTING" || event.ListRejectReason != "NONE" || event.ListClientOrderId != "F4QN4G8DlFATFlIUQ0cjdD" {
+		t.Errorf("event = %+v", event)
+	}
+	if len(event.Orders) != 2 {
+		t.Fatalf("orders = %d", len(event.Orders))
+	}
+	if v := event.Orders[1]; v.Symbol != "ETHBTC" || v.OrderId != 18 || v.ClientOrderId != "bfYPSQdLoqAJeNrOr9adzq" {
+		t.Errorf("order = %+v", v)
+	}
+}
@@ -0,0 +1,159 @@
+package websocket
+
+import (
+	"errors"
+	"github.com/gorilla/websocket"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testListenKeyService
+// listenKey is "key-<n>" of the n-th CreateListenKey
+type testListenKeyService struct {
+	mu           sync.Mutex
+	created      int
+	keepAliveErr error
+	keptAlive    []string
+	closed       []string
+}
+
+func (s *testListenKeyService) CreateListenKey() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.created++
+	return "key-" + strconv.Itoa(s.created), nil
+}
+
+func (s *testListenKeyService) KeepAliveListenKey(listenKey string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keptAlive = append(s.keptAlive, listenKey)
+	return s.keepAliveErr
+}
+
+func (s *testListenKeyService) CloseListenKey(listenKey string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = append(s.closed, listenKey)
+	return nil
+}
+
+// newTestUserDataStream
+// connected paths are sent to paths, messages of the path are sent on connect
+func newTestUserDataStream(t *testing.T, service ListenKeyService, messages map[string][]string, opts ...StreamOption) (*UserDataStream, chan string) {
+	upgrader := websocket.Upgrader{}
+	paths := make(chan string, 16)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		paths <- r.URL.Path
+		for _, message := range messages[r.URL.Path] {
+			_ = conn.WriteMessage(websocket.TextMessage, []byte(message))
+		}
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	uds, err := NewUserDataStream(service, append([]StreamOption{WithBaseUrl("ws" + strings.TrimPrefix(server.URL, "http"))}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(uds.Shutdown)
+	return uds, paths
+}
+
+func waitPath(t *testing.T, paths chan string, expected string) {
+	for {
+		select {
+		case path := <-paths:
+			if path == expected {
+				return
+			}
+		case <-time.After(3 * time.Second):
+			t.Fatalf("%s is not connected", expected)
+		}
+	}
+}
+
+func TestUserDataStream_ListenKeyExpired(t *testing.T) {
+	service := new(testListenKeyService)
+	uds, paths := newTestUserDataStream(t, service, map[string][]string{
+		"/key-1": {`{"e":"listenKeyExpired","E":1576653824250}`},
+		"/key-2": {`{"e":"balanceUpdate","E":1573200697110,"a":"BTC","d":"100.00000000","T":1573200697068}`},
+	})
+
+	received := make(chan *BalanceUpdateEvent, 1)
+	if err := uds.SubscribeBalanceUpdate(func(event *BalanceUpdateEvent, err error) {
+		received <- event
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	waitPath(t, paths, "/key-1")
+	// reconnected with the new listenKey
+	waitPath(t, paths, "/key-2")
+	if v := uds.ListenKey(); v != "key-2" {
+		t.Errorf("listenKey = %s", v)
+	}
+	select {
+	case event := <-received:
+		if event.Asset != "BTC" {
+			t.Errorf("event = %+v", event)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("balanceUpdate of new connection is not received")
+	}
+}
+
+func TestUserDataStream_KeepAlive(t *testing.T) {
+	service := new(testListenKeyService)
+	uds, paths := newTestUserDataStream(t, service, nil)
+	waitPath(t, paths, "/key-1")
+
+	uds.keepAliveListenKey()
+	if v := uds.ListenKey(); v != "key-1" {
+		t.Errorf("listenKey = %s", v)
+	}
+
+	// listenKey is renewed when keep alive failed
+	service.mu.Lock()
+	service.keepAliveErr = errors.New("listenKey does not exist")
+	service.mu.Unlock()
+	uds.keepAliveListenKey()
+	waitPath(t, paths, "/key-2")
+	if v := uds.ListenKey(); v != "key-2" {
+		t.Errorf("listenKey = %s", v)
+	}
+
+	uds.Shutdown()
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	if strings.Join(service.keptAlive, ",") != "key-1,key-1" || strings.Join(service.closed, ",") != "key-2" {
+		t.Errorf("kept alive = %v, closed = %v", service.keptAlive, service.closed)
+	}
+}
+
+func TestUserDataStream_SlowConsumerPolicy(t *testing.T) {
+	uds, paths := newTestUserDataStream(t, new(testListenKeyService), nil, WithSlowConsumerPolicy(SlowConsumerPolicyDropNewest))
+	waitPath(t, paths, "/key-1")
+
+	if policy := uds.dispatcher.shards[0].policy; policy != SlowConsumerPolicyBlock {
+		t.Errorf("policy = %s", policy)
+	}
+}
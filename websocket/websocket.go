@@ -4,6 +4,7 @@ package websocket
 // https://gist.github.com/navono/d3742c4b0f26f68f1a48d86cf4556726
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/NattapornTee22816/binance-connector-golang/lib"
@@ -36,6 +37,7 @@ type Websocket struct {
 	conn        *websocket.Conn
 	dialer      *websocket.Dialer
 	mu          sync.Mutex
+	writeMu     sync.Mutex // one writer at a time on conn
 	logger      *lib.BinanceLogger
 	isConnected bool
 	isDone      bool
@@ -47,7 +49,9 @@ type Websocket struct {
 func (ws *Websocket) WriteJSON(v interface{}) error {
 	err := ErrNotConnected
 	if ws.IsNotDone() && ws.IsConnected() {
+		ws.writeMu.Lock()
 		err = ws.conn.WriteJSON(v)
+		ws.writeMu.Unlock()
 		if err != nil {
 			if ws.logger.CanDebug() {
 				ws.logger.Info("write message error, try reconnect")
@@ -65,7 +69,9 @@ func (ws *Websocket) WriteJSON(v interface{}) error {
 func (ws *Websocket) WriteMessage(messageType int, data []byte) error {
 	err := ErrNotConnected
 	if ws.IsNotDone() && ws.IsConnected() {
+		ws.writeMu.Lock()
 		err = ws.conn.WriteMessage(messageType, data)
+		ws.writeMu.Unlock()
 		if err != nil {
 			if ws.logger.CanDebug() {
 				ws.logger.Info(fmt.Sprintf("websocket[%d]: write message error, try reconnect", ws.id))
@@ -118,6 +124,12 @@ func (ws *Websocket) Dial(urlStr string) error {
 }
 
 func (ws *Websocket) Connect() {
+	_ = ws.connect(context.Background())
+}
+
+// connect
+// dial with backoff until it is connected, ctx.Err() when ctx is done first
+func (ws *Websocket) connect(ctx context.Context) error {
 	b := &backoff.Backoff{
 		Min:    ws.reconnectIntervalMin,
 		Max:    ws.reconnectIntervalMax,
@@ -131,7 +143,7 @@ func (ws *Websocket) Connect() {
 	for {
 		nextInterval := b.Duration()
 
-		wsConn, _, err := ws.dialer.Dial(ws.url, nil)
+		wsConn, _, err := ws.dialer.DialContext(ctx, ws.url, nil)
 
 		ws.mu.Lock()
 		ws.conn = wsConn
@@ -145,12 +157,18 @@ func (ws *Websocket) Connect() {
 				ws.OnConnect(ws)
 			}
 			ws.wg.Add(1)
-			return
+			return nil
 		} else {
 			ws.logger.Error(fmt.Sprintf("websocket[%d] can't connect to %s, will try again in %v", ws.id, ws.url, nextInterval))
 		}
 
-		time.Sleep(nextInterval)
+		timer := time.NewTimer(nextInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
 	}
 }
 
@@ -162,17 +180,20 @@ func (ws *Websocket) SetPingHandler() {
 
 	ws.wg.Add(1)
 	for {
-		if ws.isDone {
+		if !ws.IsNotDone() {
 			ws.logger.Info(fmt.Sprintf("websocket[%d] stop ping handler", ws.id))
 			ws.wg.Done()
 			return
 		}
 		select {
 		case <-ticker.C:
+			ws.writeMu.Lock()
 			_ = ws.conn.SetWriteDeadline(time.Now().Add(time.Second))
 			if err := ws.conn.WriteMessage(websocket.PingMessage, []byte{}); err != nil {
 				ws.logger.Error(err.Error())
 			}
+			_ = ws.conn.SetWriteDeadline(time.Time{})
+			ws.writeMu.Unlock()
 		default:
 			time.Sleep(time.Second * 5)
 		}
@@ -180,9 +201,17 @@ func (ws *Websocket) SetPingHandler() {
 }
 
 func (ws *Websocket) SetPongHandler() {
-	_ = ws.conn.SetReadDeadline(time.Now().Add(ws.PongDuration))
-	ws.conn.SetPongHandler(func(appData string) error {
-		err := ws.conn.SetReadDeadline(time.Now().Add(ws.PongDuration))
+	ws.mu.Lock()
+	conn := ws.conn
+	ws.mu.Unlock()
+	// shutdown before the reader is started
+	if conn == nil {
+		return
+	}
+
+	_ = conn.SetReadDeadline(time.Now().Add(ws.PongDuration))
+	conn.SetPongHandler(func(appData string) error {
+		err := conn.SetReadDeadline(time.Now().Add(ws.PongDuration))
 		if err != nil {
 			return err
 		}
@@ -211,8 +240,26 @@ func (ws *Websocket) closeAndReconnect() {
 	}
 }
 
+// redial
+// change url and close current connection, the reader will reconnect with new url
+func (ws *Websocket) redial(urlStr string) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	ws.url = urlStr
+	if ws.conn != nil {
+		_ = ws.conn.Close()
+	}
+}
+
 func (ws *Websocket) Shutdown() {
+	ws.mu.Lock()
+	if ws.isDone {
+		ws.mu.Unlock()
+		return
+	}
 	ws.isDone = true
+	ws.mu.Unlock()
 	ws.logger.Info(fmt.Sprintf("websocket[%d] is shutting down...", ws.id))
 	ws.Close()
 
@@ -0,0 +1,508 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"github.com/buger/jsonparser"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrSignerRequired  = errors.New("signer is required for signed request")
+	ErrConnectionReset = errors.New("websocket reconnected before the response was received")
+)
+
+// WsApi
+// websocket API, requests are sent over one persistent connection and correlated with responses by id.
+// Params are the models of REST API, e.g. *model.OrderParam.
+// https://binance-docs.github.io/apidocs/websocket_api/en/
+type WsApi struct {
+	ws     *Websocket
+	apiKey string
+	signer lib.Signer
+	clock  *lib.Clock
+	parser *model.Parser
+	// default to 10 seconds
+	requestTimeout time.Duration
+	requestId      uint64
+	mu             sync.Mutex
+	pending        map[string]chan *WsApiResponse
+	rateLimits     []*WsApiRateLimit
+}
+
+// NewWsApi
+//   - signer is used by signed (TRADE and USER_DATA) requests, e.g. lib.NewHmacSigner(secret) or lib.NewEd25519Signer(key)
+//   - signer can be nil when only public requests are sent
+func NewWsApi(apiKey string, signer lib.Signer, opts ...StreamOption) (*WsApi, error) {
+	return newWsApi(apiKey, signer, newStreamConfig("wss://ws-api.binance.com:443/ws-api/v3", opts).baseUrl)
+}
+
+func NewTestnetWsApi(apiKey string, signer lib.Signer, opts ...StreamOption) (*WsApi, error) {
+	return newWsApi(apiKey, signer, newStreamConfig("wss://testnet.binance.vision/ws-api/v3", opts).baseUrl)
+}
+
+func newWsApi(apiKey string, signer lib.Signer, baseUrl string) (*WsApi, error) {
+	api := &WsApi{
+		apiKey:         apiKey,
+		signer:         signer,
+		parser:         model.NewParser(),
+		requestTimeout: 10 * time.Second,
+		pending:        make(map[string]chan *WsApiResponse),
+	}
+	api.ws = &Websocket{
+		id:           lib.RandomInt(),
+		url:          baseUrl,
+		PingDuration: 2 * time.Minute,
+		PongDuration: 5 * time.Minute,
+		mu:           sync.Mutex{},
+		logger:       lib.NewLogger("ws-binance-api", lib.LogLevelDebug),
+		wg:           sync.WaitGroup{},
+		OnConnect:    api.onWebsocketConnect,
+	}
+
+	api.ws.Connect()
+	go api.readMessage()
+
+	return api, nil
+}
+
+// SetClock
+// timestamp of signed requests, e.g. API.Clock() of spot. By default, local time
+func (a *WsApi) SetClock(clock *lib.Clock) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.clock = clock
+}
+
+// SetRequestTimeout
+// timeout of the requests whose context has no deadline
+func (a *WsApi) SetRequestTimeout(timeout time.Duration) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.requestTimeout = timeout
+}
+
+// RateLimits
+// rate limits of the latest response
+func (a *WsApi) RateLimits() []*WsApiRateLimit {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.rateLimits
+}
+
+func (a *WsApi) Shutdown() {
+	a.ws.Shutdown()
+	a.failPending()
+}
+
+// onWebsocketConnect
+// responses of the previous connection are never received
+func (a *WsApi) onWebsocketConnect(_ *Websocket) {
+	a.failPending()
+}
+
+func (a *WsApi) failPending() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for id, ch := range a.pending {
+		close(ch)
+		delete(a.pending, id)
+	}
+}
+
+// Request
+//   - send method with params and wait for the response of the same id
+//   - params is a pointer to param model of REST API, or nil
+//   - apiKey is added by USER_STREAM and MARKET_DATA, apiKey, timestamp and signature by TRADE, MARGIN and USER_DATA
+//   - failed request is returned as *WsApiError with the response, e.g. errors.Is(err, spot.ErrUnknownOrder)
+func (a *WsApi) Request(ctx context.Context, method string, params interface{}, securityType model.EndpointSecurityType) (*WsApiResponse, error) {
+	a.mu.Lock()
+	timeout := a.requestTimeout
+	a.mu.Unlock()
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	request, err := a.newRequest(method, params, securityType)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *WsApiResponse, 1)
+	a.mu.Lock()
+	a.pending[request.Id] = ch
+	a.mu.Unlock()
+	defer func() {
+		a.mu.Lock()
+		delete(a.pending, request.Id)
+		a.mu.Unlock()
+	}()
+
+	if a.ws.logger.CanDebug() {
+		a.ws.logger.Debug(fmt.Sprintf("websocket[%d] request %s %s", a.ws.id, request.Id, method))
+	}
+	if err = a.ws.WriteJSON(request); err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case response, ok := <-ch:
+		if !ok {
+			return nil, ErrConnectionReset
+		}
+		if response.Error != nil {
+			return response, &WsApiError{
+				Status:  response.Status,
+				Code:    response.Error.Code,
+				Message: response.Error.Msg,
+			}
+		}
+		return response, nil
+	}
+}
+
+func (a *WsApi) newRequest(method string, payload interface{}, securityType model.EndpointSecurityType) (*WsApiRequest, error) {
+	a.mu.Lock()
+	a.requestId = a.requestId + 1
+	id := strconv.FormatUint(a.requestId, 10)
+	clock := a.clock
+	a.mu.Unlock()
+
+	params := make(map[string]interface{})
+	if payload != nil && (reflect.ValueOf(payload).Kind() == reflect.Ptr && !reflect.ValueOf(payload).IsNil()) {
+		validate := model.NewValidator()
+		if err := validate.Struct(payload); err != nil {
+			return nil, err
+		}
+		appendWsApiParams(params, reflect.ValueOf(payload).Elem(), clock)
+	}
+
+	switch securityType {
+	case model.EndpointSecurityTypeUserStream, model.EndpointSecurityTypeMarketData:
+		params["apiKey"] = a.apiKey
+	case model.EndpointSecurityTypeTrade, model.EndpointSecurityTypeMargin, model.EndpointSecurityTypeUserData:
+		if a.signer == nil {
+			return nil, ErrSignerRequired
+		}
+		params["apiKey"] = a.apiKey
+		if clock != nil {
+			params["timestamp"] = clock.Timestamp()
+		} else {
+			params["timestamp"] = time.Now().UnixMilli()
+		}
+		signature, err := a.signer.Sign(wsApiSignaturePayload(params))
+		if err != nil {
+			return nil, err
+		}
+		params["signature"] = signature
+	}
+
+	request := &WsApiRequest{
+		Id:     id,
+		Method: method,
+	}
+	if len(params) > 0 {
+		request.Params = params
+	}
+	return request, nil
+}
+
+// appendWsApiParams
+// same `param` tags as REST API, numbers and booleans are kept as json values
+func appendWsApiParams(out map[string]interface{}, iVal reflect.Value, clock *lib.Clock) {
+	typ := iVal.Type()
+	for i := 0; i < iVal.NumField(); i++ {
+		f := iVal.Field(i)
+		if f.IsZero() {
+			continue
+		}
+
+		field := typ.Field(i)
+		if field.Anonymous && f.Kind() == reflect.Struct {
+			appendWsApiParams(out, f, clock)
+			continue
+		}
+		keyName, ok := field.Tag.Lookup("param")
+		if !ok {
+			continue
+		}
+		keyName, option, _ := strings.Cut(keyName, ",")
+		switch v := f.Interface().(type) {
+		case string:
+			out[keyName] = v
+		case int, int8, int16, int32, int64:
+			out[keyName] = f.Int()
+		case float32, float64:
+			out[keyName] = strconv.FormatFloat(f.Float(), 'f', -1, 64)
+		case bool:
+			out[keyName] = v
+		case []string:
+			if option == "comma" {
+				out[keyName] = strings.Join(v, ",")
+			} else if option == "json" {
+				if b, err := json.Marshal(v); err == nil {
+					out[keyName] = string(b)
+				}
+			} else {
+				out[keyName] = v
+			}
+		case model.Decimal:
+			if !v.IsZero() {
+				out[keyName] = v.Trim().String()
+			}
+		case time.Time:
+			if clock != nil {
+				out[keyName] = clock.ConvertTimeToInt(v)
+			} else {
+				out[keyName] = v.UnixMilli()
+			}
+		}
+	}
+}
+
+// wsApiSignaturePayload
+// params sorted by key, e.g. apiKey=...&quantity=0.01&symbol=BTCUSDT&timestamp=...
+// https://binance-docs.github.io/apidocs/websocket_api/en/#signed-request-example-hmac
+func wsApiSignaturePayload(params map[string]interface{}) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		var v string
+		switch value := params[key].(type) {
+		case string:
+			v = value
+		case int64:
+			v = strconv.FormatInt(value, 10)
+		case bool:
+			v = strconv.FormatBool(value)
+		case []string:
+			if b, err := json.Marshal(value); err == nil {
+				v = string(b)
+			}
+		}
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, v))
+	}
+	return strings.Join(pairs, "&")
+}
+
+func (a *WsApi) readMessage() {
+	a.ws.SetPongHandler()
+	a.ws.wg.Add(1)
+
+	for {
+		if !a.ws.IsNotDone() {
+			a.ws.logger.Info(fmt.Sprintf("websocket[%d] stop read message", a.ws.id))
+			a.ws.wg.Done()
+			return
+		}
+		if a.ws.IsConnected() {
+			_, message, err := a.ws.ReadMessage()
+			if err == nil {
+				a.messageHandler(message)
+			}
+		}
+	}
+}
+
+func (a *WsApi) messageHandler(message []byte) {
+	response, err := parseWsApiResponse(message)
+	if err != nil {
+		if a.ws.logger.CanTrace() {
+			a.ws.logger.Debug(fmt.Sprintf("websocket[%d] unknown message %s", a.ws.id, string(message)))
+		}
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if len(response.RateLimits) > 0 {
+		a.rateLimits = response.RateLimits
+	}
+	if ch, ok := a.pending[response.Id]; ok {
+		// buffered, the request is removed after the first response
+		ch <- response
+		delete(a.pending, response.Id)
+	}
+}
+
+// Ping
+// Test connectivity to the WebSocket API.
+// https://binance-docs.github.io/apidocs/websocket_api/en/#test-connectivity
+func (a *WsApi) Ping() error {
+	return a.PingContext(context.Background())
+}
+
+// PingContext
+// Ping with context of the request
+func (a *WsApi) PingContext(ctx context.Context) error {
+	_, err := a.Request(ctx, "ping", nil, model.EndpointSecurityTypeNone)
+	return err
+}
+
+// CheckServerTime
+// Test connectivity to the WebSocket API and get the current server time.
+// https://binance-docs.github.io/apidocs/websocket_api/en/#check-server-time
+func (a *WsApi) CheckServerTime() (int64, error) {
+	return a.CheckServerTimeContext(context.Background())
+}
+
+// CheckServerTimeContext
+// CheckServerTime with context of the request
+func (a *WsApi) CheckServerTimeContext(ctx context.Context) (int64, error) {
+	response, err := a.Request(ctx, "time", nil, model.EndpointSecurityTypeNone)
+	if err != nil {
+		return 0, err
+	}
+
+	return jsonparser.GetInt(response.Result, "serverTime")
+}
+
+// OrderBook
+// Get current order book.
+// https://binance-docs.github.io/apidocs/websocket_api/en/#order-book
+func (a *WsApi) OrderBook(param *model.OrderBookParam) (*model.OrderBook, error) {
+	return a.OrderBookContext(context.Background(), param)
+}
+
+// OrderBookContext
+// OrderBook with context of the request
+func (a *WsApi) OrderBookContext(ctx context.Context, param *model.OrderBookParam) (*model.OrderBook, error) {
+	response, err := a.Request(ctx, "depth", param, model.EndpointSecurityTypeNone)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.parser.ParseOrderBook(response.Result)
+}
+
+// NewOrderTest
+// Test order placement (TRADE)
+// Validates new order parameters and verifies your signature but does not send the order into the matching engine.
+// https://binance-docs.github.io/apidocs/websocket_api/en/#test-new-order-trade
+func (a *WsApi) NewOrderTest(param *model.OrderParam) error {
+	return a.NewOrderTestContext(context.Background(), param)
+}
+
+// NewOrderTestContext
+// NewOrderTest with context of the request
+func (a *WsApi) NewOrderTestContext(ctx context.Context, param *model.OrderParam) error {
+	_, err := a.Request(ctx, "order.test", param, model.EndpointSecurityTypeTrade)
+	return err
+}
+
+// NewOrder
+// Place new order (TRADE)
+// https://binance-docs.github.io/apidocs/websocket_api/en/#place-new-order-trade
+func (a *WsApi) NewOrder(param *model.OrderParam) (*model.Order, error) {
+	return a.NewOrderContext(context.Background(), param)
+}
+
+// NewOrderContext
+// NewOrder with context of the request
+func (a *WsApi) NewOrderContext(ctx context.Context, param *model.OrderParam) (*model.Order, error) {
+	response, err := a.Request(ctx, "order.place", param, model.EndpointSecurityTypeTrade)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.parser.ParseOrder(response.Result)
+}
+
+// GetOrder
+// Query order (USER_DATA)
+// Check execution status of an order.
+// https://binance-docs.github.io/apidocs/websocket_api/en/#query-order-user_data
+func (a *WsApi) GetOrder(param *model.GetOrderParam) (*model.GetOrder, error) {
+	return a.GetOrderContext(context.Background(), param)
+}
+
+// GetOrderContext
+// GetOrder with context of the request
+func (a *WsApi) GetOrderContext(ctx context.Context, param *model.GetOrderParam) (*model.GetOrder, error) {
+	response, err := a.Request(ctx, "order.status", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.parser.ParseGetOrder(response.Result)
+}
+
+// CancelOrder
+// Cancel order (TRADE)
+// Cancel an active order.
+// https://binance-docs.github.io/apidocs/websocket_api/en/#cancel-order-trade
+func (a *WsApi) CancelOrder(param *model.CancelOrderParam) (*model.CancelOrder, error) {
+	return a.CancelOrderContext(context.Background(), param)
+}
+
+// CancelOrderContext
+// CancelOrder with context of the request
+func (a *WsApi) CancelOrderContext(ctx context.Context, param *model.CancelOrderParam) (*model.CancelOrder, error) {
+	response, err := a.Request(ctx, "order.cancel", param, model.EndpointSecurityTypeTrade)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.parser.ParseCancelOrder(response.Result)
+}
+
+// GetOpenOrders
+// Current open orders (USER_DATA)
+// Query execution status of all open orders.
+// https://binance-docs.github.io/apidocs/websocket_api/en/#current-open-orders-user_data
+func (a *WsApi) GetOpenOrders(param *model.GetOpenOrdersParam) ([]*model.GetOrder, error) {
+	return a.GetOpenOrdersContext(context.Background(), param)
+}
+
+// GetOpenOrdersContext
+// GetOpenOrders with context of the request
+func (a *WsApi) GetOpenOrdersContext(ctx context.Context, param *model.GetOpenOrdersParam) ([]*model.GetOrder, error) {
+	response, err := a.Request(ctx, "openOrders.status", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.parser.ParseGetOpenOrder(response.Result)
+}
+
+// Account
+// Account information (USER_DATA)
+// Query information about your account.
+// https://binance-docs.github.io/apidocs/websocket_api/en/#account-information-user_data
+func (a *WsApi) Account(param *model.AccountParam) (*model.Account, error) {
+	return a.AccountContext(context.Background(), param)
+}
+
+// AccountContext
+// Account with context of the request
+func (a *WsApi) AccountContext(ctx context.Context, param *model.AccountParam) (*model.Account, error) {
+	response, err := a.Request(ctx, "account.status", param, model.EndpointSecurityTypeUserData)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.parser.ParseAccount(response.Result)
+}
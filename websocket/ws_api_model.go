@@ -0,0 +1,105 @@
+package websocket
+
+import (
+	"fmt"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"github.com/buger/jsonparser"
+)
+
+type WsApiRequest struct {
+	Id     string                 `json:"id"`
+	Method string                 `json:"method"`
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+type WsApiResponse struct {
+	Id     string `json:"id"`
+	Status int64  `json:"status"`
+	// Result ([]byte): raw json of "result", nil when the request failed
+	Result     []byte               `json:"result,omitempty"`
+	Error      *model.ErrorResponse `json:"error,omitempty"`
+	RateLimits []*WsApiRateLimit    `json:"rateLimits,omitempty"`
+}
+
+// WsApiRateLimit
+// usage of the rate limit after the request, Count of Limit per IntervalNum Interval
+type WsApiRateLimit struct {
+	RateLimitType string `json:"rateLimitType"`
+	Interval      string `json:"interval"`
+	IntervalNum   int64  `json:"intervalNum"`
+	Limit         int64  `json:"limit"`
+	Count         int64  `json:"count"`
+}
+
+// WsApiError
+// failed request of WsApi. It matches the documented errors of spot by errors.Is,
+// e.g. errors.Is(err, spot.ErrUnknownOrder) or errors.Is(err, spot.ErrorCategoryUnknownOrder)
+type WsApiError struct {
+	Status  int64
+	Code    int64
+	Message string
+}
+
+func (e *WsApiError) Error() string {
+	return fmt.Sprintf("websocket api error::status: %d, error code: %d, message: %s", e.Status, e.Code, e.Message)
+}
+
+// errorCodeMatcher
+// implemented by *spot.ErrorCode and spot.ErrorCategory
+type errorCodeMatcher interface {
+	MatchCode(code int64, message string) bool
+}
+
+func (e *WsApiError) Is(target error) bool {
+	if matcher, ok := target.(errorCodeMatcher); ok {
+		return matcher.MatchCode(e.Code, e.Message)
+	}
+	return false
+}
+
+func parseWsApiResponse(b []byte) (*WsApiResponse, error) {
+	result := new(WsApiResponse)
+
+	if v, err := jsonparser.GetString(b, "id"); err == nil {
+		result.Id = v
+	} else {
+		return nil, err
+	}
+	if v, err := jsonparser.GetInt(b, "status"); err == nil {
+		result.Status = v
+	}
+	if v, _, _, err := jsonparser.Get(b, "result"); err == nil {
+		result.Result = v
+	}
+	if v, dataType, _, err := jsonparser.Get(b, "error"); err == nil && dataType == jsonparser.Object {
+		e := new(model.ErrorResponse)
+		if v, err := jsonparser.GetInt(v, "code"); err == nil {
+			e.Code = v
+		}
+		if v, err := jsonparser.GetString(v, "msg"); err == nil {
+			e.Msg = v
+		}
+		result.Error = e
+	}
+	_, _ = jsonparser.ArrayEach(b, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		rateLimit := new(WsApiRateLimit)
+		if v, err := jsonparser.GetString(value, "rateLimitType"); err == nil {
+			rateLimit.RateLimitType = v
+		}
+		if v, err := jsonparser.GetString(value, "interval"); err == nil {
+			rateLimit.Interval = v
+		}
+		if v, err := jsonparser.GetInt(value, "intervalNum"); err == nil {
+			rateLimit.IntervalNum = v
+		}
+		if v, err := jsonparser.GetInt(value, "limit"); err == nil {
+			rateLimit.Limit = v
+		}
+		if v, err := jsonparser.GetInt(value, "count"); err == nil {
+			rateLimit.Count = v
+		}
+		result.RateLimits = append(result.RateLimits, rateLimit)
+	}, "rateLimits")
+
+	return result, nil
+}
@@ -0,0 +1,158 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"github.com/NattapornTee22816/binance-connector-golang/lib"
+	"github.com/NattapornTee22816/binance-connector-golang/model"
+	"github.com/NattapornTee22816/binance-connector-golang/spot"
+	"github.com/buger/jsonparser"
+	"github.com/gorilla/websocket"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWsApiSignaturePayload(t *testing.T) {
+	params := map[string]interface{}{
+		"symbol":           "BTCUSDT",
+		"side":             "SELL",
+		"type":             "LIMIT",
+		"timeInForce":      "GTC",
+		"quantity":         "0.01000000",
+		"price":            "52000.00",
+		"newOrderRespType": "ACK",
+		"recvWindow":       int64(100),
+		"timestamp":        int64(1655716096498),
+		"apiKey":           "vmPUZE6mv9SD5VNHk4HlWFsOr6aKE2zvsw0MuIgwCIPy6utIco14y7Ju91duEh8A",
+	}
+
+	expected := "apiKey=vmPUZE6mv9SD5VNHk4HlWFsOr6aKE2zvsw0MuIgwCIPy6utIco14y7Ju91duEh8A&newOrderRespType=ACK&price=52000.00&quantity=0.01000000&recvWindow=100&side=SELL&symbol=BTCUSDT&timeInForce=GTC&timestamp=1655716096498&type=LIMIT"
+	if payload := wsApiSignaturePayload(params); payload != expected {
+		t.Errorf("payload = %s", payload)
+	}
+}
+
+// newTestWsApi
+// handler returns the response of a request, nil for no response
+func newTestWsApi(t *testing.T, handler func(request []byte) []byte) *WsApi {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var mu sync.Mutex
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			go func() {
+				if response := handler(message); response != nil {
+					mu.Lock()
+					_ = conn.WriteMessage(websocket.TextMessage, response)
+					mu.Unlock()
+				}
+			}()
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	api, err := NewWsApi("key", lib.NewHmacSigner("secret"), WithBaseUrl("ws"+strings.TrimPrefix(server.URL, "http")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(api.Shutdown)
+	return api
+}
+
+func TestWsApi_Request(t *testing.T) {
+	var mu sync.Mutex
+	requests := make(map[string][]byte)
+	api := newTestWsApi(t, func(request []byte) []byte {
+		id, _ := jsonparser.GetString(request, "id")
+		method, _ := jsonparser.GetString(request, "method")
+		mu.Lock()
+		requests[method] = request
+		mu.Unlock()
+
+		switch method {
+		case "order.place":
+			// the slower response is received after the response of the next request
+			time.Sleep(50 * time.Millisecond)
+			return []byte(`{"id":"` + id + `","status":200,"result":{"symbol":"BTCUSDT","orderId":12569099453,"orderListId":-1,"clientOrderId":"4d96324ff9d44481926157ec08158a40","transactTime":1660801715639},"rateLimits":[{"rateLimitType":"ORDERS","interval":"SECOND","intervalNum":10,"limit":50,"count":1},{"rateLimitType":"REQUEST_WEIGHT","interval":"MINUTE","intervalNum":1,"limit":1200,"count":2}]}`)
+		case "order.cancel":
+			return []byte(`{"id":"` + id + `","status":400,"error":{"code":-2011,"msg":"Unknown order sent."},"rateLimits":[{"rateLimitType":"REQUEST_WEIGHT","interval":"MINUTE","intervalNum":1,"limit":1200,"count":3}]}`)
+		case "time":
+			return []byte(`{"id":"` + id + `","status":200,"result":{"serverTime":1656400526260},"rateLimits":[{"rateLimitType":"REQUEST_WEIGHT","interval":"MINUTE","intervalNum":1,"limit":1200,"count":1}]}`)
+		}
+		return nil
+	})
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		order, err := api.NewOrder(&model.OrderParam{
+			Symbol:      "BTCUSDT",
+			Side:        model.OrderSideSell,
+			OrderType:   model.OrderTypeLimit,
+			TimeInForce: model.TimeInForceGTG,
+			Quantity:    model.MustDecimal("0.01"),
+			Price:       model.MustDecimal("23416.10"),
+			RecvWindow:  5000,
+		})
+		if err != nil || order.OrderId != 12569099453 {
+			t.Errorf("order = %+v, err = %v", order, err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		time.Sleep(10 * time.Millisecond)
+		serverTime, err := api.CheckServerTime()
+		if err != nil || serverTime != 1656400526260 {
+			t.Errorf("server time = %d, err = %v", serverTime, err)
+		}
+	}()
+	wg.Wait()
+
+	mu.Lock()
+	request := requests["order.place"]
+	mu.Unlock()
+	if v, _ := jsonparser.GetString(request, "params", "price"); v != "23416.1" {
+		t.Errorf("price = %s", v)
+	}
+	if v, _ := jsonparser.GetInt(request, "params", "recvWindow"); v != 5000 {
+		t.Errorf("recvWindow = %d", v)
+	}
+	if v, _ := jsonparser.GetString(request, "params", "signature"); len(v) != 64 {
+		t.Errorf("signature = %s", v)
+	}
+	if v, _ := jsonparser.GetString(request, "params", "apiKey"); v != "key" {
+		t.Errorf("apiKey = %s", v)
+	}
+
+	_, err := api.CancelOrder(&model.CancelOrderParam{Symbol: "BTCUSDT", OrderId: 1})
+	var apiError *WsApiError
+	if !errors.As(err, &apiError) || apiError.Status != 400 || !errors.Is(err, spot.ErrUnknownOrder) || !errors.Is(err, spot.ErrorCategoryUnknownOrder) {
+		t.Errorf("err = %v", err)
+	}
+	if rateLimits := api.RateLimits(); len(rateLimits) != 1 || rateLimits[0].Count != 3 {
+		t.Errorf("rate limits = %+v", rateLimits)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err = api.PingContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v", err)
+	}
+	if _, err = api.Request(context.Background(), "account.status", &model.AccountParam{RecvWindow: 70000}, model.EndpointSecurityTypeUserData); err == nil {
+		t.Error("recvWindow should be validated")
+	}
+}